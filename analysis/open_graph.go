@@ -0,0 +1,110 @@
+package analysis
+
+import (
+	"bytes"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/yuin/goldmark/ast"
+)
+
+// DefaultDescriptionLength is a default maximum number of characters of
+// descriptions extracted by ExtractOpenGraph.
+const DefaultDescriptionLength = 200
+
+// An OpenGraph struct holds metadata for social previews of a document
+// like '<meta property="og:title" content="...">'.
+type OpenGraph struct {
+	// Title is a "title" metadata of the document(i.e. front matter) or
+	// a text of the first heading.
+	Title string
+
+	// Description is a "description" metadata of the document or
+	// a text of the first paragraph that has texts.
+	Description string
+
+	// Image is an "image" metadata of the document or a destination of
+	// the first image.
+	Image string
+}
+
+// ExtractOpenGraph returns Open Graph metadata of the given document.
+// Descriptions are truncated to DefaultDescriptionLength characters.
+func ExtractOpenGraph(doc ast.Node, source []byte) *OpenGraph {
+	return ExtractOpenGraphWithLength(doc, source, DefaultDescriptionLength)
+}
+
+// ExtractOpenGraphWithLength returns Open Graph metadata of the given
+// document. Descriptions are truncated to the given number of characters
+// at a word boundary. If length is less than or equal to 0, descriptions
+// are not truncated.
+func ExtractOpenGraphWithLength(doc ast.Node, source []byte, length int) *OpenGraph {
+	og := &OpenGraph{}
+	if d, ok := doc.(*ast.Document); ok {
+		og.Title, _ = d.Meta()["title"].(string)
+		og.Description, _ = d.Meta()["description"].(string)
+		og.Image, _ = d.Meta()["image"].(string)
+	}
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		switch v := n.(type) {
+		case *ast.Heading:
+			if len(og.Title) == 0 {
+				og.Title = plainText(v, source)
+			}
+		case *ast.Paragraph:
+			if len(og.Description) == 0 {
+				og.Description = truncateText(plainText(v, source), length)
+			}
+		case *ast.Image:
+			if len(og.Image) == 0 {
+				og.Image = string(v.Destination)
+			}
+		}
+		return ast.WalkContinue, nil
+	})
+	return og
+}
+
+// plainText returns a text of the given node without markups.
+// Line breaks are replaced with spaces and images are omitted.
+func plainText(n ast.Node, source []byte) string {
+	var buf bytes.Buffer
+	_ = ast.Walk(n, func(c ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		switch v := c.(type) {
+		case *ast.Image:
+			return ast.WalkSkipChildren, nil
+		case *ast.Text:
+			_, _ = buf.Write(v.Segment.Value(source))
+			if v.SoftLineBreak() || v.HardLineBreak() {
+				_ = buf.WriteByte(' ')
+			}
+		case *ast.String:
+			_, _ = buf.Write(v.Value)
+		case *ast.AutoLink:
+			_, _ = buf.Write(v.Label(source))
+		}
+		return ast.WalkContinue, nil
+	})
+	return strings.Join(strings.Fields(buf.String()), " ")
+}
+
+// truncateText truncates the given text to the given number of characters
+// at a word boundary.
+func truncateText(s string, length int) string {
+	if length <= 0 || utf8.RuneCountInString(s) <= length {
+		return s
+	}
+	const ellipsis = "…"
+	runes := []rune(s)[:length-1]
+	t := string(runes)
+	if i := strings.LastIndexByte(t, ' '); i > 0 {
+		t = t[:i]
+	}
+	return strings.TrimRight(t, " ,.;:") + ellipsis
+}
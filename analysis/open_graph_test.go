@@ -0,0 +1,44 @@
+package analysis
+
+import (
+	"testing"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/text"
+)
+
+func TestExtractOpenGraph(t *testing.T) {
+	source := []byte("![Banner](banner.png)\n" +
+		"\n" +
+		"# Release *1.0*\n" +
+		"\n" +
+		"This release adds **many** features\n" +
+		"like `code` and [links](/links).\n" +
+		"\n" +
+		"![Screenshot](screenshot.png)\n")
+	markdown := goldmark.New()
+	doc := markdown.Parser().Parse(text.NewReader(source))
+
+	og := ExtractOpenGraph(doc, source)
+	if og.Title != "Release 1.0" {
+		t.Errorf("unexpected title: %q", og.Title)
+	}
+	if og.Description != "This release adds many features like code and links." {
+		t.Errorf("unexpected description: %q", og.Description)
+	}
+	if og.Image != "banner.png" {
+		t.Errorf("unexpected image: %q", og.Image)
+	}
+
+	og = ExtractOpenGraphWithLength(doc, source, 30)
+	if og.Description != "This release adds many…" {
+		t.Errorf("unexpected truncated description: %q", og.Description)
+	}
+
+	doc.(*ast.Document).AddMeta("title", "Front matter title")
+	og = ExtractOpenGraph(doc, source)
+	if og.Title != "Front matter title" {
+		t.Errorf("metadata should take precedence, but got %q", og.Title)
+	}
+}
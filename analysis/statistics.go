@@ -0,0 +1,102 @@
+// Package analysis provides analyzers that derive information from
+// goldmark's AST.
+package analysis
+
+import (
+	"github.com/yuin/goldmark/ast"
+	east "github.com/yuin/goldmark/extension/ast"
+)
+
+// A Statistics struct holds structural statistics of a document.
+type Statistics struct {
+	// Headings is a number of headings per level. Headings[0] is
+	// a number of level 1 headings.
+	Headings [6]int
+
+	// Paragraphs is a number of paragraphs.
+	Paragraphs int
+
+	// Links is a number of links including autolinks.
+	Links int
+
+	// Images is a number of images.
+	Images int
+
+	// CodeBlocks is a number of indented and fenced code blocks.
+	CodeBlocks int
+
+	// CodeBlocksByLanguage is a number of code blocks per language.
+	// Code blocks without languages are counted as "".
+	CodeBlocksByLanguage map[string]int
+
+	// Lists is a number of lists.
+	Lists int
+
+	// Tables is a number of tables.
+	Tables int
+
+	// Footnotes is a number of footnote definitions.
+	Footnotes int
+
+	// MaxDepth is a maximum nesting depth of blocks. Blocks directly
+	// under the document are at depth 1.
+	MaxDepth int
+}
+
+// TotalHeadings returns a number of headings of all levels.
+func (s *Statistics) TotalHeadings() int {
+	total := 0
+	for _, v := range s.Headings {
+		total += v
+	}
+	return total
+}
+
+// Analyze returns statistics of the given document.
+func Analyze(doc ast.Node, source []byte) *Statistics {
+	s := &Statistics{
+		CodeBlocksByLanguage: map[string]int{},
+	}
+	depth := 0
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if n.Type() == ast.TypeBlock && n.Kind() != ast.KindDocument {
+			if !entering {
+				depth--
+				return ast.WalkContinue, nil
+			}
+			depth++
+			if depth > s.MaxDepth {
+				s.MaxDepth = depth
+			}
+		}
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		switch v := n.(type) {
+		case *ast.Heading:
+			if v.Level >= 1 && v.Level <= len(s.Headings) {
+				s.Headings[v.Level-1]++
+			}
+		case *ast.Paragraph:
+			s.Paragraphs++
+		case *ast.Link, *ast.AutoLink:
+			s.Links++
+		case *ast.Image:
+			s.Images++
+		case *ast.CodeBlock:
+			s.CodeBlocks++
+			s.CodeBlocksByLanguage[""]++
+		case *ast.FencedCodeBlock:
+			s.CodeBlocks++
+			s.CodeBlocksByLanguage[string(v.Language(source))]++
+		case *ast.List:
+			s.Lists++
+		case *east.Table:
+			s.Tables++
+		case *east.Footnote:
+			s.Footnotes++
+		}
+		return ast.WalkContinue, nil
+	})
+	return s
+}
@@ -0,0 +1,53 @@
+package analysis
+
+import (
+	"testing"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/text"
+)
+
+func TestAnalyze(t *testing.T) {
+	source := []byte("# Title\n" +
+		"\n" +
+		"## Section\n" +
+		"\n" +
+		"A [link](/) and <https://example.com> with ![image](a.png)[^1].\n" +
+		"\n" +
+		"- item\n" +
+		"  > quote\n" +
+		"  > - nested\n" +
+		"\n" +
+		"```go\n" +
+		"package main\n" +
+		"```\n" +
+		"\n" +
+		"    indented\n" +
+		"\n" +
+		"| a |\n" +
+		"|---|\n" +
+		"| 1 |\n" +
+		"\n" +
+		"[^1]: footnote\n")
+	markdown := goldmark.New(goldmark.WithExtensions(extension.GFM, extension.Footnote))
+	doc := markdown.Parser().Parse(text.NewReader(source))
+	s := Analyze(doc, source)
+
+	if s.Headings[0] != 1 || s.Headings[1] != 1 || s.TotalHeadings() != 2 {
+		t.Errorf("unexpected headings: %v", s.Headings)
+	}
+	if s.Links != 2 || s.Images != 1 {
+		t.Errorf("unexpected links and images: %d, %d", s.Links, s.Images)
+	}
+	if s.CodeBlocks != 2 || s.CodeBlocksByLanguage["go"] != 1 || s.CodeBlocksByLanguage[""] != 1 {
+		t.Errorf("unexpected code blocks: %v", s.CodeBlocksByLanguage)
+	}
+	if s.Lists != 2 || s.Tables != 1 || s.Footnotes != 1 {
+		t.Errorf("unexpected lists, tables and footnotes: %d, %d, %d", s.Lists, s.Tables, s.Footnotes)
+	}
+	// list > item > blockquote > list > item > text block
+	if s.MaxDepth != 6 {
+		t.Errorf("expected max depth 6, but got %d", s.MaxDepth)
+	}
+}
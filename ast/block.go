@@ -4,6 +4,7 @@ import (
 	"fmt"
 	textm "github.com/yuin/goldmark/text"
 	"strings"
+	"sync"
 )
 
 // A BaseBlock struct implements the Node interface.
@@ -49,6 +50,9 @@ func (b *BaseBlock) SetLines(v *textm.Segments) {
 // A Document struct is a root node of Markdown text.
 type Document struct {
 	BaseBlock
+
+	meta    map[string]interface{}
+	options map[DocumentOptionKey]interface{}
 }
 
 // KindDocument is a NodeKind of the Document node.
@@ -69,6 +73,60 @@ func (n *Document) Kind() NodeKind {
 	return KindDocument
 }
 
+// Meta returns metadata of this document.
+// Metadata are values associated with a document rather than nodes,
+// like options for rendering this document.
+func (n *Document) Meta() map[string]interface{} {
+	if n.meta == nil {
+		n.meta = map[string]interface{}{}
+	}
+	return n.meta
+}
+
+// SetMeta sets given metadata to this document.
+func (n *Document) SetMeta(meta map[string]interface{}) {
+	n.meta = meta
+}
+
+// AddMeta adds given metadata to this document.
+func (n *Document) AddMeta(key string, value interface{}) {
+	n.Meta()[key] = value
+}
+
+// A DocumentOptionKey is a key of document options.
+type DocumentOptionKey int
+
+var documentOptionKeyMax DocumentOptionKey
+
+var documentOptionKeyMutex sync.Mutex
+
+// NewDocumentOptionKey returns a new DocumentOptionKey value.
+// NewDocumentOptionKey is safe for concurrent use.
+func NewDocumentOptionKey() DocumentOptionKey {
+	documentOptionKeyMutex.Lock()
+	defer documentOptionKeyMutex.Unlock()
+	documentOptionKeyMax++
+	return documentOptionKeyMax
+}
+
+// Option returns a value of the document option associated with the
+// given key, or nil if it is not set.
+// Document options are values set by programs for each Parse call,
+// like options that override renderer options for this document.
+// Unlike metadata, document options can not be set by contents of
+// documents like front matter, and are not exposed as metadata.
+func (n *Document) Option(key DocumentOptionKey) interface{} {
+	return n.options[key]
+}
+
+// SetOption sets the given document option to this document.
+func (n *Document) SetOption(key DocumentOptionKey, value interface{}) {
+	if n.options == nil {
+		n.options = map[DocumentOptionKey]interface{}{}
+	}
+	n.options[key] = value
+}
+
 // NewDocument returns a new Document node.
 func NewDocument() *Document {
 	return &Document{
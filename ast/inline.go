@@ -365,6 +365,10 @@ type baseLink struct {
 
 	// Title is a title of this link.
 	Title []byte
+
+	// Reference is a label of the link reference definition that this link
+	// refers, or nil if this link is an inline link.
+	Reference []byte
 }
 
 // Inline implements Inline.Inline.
@@ -432,6 +436,7 @@ func NewImage(link *Link) *Image {
 	}
 	c.Destination = link.Destination
 	c.Title = link.Title
+	c.Reference = link.Reference
 	for n := link.FirstChild(); n != nil; {
 		next := n.NextSibling()
 		link.RemoveChild(link, n)
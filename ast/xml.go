@@ -0,0 +1,293 @@
+package ast
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/yuin/goldmark/util"
+)
+
+// DumpXML writes the given node and its descendants to w in the
+// CommonMark XML format(http://commonmark.org/xml/1.0) that the reference
+// implementation(cmark) outputs with '-t xml'. Outputs can be compared
+// with cmark's outputs mechanically.
+//
+// Like cmark, adjacent texts are merged into a text, and paragraphs in
+// tight lists are dumped as paragraphs. Nodes that are not defined in
+// CommonMark are dumped as elements named after their kinds like
+// '<table_cell>'.
+func DumpXML(w io.Writer, n Node, source []byte) error {
+	d := &xmlDumper{w: bufio.NewWriter(w), source: source}
+	_, _ = d.w.WriteString("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n")
+	_, _ = d.w.WriteString("<!DOCTYPE document SYSTEM \"CommonMark.dtd\">\n")
+	d.dump(n, 0)
+	return d.w.Flush()
+}
+
+type xmlDumper struct {
+	w      *bufio.Writer
+	source []byte
+	text   []byte
+	inText bool
+}
+
+func (d *xmlDumper) indent(level int) {
+	_, _ = d.w.WriteString(strings.Repeat("  ", level))
+}
+
+func (d *xmlDumper) open(level int, name string, attrs [][2]string, empty bool) {
+	d.indent(level)
+	_ = d.w.WriteByte('<')
+	_, _ = d.w.WriteString(name)
+	for _, attr := range attrs {
+		_ = d.w.WriteByte(' ')
+		_, _ = d.w.WriteString(attr[0])
+		_, _ = d.w.WriteString("=\"")
+		_, _ = d.w.Write(util.EscapeHTML([]byte(attr[1])))
+		_ = d.w.WriteByte('"')
+	}
+	if empty {
+		_, _ = d.w.WriteString(" />\n")
+	} else {
+		_, _ = d.w.WriteString(">\n")
+	}
+}
+
+func (d *xmlDumper) close(level int, name string) {
+	d.indent(level)
+	_, _ = d.w.WriteString("</")
+	_, _ = d.w.WriteString(name)
+	_, _ = d.w.WriteString(">\n")
+}
+
+func (d *xmlDumper) literal(level int, name string, attrs [][2]string, value []byte) {
+	d.indent(level)
+	_ = d.w.WriteByte('<')
+	_, _ = d.w.WriteString(name)
+	for _, attr := range attrs {
+		_ = d.w.WriteByte(' ')
+		_, _ = d.w.WriteString(attr[0])
+		_, _ = d.w.WriteString("=\"")
+		_, _ = d.w.Write(util.EscapeHTML([]byte(attr[1])))
+		_ = d.w.WriteByte('"')
+	}
+	_, _ = d.w.WriteString(" xml:space=\"preserve\">")
+	_, _ = d.w.Write(util.EscapeHTML(value))
+	_, _ = d.w.WriteString("</")
+	_, _ = d.w.WriteString(name)
+	_, _ = d.w.WriteString(">\n")
+}
+
+// flushText writes texts that have been merged so far.
+// Like cmark, empty texts are not written.
+func (d *xmlDumper) flushText(level int) {
+	if d.inText {
+		if len(d.text) != 0 {
+			d.literal(level, "text", nil, d.text)
+		}
+		d.text = d.text[:0]
+		d.inText = false
+	}
+}
+
+func (d *xmlDumper) appendText(value []byte) {
+	d.text = append(d.text, value...)
+	d.inText = true
+}
+
+func (d *xmlDumper) dumpChildren(n Node, level int) {
+	for c := n.FirstChild(); c != nil; c = c.NextSibling() {
+		d.dump(c, level)
+	}
+	d.flushText(level)
+}
+
+// isEmptyXMLNode returns true if the given node should not be dumped.
+// Text blocks that consist only of link reference definitions are left
+// empty in the AST, while cmark removes them.
+func isEmptyXMLNode(n Node) bool {
+	return n.Kind() == KindTextBlock && n.Lines().Len() == 0 && !n.HasChildren()
+}
+
+func (d *xmlDumper) container(n Node, level int, name string, attrs [][2]string) {
+	empty := true
+	for c := n.FirstChild(); c != nil; c = c.NextSibling() {
+		if !isEmptyXMLNode(c) {
+			empty = false
+			break
+		}
+	}
+	if !empty {
+		d.open(level, name, attrs, false)
+		d.dumpChildren(n, level+1)
+		d.close(level, name)
+	} else {
+		d.open(level, name, attrs, true)
+	}
+}
+
+func (d *xmlDumper) dump(n Node, level int) {
+	if isEmptyXMLNode(n) {
+		return
+	}
+	source := d.source
+	switch v := n.(type) {
+	case *Text:
+		value := v.Segment.Value(source)
+		if v.IsRaw() {
+			d.appendText(value)
+		} else {
+			d.appendText(unescapeXMLText(value))
+		}
+		if v.HardLineBreak() {
+			d.flushText(level)
+			d.open(level, "linebreak", nil, true)
+		} else if v.SoftLineBreak() {
+			d.flushText(level)
+			d.open(level, "softbreak", nil, true)
+		}
+		return
+	case *String:
+		if v.IsRaw() || v.IsCode() {
+			d.appendText(v.Value)
+		} else {
+			d.appendText(unescapeXMLText(v.Value))
+		}
+		return
+	}
+	d.flushText(level)
+	switch v := n.(type) {
+	case *Document:
+		d.container(n, level, "document", [][2]string{{"xmlns", "http://commonmark.org/xml/1.0"}})
+	case *Paragraph, *TextBlock:
+		d.container(n, level, "paragraph", nil)
+	case *Heading:
+		d.container(n, level, "heading", [][2]string{{"level", strconv.Itoa(v.Level)}})
+	case *ThemanticBreak:
+		d.open(level, "thematic_break", nil, true)
+	case *Blockquote:
+		d.container(n, level, "block_quote", nil)
+	case *List:
+		var attrs [][2]string
+		if v.IsOrdered() {
+			delim := "period"
+			if v.Marker == ')' {
+				delim = "paren"
+			}
+			attrs = append(attrs, [2]string{"type", "ordered"},
+				[2]string{"start", strconv.Itoa(v.Start)}, [2]string{"delim", delim})
+		} else {
+			attrs = append(attrs, [2]string{"type", "bullet"})
+		}
+		attrs = append(attrs, [2]string{"tight", strconv.FormatBool(v.IsTight)})
+		d.container(n, level, "list", attrs)
+	case *ListItem:
+		d.container(n, level, "item", nil)
+	case *CodeBlock:
+		d.literal(level, "code_block", nil, n.Lines().Value(source))
+	case *FencedCodeBlock:
+		var attrs [][2]string
+		if v.Info != nil {
+			attrs = append(attrs, [2]string{"info", string(unescapeXMLText(v.Info.Text(source)))})
+		}
+		d.literal(level, "code_block", attrs, n.Lines().Value(source))
+	case *HTMLBlock:
+		value := n.Lines().Value(source)
+		if v.HasClosure() {
+			value = append(value, v.ClosureLine.Value(source)...)
+		}
+		d.literal(level, "html_block", nil, value)
+	case *CodeSpan:
+		var buf bytes.Buffer
+		for c := n.FirstChild(); c != nil; c = c.NextSibling() {
+			if t, ok := c.(*Text); ok {
+				value := t.Segment.Value(source)
+				if bytes.HasSuffix(value, []byte("\n")) {
+					value = value[:len(value)-1]
+					if c != n.LastChild() {
+						value = append(value, ' ')
+					}
+				}
+				_, _ = buf.Write(value)
+			}
+		}
+		d.literal(level, "code", nil, buf.Bytes())
+	case *RawHTML:
+		d.literal(level, "html_inline", nil, v.Segments.Value(source))
+	case *Emphasis:
+		name := "emph"
+		if v.Level == 2 {
+			name = "strong"
+		}
+		d.container(n, level, name, nil)
+	case *Link:
+		d.container(n, level, "link", linkXMLAttributes(v.Destination, v.Title))
+	case *Image:
+		d.container(n, level, "image", linkXMLAttributes(v.Destination, v.Title))
+	case *AutoLink:
+		url := v.URL(source)
+		if v.AutoLinkType == AutoLinkEmail && !bytes.HasPrefix(bytes.ToLower(url), []byte("mailto:")) {
+			url = append([]byte("mailto:"), url...)
+		}
+		d.open(level, "link", [][2]string{{"destination", string(url)}, {"title", ""}}, false)
+		d.literal(level+1, "text", nil, v.Label(source))
+		d.close(level, "link")
+	default:
+		d.container(n, level, xmlName(n.Kind().String()), nil)
+	}
+}
+
+func linkXMLAttributes(destination, title []byte) [][2]string {
+	return [][2]string{
+		{"destination", string(unescapeXMLText(destination))},
+		{"title", string(unescapeXMLText(title))},
+	}
+}
+
+// xmlName converts a kind name like "TableCell" into an element name
+// like "table_cell".
+func xmlName(kind string) string {
+	var buf strings.Builder
+	for i, r := range kind {
+		if unicode.IsUpper(r) {
+			if i != 0 {
+				_ = buf.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		_, _ = buf.WriteRune(r)
+	}
+	return buf.String()
+}
+
+// unescapeXMLText resolves backslash escapes and character references
+// in the given value like the HTML renderer does.
+func unescapeXMLText(value []byte) []byte {
+	buf := make([]byte, 0, len(value))
+	limit := len(value)
+	for i := 0; i < limit; i++ {
+		c := value[i]
+		if c == '\\' && i+1 < limit && util.IsPunct(value[i+1]) {
+			buf = append(buf, value[i+1])
+			i++
+			continue
+		}
+		if c == '&' {
+			if j := bytes.IndexByte(value[i:], ';'); j > 0 {
+				ref := value[i : i+j+1]
+				resolved := util.ResolveEntityNames(util.ResolveNumericReferences(ref))
+				if !bytes.Equal(ref, resolved) {
+					buf = append(buf, resolved...)
+					i += j
+					continue
+				}
+			}
+		}
+		buf = append(buf, c)
+	}
+	return buf
+}
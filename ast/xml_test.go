@@ -0,0 +1,113 @@
+package ast_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/text"
+)
+
+const xmlHeader = "<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n" +
+	"<!DOCTYPE document SYSTEM \"CommonMark.dtd\">\n" +
+	"<document xmlns=\"http://commonmark.org/xml/1.0\">\n"
+
+// expected outputs are taken from 'cmark -t xml'
+var xmlTestCases = []struct {
+	markdown string
+	expected string
+}{
+	{
+		"Hello *world* and  \nall **of\nyou**\n",
+		xmlHeader +
+			"  <paragraph>\n" +
+			"    <text xml:space=\"preserve\">Hello </text>\n" +
+			"    <emph>\n" +
+			"      <text xml:space=\"preserve\">world</text>\n" +
+			"    </emph>\n" +
+			"    <text xml:space=\"preserve\"> and</text>\n" +
+			"    <linebreak />\n" +
+			"    <text xml:space=\"preserve\">all </text>\n" +
+			"    <strong>\n" +
+			"      <text xml:space=\"preserve\">of</text>\n" +
+			"      <softbreak />\n" +
+			"      <text xml:space=\"preserve\">you</text>\n" +
+			"    </strong>\n" +
+			"  </paragraph>\n" +
+			"</document>\n",
+	},
+	{
+		"# Title\n\n```go\nfmt.Println(\"a & b\")\n```\n\n***\n",
+		xmlHeader +
+			"  <heading level=\"1\">\n" +
+			"    <text xml:space=\"preserve\">Title</text>\n" +
+			"  </heading>\n" +
+			"  <code_block info=\"go\" xml:space=\"preserve\">fmt.Println(&quot;a &amp; b&quot;)\n</code_block>\n" +
+			"  <thematic_break />\n" +
+			"</document>\n",
+	},
+	{
+		"[foo]: /url \"title\"\n\n[foo] and `code`\nnext\n",
+		xmlHeader +
+			"  <paragraph>\n" +
+			"    <link destination=\"/url\" title=\"title\">\n" +
+			"      <text xml:space=\"preserve\">foo</text>\n" +
+			"    </link>\n" +
+			"    <text xml:space=\"preserve\"> and </text>\n" +
+			"    <code xml:space=\"preserve\">code</code>\n" +
+			"    <softbreak />\n" +
+			"    <text xml:space=\"preserve\">next</text>\n" +
+			"  </paragraph>\n" +
+			"</document>\n",
+	},
+	{
+		"- [foo]: /url\n- bar\n\n2) baz\n",
+		xmlHeader +
+			"  <list type=\"bullet\" tight=\"true\">\n" +
+			"    <item />\n" +
+			"    <item>\n" +
+			"      <paragraph>\n" +
+			"        <text xml:space=\"preserve\">bar</text>\n" +
+			"      </paragraph>\n" +
+			"    </item>\n" +
+			"  </list>\n" +
+			"  <list type=\"ordered\" start=\"2\" delim=\"paren\" tight=\"true\">\n" +
+			"    <item>\n" +
+			"      <paragraph>\n" +
+			"        <text xml:space=\"preserve\">baz</text>\n" +
+			"      </paragraph>\n" +
+			"    </item>\n" +
+			"  </list>\n" +
+			"</document>\n",
+	},
+	{
+		"> [foo]: /url\n> <b>*bold*</b>\n",
+		xmlHeader +
+			"  <block_quote>\n" +
+			"    <paragraph>\n" +
+			"      <html_inline xml:space=\"preserve\">&lt;b&gt;</html_inline>\n" +
+			"      <emph>\n" +
+			"        <text xml:space=\"preserve\">bold</text>\n" +
+			"      </emph>\n" +
+			"      <html_inline xml:space=\"preserve\">&lt;/b&gt;</html_inline>\n" +
+			"    </paragraph>\n" +
+			"  </block_quote>\n" +
+			"</document>\n",
+	},
+}
+
+func TestDumpXML(t *testing.T) {
+	p := goldmark.DefaultParser()
+	for i, c := range xmlTestCases {
+		source := []byte(c.markdown)
+		doc := p.Parse(text.NewReader(source))
+		var b bytes.Buffer
+		if err := ast.DumpXML(&b, doc, source); err != nil {
+			t.Fatal(err)
+		}
+		if b.String() != c.expected {
+			t.Errorf("%d: unexpected output for %q:\n%s", i, c.markdown, b.String())
+		}
+	}
+}
@@ -0,0 +1,251 @@
+package extension
+
+import (
+	"bytes"
+
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// An AdmonitionConfig struct is a data structure that holds configuration
+// of the Admonition extension.
+type AdmonitionConfig struct {
+	// Class is a class of admonitions. Types of admonitions like 'warning'
+	// are added as classes too.
+	Class []byte
+
+	// TitleClass is a class of titles of admonitions.
+	TitleClass []byte
+}
+
+// NewAdmonitionConfig returns a new AdmonitionConfig with defaults.
+func NewAdmonitionConfig() AdmonitionConfig {
+	return AdmonitionConfig{
+		Class:      []byte("admonition"),
+		TitleClass: []byte("admonition-title"),
+	}
+}
+
+// An AdmonitionOption interface sets options for the Admonition extension.
+type AdmonitionOption interface {
+	SetAdmonitionOption(*AdmonitionConfig)
+}
+
+type withAdmonitionClass struct {
+	value []byte
+}
+
+func (o *withAdmonitionClass) SetAdmonitionOption(c *AdmonitionConfig) {
+	c.Class = o.value
+}
+
+// WithAdmonitionClass is a functional option that sets a class of
+// admonitions. The default is "admonition" as in Python-Markdown.
+// An empty string means only types of admonitions are added as classes.
+func WithAdmonitionClass(class string) AdmonitionOption {
+	return &withAdmonitionClass{[]byte(class)}
+}
+
+type withAdmonitionTitleClass struct {
+	value []byte
+}
+
+func (o *withAdmonitionTitleClass) SetAdmonitionOption(c *AdmonitionConfig) {
+	c.TitleClass = o.value
+}
+
+// WithAdmonitionTitleClass is a functional option that sets a class of
+// titles of admonitions. The default is "admonition-title".
+// An empty string means no classes are added.
+func WithAdmonitionTitleClass(class string) AdmonitionOption {
+	return &withAdmonitionTitleClass{[]byte(class)}
+}
+
+var admonitionMarker = []byte("!!!")
+
+type admonitionParser struct {
+}
+
+var defaultAdmonitionParser = &admonitionParser{}
+
+// NewAdmonitionParser returns a new parser.BlockParser that can parse
+// Python-Markdown style admonitions like '!!! warning "Title"'.
+// Bodies of admonitions must be indented by 4 spaces.
+func NewAdmonitionParser() parser.BlockParser {
+	return defaultAdmonitionParser
+}
+
+func (b *admonitionParser) Open(parent gast.Node, reader text.Reader, pc parser.Context) (gast.Node, parser.State) {
+	line, segment := reader.PeekLine()
+	pos := pc.BlockOffset()
+	if pos < 0 || !bytes.HasPrefix(line[pos:], admonitionMarker) {
+		return nil, parser.NoChildren
+	}
+	rest := line[pos+len(admonitionMarker):]
+	if len(rest) == 0 || !util.IsSpace(rest[0]) {
+		return nil, parser.NoChildren
+	}
+	typ, classes, title, ok := parseAdmonitionHeader(rest, true)
+	if !ok {
+		return nil, parser.NoChildren
+	}
+	node := ast.NewAdmonition(typ, title)
+	node.Classes = classes
+	reader.Advance(segment.Len() - 1)
+	return node, parser.HasChildren
+}
+
+// parseAdmonitionHeader parses a header of admonitions like
+// 'warning highlight "Title"' following markers.
+// A type can be omitted if requireType is false and the header has a
+// title.
+func parseAdmonitionHeader(rest []byte, requireType bool) (typ []byte, classes [][]byte, title []byte, ok bool) {
+	rest = util.TrimRightSpace(util.TrimLeftSpace(rest))
+	hasTitle := false
+	if i := bytes.IndexByte(rest, '"'); i > -1 {
+		if len(rest) < i+2 || rest[len(rest)-1] != '"' {
+			return nil, nil, nil, false
+		}
+		title = rest[i+1 : len(rest)-1]
+		hasTitle = true
+		rest = rest[:i]
+	}
+	fields := bytes.Fields(rest)
+	if len(fields) == 0 && (requireType || !hasTitle) {
+		return nil, nil, nil, false
+	}
+	for _, field := range fields {
+		for _, c := range field {
+			if !util.IsAlphaNumeric(c) && c != '-' && c != '_' {
+				return nil, nil, nil, false
+			}
+		}
+	}
+	if len(fields) != 0 {
+		typ = bytes.ToLower(fields[0])
+		classes = fields[1:]
+	}
+	if !hasTitle {
+		// titles default to capitalized types as in Python-Markdown
+		title = append([]byte{}, typ...)
+		if 'a' <= title[0] && title[0] <= 'z' {
+			title[0] -= 'a' - 'A'
+		}
+	} else if len(title) == 0 {
+		title = nil
+	}
+	return typ, classes, title, true
+}
+
+func (b *admonitionParser) Continue(node gast.Node, reader text.Reader, pc parser.Context) parser.State {
+	line, _ := reader.PeekLine()
+	if util.IsBlank(line) {
+		return parser.Continue | parser.HasChildren
+	}
+	childpos, padding := util.IndentPosition(line, reader.LineOffset(), 4)
+	if childpos < 0 {
+		return parser.Close
+	}
+	reader.AdvanceAndSetPadding(childpos, padding)
+	return parser.Continue | parser.HasChildren
+}
+
+func (b *admonitionParser) Close(node gast.Node, reader text.Reader, pc parser.Context) {
+	// nothing to do
+}
+
+func (b *admonitionParser) CanInterruptParagraph() bool {
+	return false
+}
+
+func (b *admonitionParser) CanAcceptIndentedLine() bool {
+	return false
+}
+
+// AdmonitionHTMLRenderer is a renderer.NodeRenderer implementation that
+// renders Admonition nodes.
+type AdmonitionHTMLRenderer struct {
+	html.Config
+	AdmonitionConfig
+}
+
+// NewAdmonitionHTMLRenderer returns a new AdmonitionHTMLRenderer.
+func NewAdmonitionHTMLRenderer(opts ...AdmonitionOption) renderer.NodeRenderer {
+	r := &AdmonitionHTMLRenderer{
+		Config:           html.NewConfig(),
+		AdmonitionConfig: NewAdmonitionConfig(),
+	}
+	for _, opt := range opts {
+		opt.SetAdmonitionOption(&r.AdmonitionConfig)
+	}
+	return r
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *AdmonitionHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindAdmonition, r.renderAdmonition)
+}
+
+func (r *AdmonitionHTMLRenderer) renderAdmonition(w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	if !entering {
+		_, _ = w.WriteString("</div>\n")
+		return gast.WalkContinue, nil
+	}
+	n := node.(*ast.Admonition)
+	classes := [][]byte{}
+	if len(r.Class) != 0 {
+		classes = append(classes, r.Class)
+	}
+	classes = append(classes, n.AdmonitionType)
+	classes = append(classes, n.Classes...)
+	_, _ = w.WriteString(`<div class="`)
+	_, _ = w.Write(util.EscapeHTML(bytes.Join(classes, []byte{' '})))
+	_ = w.WriteByte('"')
+	if n.Attributes() != nil || r.SourcePos {
+		r.RenderAttributes(w, n)
+	}
+	_, _ = w.WriteString(">\n")
+	if n.Title != nil {
+		_, _ = w.WriteString("<p")
+		if len(r.TitleClass) != 0 {
+			_, _ = w.WriteString(` class="`)
+			_, _ = w.Write(util.EscapeHTML(r.TitleClass))
+			_ = w.WriteByte('"')
+		}
+		_ = w.WriteByte('>')
+		_, _ = w.Write(util.EscapeHTML(n.Title))
+		_, _ = w.WriteString("</p>\n")
+	}
+	return gast.WalkContinue, nil
+}
+
+type admonition struct {
+	options []AdmonitionOption
+}
+
+// Admonition is an extension that allows you to use Python-Markdown style
+// admonitions like '!!! warning "Title"'.
+var Admonition = &admonition{}
+
+// NewAdmonition returns a new Extender that renders admonitions with
+// the given options.
+func NewAdmonition(opts ...AdmonitionOption) goldmark.Extender {
+	return &admonition{
+		options: opts,
+	}
+}
+
+func (e *admonition) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(parser.WithBlockParsers(
+		util.Prioritized(NewAdmonitionParser(), 999),
+	))
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(NewAdmonitionHTMLRenderer(e.options...), 500),
+	))
+}
@@ -0,0 +1,16 @@
+package extension
+
+import (
+	"testing"
+
+	"github.com/yuin/goldmark"
+)
+
+func TestAdmonition(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			Admonition,
+		),
+	)
+	goldmark.DoTestCaseFile(markdown, "_test/admonition.txt", t)
+}
@@ -0,0 +1,237 @@
+package extension
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// ErrMissingImageSize is reported to html.DiagnosticHandler when sizes of
+// images are not resolved in AMP documents.
+var ErrMissingImageSize = errors.New("amp-img requires width and height")
+
+// An AMPConfig struct is a data structure that holds configuration of the
+// AMP extension.
+type AMPConfig struct {
+	// ImageSizeProber resolves sizes of images that do not have width and
+	// height attributes. nil means sizes are not probed.
+	ImageSizeProber ImageSizeProber
+}
+
+// An AMPOption interface sets options for the AMP extension.
+type AMPOption interface {
+	SetAMPOption(*AMPConfig)
+}
+
+type withAMPImageSizeProber struct {
+	value ImageSizeProber
+}
+
+func (o *withAMPImageSizeProber) SetAMPOption(c *AMPConfig) {
+	c.ImageSizeProber = o.value
+}
+
+// WithAMPImageSizeProber is a functional option that resolves sizes of
+// images with the given prober.
+func WithAMPImageSizeProber(prober ImageSizeProber) AMPOption {
+	return &withAMPImageSizeProber{prober}
+}
+
+var attrNameStyle = []byte("style")
+
+type ampASTTransformer struct {
+}
+
+var defaultAMPASTTransformer = &ampASTTransformer{}
+
+// NewAMPASTTransformer returns a new parser.ASTTransformer that removes
+// style attributes, because AMP does not allow inline styles.
+func NewAMPASTTransformer() parser.ASTTransformer {
+	return defaultAMPASTTransformer
+}
+
+func (a *ampASTTransformer) Transform(node *gast.Document, reader text.Reader, pc parser.Context) {
+	_ = gast.Walk(node, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+		if !entering {
+			return gast.WalkContinue, nil
+		}
+		if _, ok := n.Attribute(attrNameStyle); ok {
+			attrs := n.Attributes()
+			n.RemoveAttributes()
+			for _, attr := range attrs {
+				if string(attr.Name) != string(attrNameStyle) {
+					n.SetAttribute(attr.Name, attr.Value)
+				}
+			}
+		}
+		return gast.WalkContinue, nil
+	})
+}
+
+// ampDisallowedTags is a set of tags that are not allowed in AMP documents.
+var ampDisallowedTags = map[string]bool{
+	"applet":   true,
+	"audio":    true,
+	"base":     true,
+	"embed":    true,
+	"frame":    true,
+	"frameset": true,
+	"iframe":   true,
+	"img":      true,
+	"link":     true,
+	"meta":     true,
+	"object":   true,
+	"param":    true,
+	"picture":  true,
+	"script":   true,
+	"source":   true,
+	"style":    true,
+	"video":    true,
+}
+
+// AMPRawHTMLRewriter is an html.RawHTMLRewriter that removes tags that are
+// not allowed in AMP documents like script and img tags, and removes
+// style and event handler attributes from other tags.
+// Note that texts between removed tags are left as they are.
+func AMPRawHTMLRewriter(tag *html.HTMLTag) bool {
+	if ampDisallowedTags[string(tag.Name)] {
+		return false
+	}
+	attrs := tag.Attributes[:0]
+	for _, attr := range tag.Attributes {
+		name := string(attr.Name)
+		if name == "style" || strings.HasPrefix(name, "on") {
+			continue
+		}
+		attrs = append(attrs, attr)
+	}
+	tag.Attributes = attrs
+	return true
+}
+
+// AMPHTMLRenderer is a renderer.NodeRenderer implementation that
+// renders images as amp-img elements.
+type AMPHTMLRenderer struct {
+	html.Config
+}
+
+// NewAMPHTMLRenderer returns a new AMPHTMLRenderer.
+func NewAMPHTMLRenderer(opts ...html.Option) renderer.NodeRenderer {
+	r := &AMPHTMLRenderer{
+		Config: html.NewConfig(),
+	}
+	for _, opt := range opts {
+		opt.SetHTMLOption(&r.Config)
+	}
+	return r
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *AMPHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(gast.KindImage, r.renderImage)
+}
+
+// imageSize returns a size in pixels written in the given attribute.
+func imageSize(n gast.Node, name []byte) (int, bool) {
+	v, ok := n.Attribute(name)
+	if !ok {
+		return 0, false
+	}
+	size, err := strconv.Atoi(strings.TrimSuffix(string(v), "px"))
+	return size, err == nil && size > 0
+}
+
+func (r *AMPHTMLRenderer) renderImage(w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	if !entering {
+		return gast.WalkContinue, nil
+	}
+	n := node.(*gast.Image)
+	width, wok := imageSize(n, attrNameWidth)
+	height, hok := imageSize(n, attrNameHeight)
+	if !wok || !hok {
+		// amp-img without sizes is invalid, so alternative texts are
+		// rendered instead.
+		r.ReportDiagnostic(n, ErrMissingImageSize)
+		_, _ = w.Write(util.EscapeHTML(n.Text(source)))
+		return gast.WalkSkipChildren, nil
+	}
+	_, _ = w.WriteString(`<amp-img src="`)
+	if r.Unsafe || !html.IsDangerousURL(n.Destination) {
+		_, _ = w.Write(util.EscapeHTML(util.URLEscape(n.Destination, true)))
+	}
+	_, _ = w.WriteString(`" alt="`)
+	_, _ = w.Write(util.EscapeHTML(n.Text(source)))
+	_ = w.WriteByte('"')
+	if n.Title != nil {
+		_, _ = w.WriteString(` title="`)
+		r.Writer.Write(w, n.Title)
+		_ = w.WriteByte('"')
+	}
+	_, _ = w.WriteString(` width="`)
+	_, _ = w.WriteString(strconv.Itoa(width))
+	_, _ = w.WriteString(`" height="`)
+	_, _ = w.WriteString(strconv.Itoa(height))
+	_, _ = w.WriteString(`" layout="responsive"`)
+	for _, attr := range r.Attributes(w, n) {
+		switch string(attr.Name) {
+		case "width", "height", "layout", "src", "alt", "title":
+			continue
+		}
+		_ = w.WriteByte(' ')
+		_, _ = w.Write(attr.Name)
+		_, _ = w.WriteString(`="`)
+		_, _ = w.Write(util.EscapeHTML(attr.Value))
+		_ = w.WriteByte('"')
+	}
+	_, _ = w.WriteString("></amp-img>")
+	return gast.WalkSkipChildren, nil
+}
+
+type amp struct {
+	AMPConfig
+}
+
+// NewAMP returns a new Extender that renders AMP-valid markups: images are
+// rendered as amp-img elements with sizes, style attributes are removed
+// and tags that are not allowed in AMP documents are removed from raw HTML.
+//
+// Sizes of images are read from width and height attributes(see
+// ImageDimensions and ImageSizer) or resolved by the prober set by
+// WithAMPImageSizeProber. Images whose sizes are not resolved are
+// rendered as their alternative texts.
+//
+// This extension sets AMPRawHTMLRewriter, so raw HTML is rendered even if
+// html.WithUnsafe is not set.
+func NewAMP(opts ...AMPOption) goldmark.Extender {
+	e := &amp{}
+	for _, opt := range opts {
+		opt.SetAMPOption(&e.AMPConfig)
+	}
+	return e
+}
+
+func (e *amp) Extend(m goldmark.Markdown) {
+	transformers := []util.PrioritizedValue{
+		util.Prioritized(NewAMPASTTransformer(), 1100),
+	}
+	if e.ImageSizeProber != nil {
+		transformers = append(transformers, util.Prioritized(NewImageSizerASTTransformer(
+			WithImageSizeProber(e.ImageSizeProber),
+		), 999))
+	}
+	m.Parser().AddOptions(parser.WithASTTransformers(transformers...))
+	m.Renderer().AddOptions(
+		html.WithRawHTMLRewriter(AMPRawHTMLRewriter),
+		renderer.WithNodeRenderers(
+			util.Prioritized(NewAMPHTMLRenderer(), 500),
+		),
+	)
+}
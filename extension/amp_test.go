@@ -0,0 +1,24 @@
+package extension
+
+import (
+	"testing"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/parser"
+)
+
+func TestAMP(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithParserOptions(
+			parser.WithAttribute(),
+		),
+		goldmark.WithExtensions(
+			NewAMP(
+				WithAMPImageSizeProber(NewManifestImageSizeProber(map[string]ImageSize{
+					"/images/logo.png": {Width: 120, Height: 40},
+				})),
+			),
+		),
+	)
+	goldmark.DoTestCaseFile(markdown, "_test/amp.txt", t)
+}
@@ -0,0 +1,50 @@
+package ast
+
+import (
+	"bytes"
+
+	gast "github.com/yuin/goldmark/ast"
+)
+
+// An Admonition struct represents a Python-Markdown style admonition like
+// '!!! warning "Title"' followed by an indented body.
+type Admonition struct {
+	gast.BaseBlock
+
+	// AdmonitionType is a type of the admonition like 'warning'.
+	AdmonitionType []byte
+
+	// Classes are additional classes written after the type like
+	// 'highlight' of '!!! danger highlight'.
+	Classes [][]byte
+
+	// Title is a title of the admonition. Title is nil if the admonition
+	// does not have a title like '!!! note ""'.
+	Title []byte
+}
+
+// Dump implements Node.Dump.
+func (n *Admonition) Dump(source []byte, level int) {
+	m := map[string]string{
+		"AdmonitionType": string(n.AdmonitionType),
+		"Classes":        string(bytes.Join(n.Classes, []byte{' '})),
+		"Title":          string(n.Title),
+	}
+	gast.DumpHelper(n, source, level, m, nil)
+}
+
+// KindAdmonition is a NodeKind of the Admonition node.
+var KindAdmonition = gast.NewNodeKind("Admonition")
+
+// Kind implements Node.Kind.
+func (n *Admonition) Kind() gast.NodeKind {
+	return KindAdmonition
+}
+
+// NewAdmonition returns a new Admonition node.
+func NewAdmonition(typ []byte, title []byte) *Admonition {
+	return &Admonition{
+		AdmonitionType: typ,
+		Title:          title,
+	}
+}
@@ -0,0 +1,37 @@
+package ast
+
+import (
+	gast "github.com/yuin/goldmark/ast"
+)
+
+// A BlockquoteAttribution struct represents an attribution of a blockquote
+// like '— Someone'. Children of the node are contents of the attribution
+// without the leading dash.
+type BlockquoteAttribution struct {
+	gast.BaseBlock
+
+	// Cite is a destination of the first link in the attribution. Cite is
+	// nil if the attribution does not have links.
+	Cite []byte
+}
+
+// Dump implements Node.Dump.
+func (n *BlockquoteAttribution) Dump(source []byte, level int) {
+	m := map[string]string{
+		"Cite": string(n.Cite),
+	}
+	gast.DumpHelper(n, source, level, m, nil)
+}
+
+// KindBlockquoteAttribution is a NodeKind of the BlockquoteAttribution node.
+var KindBlockquoteAttribution = gast.NewNodeKind("BlockquoteAttribution")
+
+// Kind implements Node.Kind.
+func (n *BlockquoteAttribution) Kind() gast.NodeKind {
+	return KindBlockquoteAttribution
+}
+
+// NewBlockquoteAttribution returns a new BlockquoteAttribution node.
+func NewBlockquoteAttribution() *BlockquoteAttribution {
+	return &BlockquoteAttribution{}
+}
@@ -0,0 +1,129 @@
+package ast
+
+import (
+	"bytes"
+	"fmt"
+
+	gast "github.com/yuin/goldmark/ast"
+)
+
+// CitationMode is a mode of citations.
+type CitationMode int
+
+const (
+	// NormalCitation indicates a citation like '[@doe2019]'.
+	NormalCitation CitationMode = iota
+
+	// SuppressAuthor indicates a citation like '[-@doe2019]' that
+	// suppresses names of authors.
+	SuppressAuthor
+
+	// AuthorInText indicates a citation like '@doe2019' that is a part of
+	// a sentence.
+	AuthorInText
+)
+
+func (m CitationMode) String() string {
+	switch m {
+	case NormalCitation:
+		return "NormalCitation"
+	case SuppressAuthor:
+		return "SuppressAuthor"
+	case AuthorInText:
+		return "AuthorInText"
+	}
+	return ""
+}
+
+// A CitationItem struct is a reference to an entry of a bibliography like
+// 'see @doe2019, p. 23'.
+type CitationItem struct {
+	// Key is a citation key like 'doe2019'.
+	Key []byte
+
+	// Prefix is a text before the key like 'see'.
+	Prefix []byte
+
+	// Suffix is a text after the key like ', p. 23'.
+	Suffix []byte
+
+	// Mode is a mode of the citation.
+	Mode CitationMode
+}
+
+// A Citation struct represents Pandoc style citations like
+// '[see @doe2019, p. 23; @smith2020]' and '@doe2019'.
+// Children of the node are the original texts.
+type Citation struct {
+	gast.BaseInline
+
+	// Items are citation items of the citation.
+	Items []*CitationItem
+
+	// HTML is an HTML formatted by a resolver like '(Doe 2019, p. 23)'.
+	// HTML is nil if the citation is not resolved.
+	HTML []byte
+}
+
+// Keys returns citation keys of the citation.
+func (n *Citation) Keys() [][]byte {
+	keys := make([][]byte, 0, len(n.Items))
+	for _, item := range n.Items {
+		keys = append(keys, item.Key)
+	}
+	return keys
+}
+
+// Dump implements Node.Dump.
+func (n *Citation) Dump(source []byte, level int) {
+	m := map[string]string{}
+	for i, item := range n.Items {
+		m[fmt.Sprintf("Item%d", i)] = fmt.Sprintf("%s(%s, %q, %q)",
+			item.Mode, item.Key, item.Prefix, item.Suffix)
+	}
+	m["Keys"] = string(bytes.Join(n.Keys(), []byte{' '}))
+	gast.DumpHelper(n, source, level, m, nil)
+}
+
+// KindCitation is a NodeKind of the Citation node.
+var KindCitation = gast.NewNodeKind("Citation")
+
+// Kind implements Node.Kind.
+func (n *Citation) Kind() gast.NodeKind {
+	return KindCitation
+}
+
+// NewCitation returns a new Citation node.
+func NewCitation(items []*CitationItem) *Citation {
+	return &Citation{
+		Items: items,
+	}
+}
+
+// A Bibliography struct represents a bibliography of cited entries.
+type Bibliography struct {
+	gast.BaseBlock
+
+	// HTML is an HTML formatted by a resolver.
+	HTML []byte
+}
+
+// Dump implements Node.Dump.
+func (n *Bibliography) Dump(source []byte, level int) {
+	gast.DumpHelper(n, source, level, nil, nil)
+}
+
+// KindBibliography is a NodeKind of the Bibliography node.
+var KindBibliography = gast.NewNodeKind("Bibliography")
+
+// Kind implements Node.Kind.
+func (n *Bibliography) Kind() gast.NodeKind {
+	return KindBibliography
+}
+
+// NewBibliography returns a new Bibliography node.
+func NewBibliography(html []byte) *Bibliography {
+	return &Bibliography{
+		HTML: html,
+	}
+}
@@ -0,0 +1,103 @@
+package ast
+
+import (
+	gast "github.com/yuin/goldmark/ast"
+)
+
+// A CriticAddition struct represents an addition of the CriticMarkup
+// like '{++text++}'.
+type CriticAddition struct {
+	gast.BaseInline
+}
+
+// Dump implements Node.Dump.
+func (n *CriticAddition) Dump(source []byte, level int) {
+	gast.DumpHelper(n, source, level, nil, nil)
+}
+
+// KindCriticAddition is a NodeKind of the CriticAddition node.
+var KindCriticAddition = gast.NewNodeKind("CriticAddition")
+
+// Kind implements Node.Kind.
+func (n *CriticAddition) Kind() gast.NodeKind {
+	return KindCriticAddition
+}
+
+// NewCriticAddition returns a new CriticAddition node.
+func NewCriticAddition() *CriticAddition {
+	return &CriticAddition{}
+}
+
+// A CriticDeletion struct represents a deletion of the CriticMarkup
+// like '{--text--}'.
+type CriticDeletion struct {
+	gast.BaseInline
+}
+
+// Dump implements Node.Dump.
+func (n *CriticDeletion) Dump(source []byte, level int) {
+	gast.DumpHelper(n, source, level, nil, nil)
+}
+
+// KindCriticDeletion is a NodeKind of the CriticDeletion node.
+var KindCriticDeletion = gast.NewNodeKind("CriticDeletion")
+
+// Kind implements Node.Kind.
+func (n *CriticDeletion) Kind() gast.NodeKind {
+	return KindCriticDeletion
+}
+
+// NewCriticDeletion returns a new CriticDeletion node.
+func NewCriticDeletion() *CriticDeletion {
+	return &CriticDeletion{}
+}
+
+// A CriticSubstitution struct represents a substitution of the CriticMarkup
+// like '{~~old~>new~~}'.
+// A CriticSubstitution node has exactly two children: a CriticDeletion node
+// that holds an old text and a CriticAddition node that holds a new text.
+type CriticSubstitution struct {
+	gast.BaseInline
+}
+
+// Dump implements Node.Dump.
+func (n *CriticSubstitution) Dump(source []byte, level int) {
+	gast.DumpHelper(n, source, level, nil, nil)
+}
+
+// KindCriticSubstitution is a NodeKind of the CriticSubstitution node.
+var KindCriticSubstitution = gast.NewNodeKind("CriticSubstitution")
+
+// Kind implements Node.Kind.
+func (n *CriticSubstitution) Kind() gast.NodeKind {
+	return KindCriticSubstitution
+}
+
+// NewCriticSubstitution returns a new CriticSubstitution node.
+func NewCriticSubstitution() *CriticSubstitution {
+	return &CriticSubstitution{}
+}
+
+// A CriticComment struct represents a comment of the CriticMarkup
+// like '{>>text<<}'.
+type CriticComment struct {
+	gast.BaseInline
+}
+
+// Dump implements Node.Dump.
+func (n *CriticComment) Dump(source []byte, level int) {
+	gast.DumpHelper(n, source, level, nil, nil)
+}
+
+// KindCriticComment is a NodeKind of the CriticComment node.
+var KindCriticComment = gast.NewNodeKind("CriticComment")
+
+// Kind implements Node.Kind.
+func (n *CriticComment) Kind() gast.NodeKind {
+	return KindCriticComment
+}
+
+// NewCriticComment returns a new CriticComment node.
+func NewCriticComment() *CriticComment {
+	return &CriticComment{}
+}
@@ -0,0 +1,43 @@
+package ast
+
+import (
+	gast "github.com/yuin/goldmark/ast"
+)
+
+// A CustomContainer struct represents a fenced container like
+// '::: warning' ... ':::'.
+type CustomContainer struct {
+	gast.BaseBlock
+
+	// Name is a name of the container like 'warning'.
+	Name []byte
+
+	// Info is a text written after the name like 'Title' of
+	// '::: warning Title'.
+	Info []byte
+}
+
+// Dump implements Node.Dump.
+func (n *CustomContainer) Dump(source []byte, level int) {
+	m := map[string]string{
+		"Name": string(n.Name),
+		"Info": string(n.Info),
+	}
+	gast.DumpHelper(n, source, level, m, nil)
+}
+
+// KindCustomContainer is a NodeKind of the CustomContainer node.
+var KindCustomContainer = gast.NewNodeKind("CustomContainer")
+
+// Kind implements Node.Kind.
+func (n *CustomContainer) Kind() gast.NodeKind {
+	return KindCustomContainer
+}
+
+// NewCustomContainer returns a new CustomContainer node.
+func NewCustomContainer(name, info []byte) *CustomContainer {
+	return &CustomContainer{
+		Name: name,
+		Info: info,
+	}
+}
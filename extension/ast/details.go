@@ -0,0 +1,56 @@
+package ast
+
+import (
+	"bytes"
+	"strconv"
+
+	gast "github.com/yuin/goldmark/ast"
+)
+
+// A Details struct represents a collapsible section like
+// '??? note "Summary"' followed by an indented body.
+type Details struct {
+	gast.BaseBlock
+
+	// DetailsType is a type of the section like 'note'. DetailsType is nil
+	// if the section has only a summary like '??? "Summary"'.
+	DetailsType []byte
+
+	// Classes are additional classes written after the type.
+	Classes [][]byte
+
+	// Summary is a summary of the section. Summary is nil if the section
+	// does not have a summary like '??? note ""'.
+	Summary []byte
+
+	// IsOpen is true if the section is expanded by default like '???+'.
+	IsOpen bool
+}
+
+// Dump implements Node.Dump.
+func (n *Details) Dump(source []byte, level int) {
+	m := map[string]string{
+		"DetailsType": string(n.DetailsType),
+		"Classes":     string(bytes.Join(n.Classes, []byte{' '})),
+		"Summary":     string(n.Summary),
+		"IsOpen":      strconv.FormatBool(n.IsOpen),
+	}
+	gast.DumpHelper(n, source, level, m, nil)
+}
+
+// KindDetails is a NodeKind of the Details node.
+var KindDetails = gast.NewNodeKind("Details")
+
+// Kind implements Node.Kind.
+func (n *Details) Kind() gast.NodeKind {
+	return KindDetails
+}
+
+// NewDetails returns a new Details node.
+func NewDetails(typ []byte, summary []byte, open bool) *Details {
+	return &Details{
+		DetailsType: typ,
+		Summary:     summary,
+		IsOpen:      open,
+	}
+}
@@ -0,0 +1,42 @@
+package ast
+
+import (
+	gast "github.com/yuin/goldmark/ast"
+)
+
+// A Diagram struct represents a fenced code block that contains a diagram
+// like '```mermaid'. Lines of the node are lines of the diagram.
+type Diagram struct {
+	gast.BaseBlock
+
+	// Language is a language of the diagram like 'mermaid'.
+	Language []byte
+}
+
+// IsRaw implements Node.IsRaw.
+func (n *Diagram) IsRaw() bool {
+	return true
+}
+
+// Dump implements Node.Dump.
+func (n *Diagram) Dump(source []byte, level int) {
+	m := map[string]string{
+		"Language": string(n.Language),
+	}
+	gast.DumpHelper(n, source, level, m, nil)
+}
+
+// KindDiagram is a NodeKind of the Diagram node.
+var KindDiagram = gast.NewNodeKind("Diagram")
+
+// Kind implements Node.Kind.
+func (n *Diagram) Kind() gast.NodeKind {
+	return KindDiagram
+}
+
+// NewDiagram returns a new Diagram node.
+func NewDiagram(language []byte) *Diagram {
+	return &Diagram{
+		Language: language,
+	}
+}
@@ -0,0 +1,99 @@
+package ast
+
+import (
+	"fmt"
+
+	gast "github.com/yuin/goldmark/ast"
+)
+
+// EmbedType is a type of embedded contents.
+type EmbedType int
+
+const (
+	// EmbedLink indicates embedded contents are rendered as a link card.
+	EmbedLink EmbedType = iota + 1
+
+	// EmbedPhoto indicates embedded contents are a photo.
+	EmbedPhoto
+
+	// EmbedVideo indicates embedded contents are a video player.
+	EmbedVideo
+
+	// EmbedRich indicates embedded contents are arbitrary HTML.
+	EmbedRich
+)
+
+func (t EmbedType) String() string {
+	switch t {
+	case EmbedLink:
+		return "link"
+	case EmbedPhoto:
+		return "photo"
+	case EmbedVideo:
+		return "video"
+	case EmbedRich:
+		return "rich"
+	}
+	return ""
+}
+
+// An Embed struct represents an embedded content like a video player or
+// a link card that is resolved from a bare URL.
+type Embed struct {
+	gast.BaseBlock
+
+	// URL is an embedded URL.
+	URL []byte
+
+	// EmbedType is a type of this embed.
+	EmbedType EmbedType
+
+	// ProviderName is a name of the provider like 'YouTube'.
+	ProviderName []byte
+
+	// Title is a title of the embedded content.
+	Title []byte
+
+	// Description is a description of the embedded content.
+	Description []byte
+
+	// ThumbnailURL is a URL of a thumbnail image.
+	// If EmbedType is EmbedPhoto, ThumbnailURL is a URL of the photo.
+	ThumbnailURL []byte
+
+	// HTML is an HTML snippet provided by the provider(i.e. iframes).
+	HTML []byte
+
+	// Width and Height are a size of the embedded content.
+	// 0 means unknown.
+	Width  int
+	Height int
+}
+
+// Dump implements Node.Dump.
+func (n *Embed) Dump(source []byte, level int) {
+	m := map[string]string{
+		"URL":          string(n.URL),
+		"EmbedType":    n.EmbedType.String(),
+		"ProviderName": string(n.ProviderName),
+		"Title":        string(n.Title),
+		"Size":         fmt.Sprintf("%dx%d", n.Width, n.Height),
+	}
+	gast.DumpHelper(n, source, level, m, nil)
+}
+
+// KindEmbed is a NodeKind of the Embed node.
+var KindEmbed = gast.NewNodeKind("Embed")
+
+// Kind implements Node.Kind.
+func (n *Embed) Kind() gast.NodeKind {
+	return KindEmbed
+}
+
+// NewEmbed returns a new Embed node.
+func NewEmbed(url []byte) *Embed {
+	return &Embed{
+		URL:       url,
+		EmbedType: EmbedLink,
+	}
+}
@@ -0,0 +1,46 @@
+package ast
+
+import (
+	gast "github.com/yuin/goldmark/ast"
+)
+
+// An Emoji struct represents an emoji like ':smile:'.
+type Emoji struct {
+	gast.BaseInline
+
+	// ShortName is a name of the emoji without colons like 'smile'.
+	ShortName []byte
+
+	// Value is a Unicode string of the emoji. Skin tone modifiers are
+	// already applied to Value.
+	Value []byte
+
+	// Image is a URL of the custom emoji image. Value is ignored if the
+	// emoji has an image.
+	Image []byte
+}
+
+// Dump implements Node.Dump.
+func (n *Emoji) Dump(source []byte, level int) {
+	m := map[string]string{
+		"ShortName": string(n.ShortName),
+		"Value":     string(n.Value),
+		"Image":     string(n.Image),
+	}
+	gast.DumpHelper(n, source, level, m, nil)
+}
+
+// KindEmoji is a NodeKind of the Emoji node.
+var KindEmoji = gast.NewNodeKind("Emoji")
+
+// Kind implements Node.Kind.
+func (n *Emoji) Kind() gast.NodeKind {
+	return KindEmoji
+}
+
+// NewEmoji returns a new Emoji node.
+func NewEmoji(shortName []byte) *Emoji {
+	return &Emoji{
+		ShortName: shortName,
+	}
+}
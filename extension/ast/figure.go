@@ -0,0 +1,66 @@
+package ast
+
+import (
+	"fmt"
+
+	gast "github.com/yuin/goldmark/ast"
+)
+
+// A Figure struct represents a captioned image like
+//
+//     ![alt](image.png)
+//     Caption text
+//
+// A Figure node has a TextBlock node that holds an image and
+// a FigureCaption node.
+type Figure struct {
+	gast.BaseBlock
+
+	// Number is a sequence number of this figure starting from 1.
+	// Number is 0 if figures are not numbered.
+	Number int
+}
+
+// Dump implements Node.Dump.
+func (n *Figure) Dump(source []byte, level int) {
+	m := map[string]string{
+		"Number": fmt.Sprintf("%d", n.Number),
+	}
+	gast.DumpHelper(n, source, level, m, nil)
+}
+
+// KindFigure is a NodeKind of the Figure node.
+var KindFigure = gast.NewNodeKind("Figure")
+
+// Kind implements Node.Kind.
+func (n *Figure) Kind() gast.NodeKind {
+	return KindFigure
+}
+
+// NewFigure returns a new Figure node.
+func NewFigure() *Figure {
+	return &Figure{}
+}
+
+// A FigureCaption struct represents a caption of figures.
+type FigureCaption struct {
+	gast.BaseBlock
+}
+
+// Dump implements Node.Dump.
+func (n *FigureCaption) Dump(source []byte, level int) {
+	gast.DumpHelper(n, source, level, nil, nil)
+}
+
+// KindFigureCaption is a NodeKind of the FigureCaption node.
+var KindFigureCaption = gast.NewNodeKind("FigureCaption")
+
+// Kind implements Node.Kind.
+func (n *FigureCaption) Kind() gast.NodeKind {
+	return KindFigureCaption
+}
+
+// NewFigureCaption returns a new FigureCaption node.
+func NewFigureCaption() *FigureCaption {
+	return &FigureCaption{}
+}
@@ -0,0 +1,99 @@
+package ast
+
+import (
+	gast "github.com/yuin/goldmark/ast"
+)
+
+// An IssueReference struct represents a reference to an issue or a pull
+// request like '#123', 'GH-42' and 'owner/repo#7'.
+// Children of the node are the original texts.
+type IssueReference struct {
+	gast.BaseInline
+
+	// Owner and Repository are a repository like 'owner/repo' of
+	// 'owner/repo#7'. They are empty if the reference is a reference to
+	// the current repository.
+	Owner      []byte
+	Repository []byte
+
+	// Number is a number of the issue like '123'.
+	Number []byte
+
+	// Destination is a URL of the issue.
+	Destination []byte
+}
+
+// Dump implements Node.Dump.
+func (n *IssueReference) Dump(source []byte, level int) {
+	m := map[string]string{
+		"Owner":       string(n.Owner),
+		"Repository":  string(n.Repository),
+		"Number":      string(n.Number),
+		"Destination": string(n.Destination),
+	}
+	gast.DumpHelper(n, source, level, m, nil)
+}
+
+// KindIssueReference is a NodeKind of the IssueReference node.
+var KindIssueReference = gast.NewNodeKind("IssueReference")
+
+// Kind implements Node.Kind.
+func (n *IssueReference) Kind() gast.NodeKind {
+	return KindIssueReference
+}
+
+// NewIssueReference returns a new IssueReference node.
+func NewIssueReference(owner, repository, number []byte) *IssueReference {
+	return &IssueReference{
+		Owner:      owner,
+		Repository: repository,
+		Number:     number,
+	}
+}
+
+// A CommitReference struct represents a reference to a commit like
+// a 40 characters SHA and 'owner/repo@SHA'.
+// Children of the node are the original texts.
+type CommitReference struct {
+	gast.BaseInline
+
+	// Owner and Repository are a repository like 'owner/repo' of
+	// 'owner/repo@SHA'. They are empty if the reference is a reference to
+	// the current repository.
+	Owner      []byte
+	Repository []byte
+
+	// SHA is a SHA of the commit.
+	SHA []byte
+
+	// Destination is a URL of the commit.
+	Destination []byte
+}
+
+// Dump implements Node.Dump.
+func (n *CommitReference) Dump(source []byte, level int) {
+	m := map[string]string{
+		"Owner":       string(n.Owner),
+		"Repository":  string(n.Repository),
+		"SHA":         string(n.SHA),
+		"Destination": string(n.Destination),
+	}
+	gast.DumpHelper(n, source, level, m, nil)
+}
+
+// KindCommitReference is a NodeKind of the CommitReference node.
+var KindCommitReference = gast.NewNodeKind("CommitReference")
+
+// Kind implements Node.Kind.
+func (n *CommitReference) Kind() gast.NodeKind {
+	return KindCommitReference
+}
+
+// NewCommitReference returns a new CommitReference node.
+func NewCommitReference(owner, repository, sha []byte) *CommitReference {
+	return &CommitReference{
+		Owner:      owner,
+		Repository: repository,
+		SHA:        sha,
+	}
+}
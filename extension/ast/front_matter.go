@@ -0,0 +1,30 @@
+package ast
+
+import (
+	gast "github.com/yuin/goldmark/ast"
+)
+
+// A FrontMatter struct represents YAML front matter at the beginning of
+// a document like '---\ntitle: Hello\n---'.
+// Lines of the node are lines between the delimiters.
+type FrontMatter struct {
+	gast.BaseBlock
+}
+
+// Dump implements Node.Dump.
+func (n *FrontMatter) Dump(source []byte, level int) {
+	gast.DumpHelper(n, source, level, nil, nil)
+}
+
+// KindFrontMatter is a NodeKind of the FrontMatter node.
+var KindFrontMatter = gast.NewNodeKind("FrontMatter")
+
+// Kind implements Node.Kind.
+func (n *FrontMatter) Kind() gast.NodeKind {
+	return KindFrontMatter
+}
+
+// NewFrontMatter returns a new FrontMatter node.
+func NewFrontMatter() *FrontMatter {
+	return &FrontMatter{}
+}
@@ -0,0 +1,42 @@
+package ast
+
+import (
+	gast "github.com/yuin/goldmark/ast"
+)
+
+// A Hashtag struct represents a hashtag like '#tag'.
+// Children of the node are the original texts.
+type Hashtag struct {
+	gast.BaseInline
+
+	// Tag is a tag without '#' like 'tag'.
+	Tag []byte
+
+	// Destination is a URL of the tag. Destination is empty if the tag is
+	// not linked.
+	Destination []byte
+}
+
+// Dump implements Node.Dump.
+func (n *Hashtag) Dump(source []byte, level int) {
+	m := map[string]string{
+		"Tag":         string(n.Tag),
+		"Destination": string(n.Destination),
+	}
+	gast.DumpHelper(n, source, level, m, nil)
+}
+
+// KindHashtag is a NodeKind of the Hashtag node.
+var KindHashtag = gast.NewNodeKind("Hashtag")
+
+// Kind implements Node.Kind.
+func (n *Hashtag) Kind() gast.NodeKind {
+	return KindHashtag
+}
+
+// NewHashtag returns a new Hashtag node.
+func NewHashtag(tag []byte) *Hashtag {
+	return &Hashtag{
+		Tag: tag,
+	}
+}
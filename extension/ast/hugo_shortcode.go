@@ -0,0 +1,156 @@
+package ast
+
+import (
+	"bytes"
+	"strconv"
+
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/text"
+)
+
+// A HugoShortcodeParam struct is a parameter of Hugo shortcodes like
+// 'title="Note"'.
+type HugoShortcodeParam struct {
+	// Name is a name of the parameter. Name is nil if the parameter is a
+	// positional parameter.
+	Name []byte
+
+	// Value is a value of the parameter without quotes.
+	Value []byte
+}
+
+// A HugoShortcodeCall struct is a call of a Hugo shortcode like
+// '{{< figure src="a.png" >}}'.
+type HugoShortcodeCall struct {
+	// Name is a name of the shortcode like 'figure'.
+	Name []byte
+
+	// Params is a list of parameters of the shortcode.
+	Params []HugoShortcodeParam
+
+	// IsMarkdown is true if the shortcode is delimited by '{{%' and '%}}',
+	// so its inner contents are Markdown.
+	IsMarkdown bool
+
+	// IsClosing is true if the shortcode is a closing tag like
+	// '{{< /note >}}'.
+	IsClosing bool
+
+	// IsSelfClosing is true if the shortcode is closed by '/>}}'.
+	IsSelfClosing bool
+
+	// Segment is a segment of the shortcode in the source.
+	Segment text.Segment
+}
+
+// Param returns a value of the given named parameter and true if the
+// shortcode has the parameter, otherwise nil and false.
+func (c *HugoShortcodeCall) Param(name string) ([]byte, bool) {
+	for _, p := range c.Params {
+		if p.Name != nil && string(p.Name) == name {
+			return p.Value, true
+		}
+	}
+	return nil, false
+}
+
+// Positional returns a value of the i-th positional parameter and true if
+// the shortcode has the parameter, otherwise nil and false.
+func (c *HugoShortcodeCall) Positional(i int) ([]byte, bool) {
+	for _, p := range c.Params {
+		if p.Name != nil {
+			continue
+		}
+		if i == 0 {
+			return p.Value, true
+		}
+		i--
+	}
+	return nil, false
+}
+
+func (c *HugoShortcodeCall) dump() map[string]string {
+	params := make([][]byte, 0, len(c.Params))
+	for _, p := range c.Params {
+		if p.Name != nil {
+			params = append(params, []byte(string(p.Name)+"="+strconv.Quote(string(p.Value))))
+		} else {
+			params = append(params, []byte(strconv.Quote(string(p.Value))))
+		}
+	}
+	return map[string]string{
+		"Name":          string(c.Name),
+		"Params":        string(bytes.Join(params, []byte{' '})),
+		"IsMarkdown":    strconv.FormatBool(c.IsMarkdown),
+		"IsClosing":     strconv.FormatBool(c.IsClosing),
+		"IsSelfClosing": strconv.FormatBool(c.IsSelfClosing),
+	}
+}
+
+// A HugoShortcode struct represents a Hugo shortcode in a paragraph like
+// 'Go to {{< ref "about" >}}'.
+type HugoShortcode struct {
+	gast.BaseInline
+	HugoShortcodeCall
+}
+
+// Dump implements Node.Dump.
+func (n *HugoShortcode) Dump(source []byte, level int) {
+	gast.DumpHelper(n, source, level, n.dump(), nil)
+}
+
+// KindHugoShortcode is a NodeKind of the HugoShortcode node.
+var KindHugoShortcode = gast.NewNodeKind("HugoShortcode")
+
+// Kind implements Node.Kind.
+func (n *HugoShortcode) Kind() gast.NodeKind {
+	return KindHugoShortcode
+}
+
+// NewHugoShortcode returns a new HugoShortcode node.
+func NewHugoShortcode(call HugoShortcodeCall) *HugoShortcode {
+	return &HugoShortcode{
+		HugoShortcodeCall: call,
+	}
+}
+
+// A HugoShortcodeBlock struct represents a Hugo shortcode written on its
+// own line like '{{% note %}}'.
+// If the shortcode has a closing tag, the block has inner contents: children
+// parsed as Markdown for '{{% %}}' shortcodes, or raw lines for '{{< >}}'
+// shortcodes.
+type HugoShortcodeBlock struct {
+	gast.BaseBlock
+	HugoShortcodeCall
+
+	// Closure is a closing tag like '{{% /note %}}'. Closure is nil if the
+	// shortcode does not have a closing tag.
+	Closure *HugoShortcodeCall
+}
+
+// IsRaw implements Node.IsRaw.
+func (n *HugoShortcodeBlock) IsRaw() bool {
+	return !n.IsMarkdown
+}
+
+// Dump implements Node.Dump.
+func (n *HugoShortcodeBlock) Dump(source []byte, level int) {
+	m := n.dump()
+	m["HasClosure"] = strconv.FormatBool(n.Closure != nil)
+	gast.DumpHelper(n, source, level, m, nil)
+}
+
+// KindHugoShortcodeBlock is a NodeKind of the HugoShortcodeBlock node.
+var KindHugoShortcodeBlock = gast.NewNodeKind("HugoShortcodeBlock")
+
+// Kind implements Node.Kind.
+func (n *HugoShortcodeBlock) Kind() gast.NodeKind {
+	return KindHugoShortcodeBlock
+}
+
+// NewHugoShortcodeBlock returns a new HugoShortcodeBlock node.
+func NewHugoShortcodeBlock(call HugoShortcodeCall) *HugoShortcodeBlock {
+	return &HugoShortcodeBlock{
+		HugoShortcodeCall: call,
+	}
+}
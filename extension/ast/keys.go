@@ -0,0 +1,67 @@
+package ast
+
+import (
+	gast "github.com/yuin/goldmark/ast"
+)
+
+// A Keys struct represents a key combination like '++ctrl+alt+delete++'.
+// Children of the node are Key nodes.
+type Keys struct {
+	gast.BaseInline
+}
+
+// Dump implements Node.Dump.
+func (n *Keys) Dump(source []byte, level int) {
+	gast.DumpHelper(n, source, level, nil, nil)
+}
+
+// KindKeys is a NodeKind of the Keys node.
+var KindKeys = gast.NewNodeKind("Keys")
+
+// Kind implements Node.Kind.
+func (n *Keys) Kind() gast.NodeKind {
+	return KindKeys
+}
+
+// NewKeys returns a new Keys node.
+func NewKeys() *Keys {
+	return &Keys{}
+}
+
+// A Key struct represents a key of a key combination.
+type Key struct {
+	gast.BaseInline
+
+	// Name is a normalized name of the key like 'control'. Name is empty
+	// if the key is written as a quoted label like '"My Key"' or an
+	// undefined punctuation like ','.
+	Name []byte
+
+	// Label is a text of the key like 'Ctrl'.
+	Label []byte
+}
+
+// Dump implements Node.Dump.
+func (n *Key) Dump(source []byte, level int) {
+	m := map[string]string{
+		"Name":  string(n.Name),
+		"Label": string(n.Label),
+	}
+	gast.DumpHelper(n, source, level, m, nil)
+}
+
+// KindKey is a NodeKind of the Key node.
+var KindKey = gast.NewNodeKind("Key")
+
+// Kind implements Node.Kind.
+func (n *Key) Kind() gast.NodeKind {
+	return KindKey
+}
+
+// NewKey returns a new Key node.
+func NewKey(name, label []byte) *Key {
+	return &Key{
+		Name:  name,
+		Label: label,
+	}
+}
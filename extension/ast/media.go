@@ -0,0 +1,68 @@
+package ast
+
+import (
+	gast "github.com/yuin/goldmark/ast"
+)
+
+// MediaType is a type of media.
+type MediaType int
+
+const (
+	// MediaVideo indicates a media is a video.
+	MediaVideo MediaType = iota + 1
+
+	// MediaAudio indicates a media is an audio.
+	MediaAudio
+)
+
+func (t MediaType) String() string {
+	switch t {
+	case MediaVideo:
+		return "video"
+	case MediaAudio:
+		return "audio"
+	}
+	return ""
+}
+
+// A Media struct represents a video or an audio written in the image syntax
+// like '![alt](movie.mp4)'. Children of a Media node are a fallback content.
+type Media struct {
+	gast.BaseInline
+
+	// MediaType is a type of this media.
+	MediaType MediaType
+
+	// Destination is a destination(URL) of this media.
+	Destination []byte
+
+	// Title is a title of this media.
+	Title []byte
+}
+
+// Dump implements Node.Dump.
+func (n *Media) Dump(source []byte, level int) {
+	m := map[string]string{
+		"MediaType":   n.MediaType.String(),
+		"Destination": string(n.Destination),
+		"Title":       string(n.Title),
+	}
+	gast.DumpHelper(n, source, level, m, nil)
+}
+
+// KindMedia is a NodeKind of the Media node.
+var KindMedia = gast.NewNodeKind("Media")
+
+// Kind implements Node.Kind.
+func (n *Media) Kind() gast.NodeKind {
+	return KindMedia
+}
+
+// NewMedia returns a new Media node.
+func NewMedia(typ MediaType, destination, title []byte) *Media {
+	return &Media{
+		MediaType:   typ,
+		Destination: destination,
+		Title:       title,
+	}
+}
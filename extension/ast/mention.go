@@ -0,0 +1,42 @@
+package ast
+
+import (
+	gast "github.com/yuin/goldmark/ast"
+)
+
+// A Mention struct represents a mention like '@username'.
+// Children of the node are the original texts.
+type Mention struct {
+	gast.BaseInline
+
+	// Username is a name without '@' like 'username'.
+	Username []byte
+
+	// Destination is a URL of the user like a profile page. Destination
+	// is empty if the mention is not linked.
+	Destination []byte
+}
+
+// Dump implements Node.Dump.
+func (n *Mention) Dump(source []byte, level int) {
+	m := map[string]string{
+		"Username":    string(n.Username),
+		"Destination": string(n.Destination),
+	}
+	gast.DumpHelper(n, source, level, m, nil)
+}
+
+// KindMention is a NodeKind of the Mention node.
+var KindMention = gast.NewNodeKind("Mention")
+
+// Kind implements Node.Kind.
+func (n *Mention) Kind() gast.NodeKind {
+	return KindMention
+}
+
+// NewMention returns a new Mention node.
+func NewMention(username []byte) *Mention {
+	return &Mention{
+		Username: username,
+	}
+}
@@ -0,0 +1,56 @@
+package ast
+
+import (
+	"fmt"
+	"strings"
+
+	gast "github.com/yuin/goldmark/ast"
+)
+
+// PrefersDarkColorScheme is a media query that matches dark color schemes.
+var PrefersDarkColorScheme = []byte("(prefers-color-scheme: dark)")
+
+// A PictureSource struct represents an alternative source of a picture.
+type PictureSource struct {
+	// Srcset is a destination(URL) of this source.
+	Srcset []byte
+
+	// Media is a media query that selects this source.
+	Media []byte
+}
+
+// A Picture struct represents an image that has alternative sources like
+// a dark mode variant. A child of a Picture node is a fallback Image.
+type Picture struct {
+	gast.BaseInline
+
+	// Sources is a list of alternative sources of this picture.
+	Sources []PictureSource
+}
+
+// Dump implements Node.Dump.
+func (n *Picture) Dump(source []byte, level int) {
+	sources := make([]string, 0, len(n.Sources))
+	for _, s := range n.Sources {
+		sources = append(sources, fmt.Sprintf("%s %s", s.Srcset, s.Media))
+	}
+	m := map[string]string{
+		"Sources": strings.Join(sources, ", "),
+	}
+	gast.DumpHelper(n, source, level, m, nil)
+}
+
+// KindPicture is a NodeKind of the Picture node.
+var KindPicture = gast.NewNodeKind("Picture")
+
+// Kind implements Node.Kind.
+func (n *Picture) Kind() gast.NodeKind {
+	return KindPicture
+}
+
+// NewPicture returns a new Picture node.
+func NewPicture(sources ...PictureSource) *Picture {
+	return &Picture{
+		Sources: sources,
+	}
+}
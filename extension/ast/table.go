@@ -130,10 +130,40 @@ func NewTableHeader(row *TableRow) *TableHeader {
 	return n
 }
 
+// A TableFooter struct represents a table footer that consists of
+// TableRows.
+type TableFooter struct {
+	gast.BaseBlock
+}
+
+// KindTableFooter is a NodeKind of the TableFooter node.
+var KindTableFooter = gast.NewNodeKind("TableFooter")
+
+// Kind implements Node.Kind.
+func (n *TableFooter) Kind() gast.NodeKind {
+	return KindTableFooter
+}
+
+// Dump implements Node.Dump.
+func (n *TableFooter) Dump(source []byte, level int) {
+	gast.DumpHelper(n, source, level, nil, nil)
+}
+
+// NewTableFooter returns a new TableFooter node.
+func NewTableFooter() *TableFooter {
+	return &TableFooter{}
+}
+
 // A TableCell struct represents a table cell of a Markdown(GFM) text.
 type TableCell struct {
 	gast.BaseBlock
 	Alignment Alignment
+
+	// ColSpan is a number of columns this cell spans.
+	ColSpan int
+
+	// RowSpan is a number of rows this cell spans.
+	RowSpan int
 }
 
 // Dump implements Node.Dump.
@@ -153,5 +183,7 @@ func (n *TableCell) Kind() gast.NodeKind {
 func NewTableCell() *TableCell {
 	return &TableCell{
 		Alignment: AlignNone,
+		ColSpan:   1,
+		RowSpan:   1,
 	}
 }
@@ -9,12 +9,16 @@ import (
 type TaskCheckBox struct {
 	gast.BaseInline
 	IsChecked bool
+
+	// State is a character between brackets like ' ', 'x' and '-'.
+	State byte
 }
 
 // Dump impelemtns Node.Dump.
 func (n *TaskCheckBox) Dump(source []byte, level int) {
 	m := map[string]string{
 		"Checked": fmt.Sprintf("%v", n.IsChecked),
+		"State":   fmt.Sprintf("%q", n.State),
 	}
 	gast.DumpHelper(n, source, level, m, nil)
 }
@@ -29,7 +33,21 @@ func (n *TaskCheckBox) Kind() gast.NodeKind {
 
 // NewTaskCheckBox returns a new TaskCheckBox node.
 func NewTaskCheckBox(checked bool) *TaskCheckBox {
+	state := byte(' ')
+	if checked {
+		state = 'x'
+	}
 	return &TaskCheckBox{
 		IsChecked: checked,
+		State:     state,
+	}
+}
+
+// NewTaskCheckBoxState returns a new TaskCheckBox node with the given
+// state like '-'. Tasks are checked if the state is 'x' or 'X'.
+func NewTaskCheckBoxState(state byte) *TaskCheckBox {
+	return &TaskCheckBox{
+		IsChecked: state == 'x' || state == 'X',
+		State:     state,
 	}
 }
@@ -0,0 +1,93 @@
+package ast
+
+import (
+	"fmt"
+
+	gast "github.com/yuin/goldmark/ast"
+)
+
+// WikiEmbedType is a type of contents embedded by wiki embeds.
+type WikiEmbedType int
+
+const (
+	// WikiEmbedImage indicates an embedded content is an image.
+	WikiEmbedImage WikiEmbedType = iota + 1
+
+	// WikiEmbedNote indicates an embedded content is a note rendered as
+	// HTML.
+	WikiEmbedNote
+
+	// WikiEmbedFile indicates an embedded content is a file that is
+	// rendered as a link.
+	WikiEmbedFile
+)
+
+func (t WikiEmbedType) String() string {
+	switch t {
+	case WikiEmbedImage:
+		return "image"
+	case WikiEmbedNote:
+		return "note"
+	case WikiEmbedFile:
+		return "file"
+	}
+	return ""
+}
+
+// A WikiEmbed struct represents an embed like '![[note]]' or
+// '![[image.png|300]]'. Children of the node are display texts.
+type WikiEmbed struct {
+	gast.BaseInline
+
+	// Target is a name of the embedded note or file like 'image.png'.
+	Target []byte
+
+	// Fragment is a fragment like 'Section' of '![[note#Section]]'.
+	Fragment []byte
+
+	// EmbedType is a type of the embedded content.
+	EmbedType WikiEmbedType
+
+	// Destination is a URL of the embedded image or file.
+	Destination []byte
+
+	// HTML is an HTML snippet of the embedded note.
+	HTML []byte
+
+	// Width and Height are a size written like '![[image.png|300x200]]'.
+	// 0 means unspecified.
+	Width  int
+	Height int
+
+	// Exists is true if the embedded content exists.
+	Exists bool
+}
+
+// Dump implements Node.Dump.
+func (n *WikiEmbed) Dump(source []byte, level int) {
+	m := map[string]string{
+		"Target":      string(n.Target),
+		"Fragment":    string(n.Fragment),
+		"EmbedType":   n.EmbedType.String(),
+		"Destination": string(n.Destination),
+		"Size":        fmt.Sprintf("%dx%d", n.Width, n.Height),
+		"Exists":      fmt.Sprintf("%v", n.Exists),
+	}
+	gast.DumpHelper(n, source, level, m, nil)
+}
+
+// KindWikiEmbed is a NodeKind of the WikiEmbed node.
+var KindWikiEmbed = gast.NewNodeKind("WikiEmbed")
+
+// Kind implements Node.Kind.
+func (n *WikiEmbed) Kind() gast.NodeKind {
+	return KindWikiEmbed
+}
+
+// NewWikiEmbed returns a new WikiEmbed node.
+func NewWikiEmbed(target, fragment []byte) *WikiEmbed {
+	return &WikiEmbed{
+		Target:   target,
+		Fragment: fragment,
+	}
+}
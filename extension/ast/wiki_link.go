@@ -0,0 +1,52 @@
+package ast
+
+import (
+	"fmt"
+
+	gast "github.com/yuin/goldmark/ast"
+)
+
+// A WikiLink struct represents a wiki link like '[[Page Name|display]]'.
+// Children of the node are display texts.
+type WikiLink struct {
+	gast.BaseInline
+
+	// Target is a page name like 'Page Name'.
+	Target []byte
+
+	// Fragment is a fragment like 'Section' of '[[Page Name#Section]]'.
+	Fragment []byte
+
+	// Destination is a URL of the page resolved by a WikiLinkResolver.
+	Destination []byte
+
+	// Exists is true if the page exists.
+	Exists bool
+}
+
+// Dump implements Node.Dump.
+func (n *WikiLink) Dump(source []byte, level int) {
+	m := map[string]string{
+		"Target":      string(n.Target),
+		"Fragment":    string(n.Fragment),
+		"Destination": string(n.Destination),
+		"Exists":      fmt.Sprintf("%v", n.Exists),
+	}
+	gast.DumpHelper(n, source, level, m, nil)
+}
+
+// KindWikiLink is a NodeKind of the WikiLink node.
+var KindWikiLink = gast.NewNodeKind("WikiLink")
+
+// Kind implements Node.Kind.
+func (n *WikiLink) Kind() gast.NodeKind {
+	return KindWikiLink
+}
+
+// NewWikiLink returns a new WikiLink node.
+func NewWikiLink(target, fragment []byte) *WikiLink {
+	return &WikiLink{
+		Target:   target,
+		Fragment: fragment,
+	}
+}
@@ -0,0 +1,84 @@
+package extension
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+)
+
+// parseTrailingAttributes parses an attribute list like '{.class key=value}'
+// just after the given inline node and sets it to the node.
+// parseTrailingAttributes returns true if an attribute list was found.
+func parseTrailingAttributes(n gast.Node, source []byte) bool {
+	t, ok := n.NextSibling().(*gast.Text)
+	if !ok || t.Segment.Padding != 0 || t.Segment.IsEmpty() || source[t.Segment.Start] != '{' {
+		return false
+	}
+	stop := bytes.IndexByte(source[t.Segment.Start:], '\n')
+	if stop < 0 {
+		stop = len(source)
+	} else {
+		stop += t.Segment.Start
+	}
+	// parser.ParseAttributes requires a closing '}'
+	if bytes.IndexByte(source[t.Segment.Start:stop], '}') < 0 {
+		return false
+	}
+	r := text.NewReader(source[t.Segment.Start:stop])
+	attrs, ok := parser.ParseAttributes(r)
+	if !ok {
+		return false
+	}
+	_, pos := r.Position()
+	setAttributes(n, attrs)
+	consumeText(t, pos.Start)
+	return true
+}
+
+// setAttributes sets the given attributes parsed by parser.ParseAttributes
+// to the given node.
+func setAttributes(n gast.Node, attrs map[string]interface{}) {
+	names := make([]string, 0, len(attrs))
+	for name := range attrs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		var value []byte
+		switch v := attrs[name].(type) {
+		case []byte:
+			value = v
+		case [][]byte:
+			value = bytes.Join(v, []byte{' '})
+		case nil:
+			value = []byte{}
+		default:
+			value = []byte(fmt.Sprint(v))
+		}
+		n.SetAttribute([]byte(name), value)
+	}
+}
+
+// consumeText removes the given length of bytes from the head of the given
+// text and following texts.
+func consumeText(t *gast.Text, length int) {
+	for t != nil && length > 0 {
+		next, _ := t.NextSibling().(*gast.Text)
+		l := t.Segment.Len()
+		if l > length || t.SoftLineBreak() || t.HardLineBreak() {
+			if l > length {
+				t.Segment = t.Segment.WithStart(t.Segment.Start + length)
+			} else {
+				t.Segment = t.Segment.WithStart(t.Segment.Stop)
+			}
+			return
+		}
+		t.Parent().RemoveChild(t.Parent(), t)
+		length -= l
+		t = next
+	}
+}
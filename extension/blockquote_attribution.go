@@ -0,0 +1,110 @@
+package extension
+
+import (
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+type blockquoteAttributionASTTransformer struct {
+}
+
+var defaultBlockquoteAttributionASTTransformer = &blockquoteAttributionASTTransformer{}
+
+// NewBlockquoteAttributionASTTransformer returns a new ASTTransformer that
+// replaces an attribution like '— Someone' on the last paragraph of
+// blockquotes with a BlockquoteAttribution node.
+// Blockquotes that consist solely of an attribution are left as they are.
+func NewBlockquoteAttributionASTTransformer() parser.ASTTransformer {
+	return defaultBlockquoteAttributionASTTransformer
+}
+
+func (a *blockquoteAttributionASTTransformer) Transform(node *gast.Document, reader text.Reader, pc parser.Context) {
+	source := reader.Source()
+	var paragraphs []gast.Node
+	_ = gast.Walk(node, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+		if !entering || n.Kind() != gast.KindBlockquote {
+			return gast.WalkContinue, nil
+		}
+		if n.ChildCount() > 1 {
+			if t, _ := attributionPrefix(n.LastChild(), source); t != nil {
+				paragraphs = append(paragraphs, n.LastChild())
+			}
+		}
+		return gast.WalkContinue, nil
+	})
+	for _, paragraph := range paragraphs {
+		attribution := ast.NewBlockquoteAttribution()
+		attribution.Cite = attributionDestination(paragraph, source)
+		t, l := attributionPrefix(paragraph, source)
+		t.Segment = t.Segment.WithStart(t.Segment.Start + l)
+		if t.Segment.IsEmpty() {
+			paragraph.RemoveChild(paragraph, t)
+		}
+		attribution.SetLines(paragraph.Lines())
+		attribution.SetBlankPreviousLines(paragraph.HasBlankPreviousLines())
+		moveChildren(attribution, paragraph.FirstChild())
+		paragraph.Parent().ReplaceChild(paragraph.Parent(), paragraph, attribution)
+	}
+}
+
+// BlockquoteAttributionHTMLRenderer is a renderer.NodeRenderer implementation
+// that renders BlockquoteAttribution nodes.
+type BlockquoteAttributionHTMLRenderer struct {
+	html.Config
+}
+
+// NewBlockquoteAttributionHTMLRenderer returns a new
+// BlockquoteAttributionHTMLRenderer.
+func NewBlockquoteAttributionHTMLRenderer(opts ...html.Option) renderer.NodeRenderer {
+	r := &BlockquoteAttributionHTMLRenderer{
+		Config: html.NewConfig(),
+	}
+	for _, opt := range opts {
+		opt.SetHTMLOption(&r.Config)
+	}
+	return r
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *BlockquoteAttributionHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindBlockquoteAttribution, r.renderBlockquoteAttribution)
+}
+
+func (r *BlockquoteAttributionHTMLRenderer) renderBlockquoteAttribution(w util.BufWriter, source []byte, n gast.Node, entering bool) (gast.WalkStatus, error) {
+	if entering {
+		_, _ = w.WriteString("<footer")
+		if n.Attributes() != nil || r.SourcePos {
+			r.RenderAttributes(w, n)
+		}
+		_, _ = w.WriteString("><cite>")
+	} else {
+		_, _ = w.WriteString("</cite></footer>\n")
+	}
+	return gast.WalkContinue, nil
+}
+
+type blockquoteAttribution struct {
+}
+
+// BlockquoteAttribution is an extension that renders an attribution like
+// '— Someone' on the last paragraph of blockquotes as
+// '<footer><cite>Someone</cite></footer>'.
+// This extension can be used with BlockquoteCite.
+var BlockquoteAttribution = &blockquoteAttribution{}
+
+func (e *blockquoteAttribution) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithASTTransformers(
+			util.Prioritized(NewBlockquoteAttributionASTTransformer(), 600),
+		),
+	)
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(NewBlockquoteAttributionHTMLRenderer(), 500),
+	))
+}
@@ -0,0 +1,17 @@
+package extension
+
+import (
+	"testing"
+
+	"github.com/yuin/goldmark"
+)
+
+func TestBlockquoteAttribution(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			BlockquoteCite,
+			BlockquoteAttribution,
+		),
+	)
+	goldmark.DoTestCaseFile(markdown, "_test/blockquote_attribution.txt", t)
+}
@@ -0,0 +1,154 @@
+package extension
+
+import (
+	"bytes"
+
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+var attrNameCite = []byte("cite")
+
+type blockquoteAttributeParagraphTransformer struct {
+}
+
+var defaultBlockquoteAttributeParagraphTransformer = &blockquoteAttributeParagraphTransformer{}
+
+// NewBlockquoteAttributeParagraphTransformer returns a new ParagraphTransformer
+// that extracts an attribute list like '{cite="https://example.com/"}' on the
+// last line of paragraphs in blockquotes and sets it to the blockquotes.
+func NewBlockquoteAttributeParagraphTransformer() parser.ParagraphTransformer {
+	return defaultBlockquoteAttributeParagraphTransformer
+}
+
+func (p *blockquoteAttributeParagraphTransformer) Transform(node *gast.Paragraph, reader text.Reader, pc parser.Context) {
+	blockquote, ok := node.Parent().(*gast.Blockquote)
+	if !ok {
+		return
+	}
+	lines := node.Lines()
+	if lines.Len() == 0 {
+		return
+	}
+	last := lines.At(lines.Len() - 1)
+	line := util.TrimRightSpace(util.TrimLeftSpace(last.Value(reader.Source())))
+	if len(line) < 2 || line[0] != '{' || line[len(line)-1] != '}' {
+		return
+	}
+	r := text.NewReader(line)
+	attrs, ok := parser.ParseAttributes(r)
+	if !ok {
+		return
+	}
+	if _, pos := r.Position(); pos.Start != len(line) {
+		return
+	}
+	setAttributes(blockquote, attrs)
+	lines.SetSliced(0, lines.Len()-1)
+	if lines.Len() == 0 {
+		t := gast.NewTextBlock()
+		t.SetBlankPreviousLines(node.HasBlankPreviousLines())
+		node.Parent().ReplaceChild(node.Parent(), node, t)
+		return
+	}
+	last = lines.At(lines.Len() - 1)
+	lines.Set(lines.Len()-1, last.TrimRightSpace(reader.Source()))
+	node.SetLines(lines)
+}
+
+var attributionPrefixes = [][]byte{
+	[]byte("—"),
+	[]byte("―"),
+	[]byte("--"),
+}
+
+type blockquoteAttributionTransformer struct {
+}
+
+var defaultBlockquoteAttributionTransformer = &blockquoteAttributionTransformer{}
+
+// NewBlockquoteAttributionTransformer returns a new ASTTransformer that
+// sets a cite attribute of blockquotes from a link in an attribution like
+// '— [Author](https://example.com/)' on the last paragraph of blockquotes.
+// Blockquotes that already have a cite attribute are left as they are.
+func NewBlockquoteAttributionTransformer() parser.ASTTransformer {
+	return defaultBlockquoteAttributionTransformer
+}
+
+func (a *blockquoteAttributionTransformer) Transform(node *gast.Document, reader text.Reader, pc parser.Context) {
+	source := reader.Source()
+	_ = gast.Walk(node, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+		if !entering || n.Kind() != gast.KindBlockquote {
+			return gast.WalkContinue, nil
+		}
+		if _, ok := n.Attribute(attrNameCite); ok {
+			return gast.WalkContinue, nil
+		}
+		if cite := attributionDestination(n.LastChild(), source); cite != nil {
+			n.SetAttribute(attrNameCite, cite)
+		}
+		return gast.WalkContinue, nil
+	})
+}
+
+// attributionPrefix returns the first text of the given attribution
+// paragraph and a length of the leading dash including surrounding spaces,
+// or nil if the given node is not an attribution.
+func attributionPrefix(n gast.Node, source []byte) (*gast.Text, int) {
+	if n == nil || n.Kind() != gast.KindParagraph {
+		return nil, 0
+	}
+	t, ok := n.FirstChild().(*gast.Text)
+	if !ok {
+		return nil, 0
+	}
+	value := t.Segment.Value(source)
+	trimmed := util.TrimLeftSpace(value)
+	for _, prefix := range attributionPrefixes {
+		if bytes.HasPrefix(trimmed, prefix) {
+			rest := util.TrimLeftSpace(trimmed[len(prefix):])
+			return t, len(value) - len(rest)
+		}
+	}
+	return nil, 0
+}
+
+// attributionDestination returns a destination of the first link in the
+// given attribution paragraph, or nil if the given node is not an attribution.
+func attributionDestination(n gast.Node, source []byte) []byte {
+	if t, _ := attributionPrefix(n, source); t == nil {
+		return nil
+	}
+	for c := n.FirstChild(); c != nil; c = c.NextSibling() {
+		switch v := c.(type) {
+		case *gast.Link:
+			return v.Destination
+		case *gast.AutoLink:
+			return v.URL(source)
+		}
+	}
+	return nil
+}
+
+type blockquoteCite struct {
+}
+
+// BlockquoteCite is an extension that allow you to attach cite URLs to
+// blockquotes with an attribute list like '{cite="https://example.com/"}' on
+// the last line of a quoted paragraph or an attribution like
+// '— [Author](https://example.com/)'.
+var BlockquoteCite = &blockquoteCite{}
+
+func (e *blockquoteCite) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithParagraphTransformers(
+			util.Prioritized(NewBlockquoteAttributeParagraphTransformer(), 200),
+		),
+		parser.WithASTTransformers(
+			util.Prioritized(NewBlockquoteAttributionTransformer(), 500),
+		),
+	)
+}
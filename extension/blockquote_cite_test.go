@@ -0,0 +1,16 @@
+package extension
+
+import (
+	"testing"
+
+	"github.com/yuin/goldmark"
+)
+
+func TestBlockquoteCite(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			BlockquoteCite,
+		),
+	)
+	goldmark.DoTestCaseFile(markdown, "_test/blockquote_cite.txt", t)
+}
@@ -0,0 +1,333 @@
+package extension
+
+import (
+	"bytes"
+	"unicode"
+
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// A CitationResolver interface formats citations and bibliographies like
+// citeproc does.
+type CitationResolver interface {
+	// FormatCitation returns an HTML of the given citation like
+	// '(Doe 2019, p. 23)'. FormatCitation is called in order of appearance
+	// in a document. FormatCitation returns false if the citation can not
+	// be resolved like keys are not found in a bibliography.
+	FormatCitation(citation *ast.Citation) ([]byte, bool)
+
+	// FormatBibliography returns an HTML of a bibliography of the given
+	// keys in order of first appearance in a document. An empty HTML
+	// means the document does not have a bibliography.
+	FormatBibliography(keys [][]byte) []byte
+}
+
+// A CitationConfig struct is a data structure that holds configuration of
+// the Citation extension.
+type CitationConfig struct {
+	// Resolver formats citations and bibliographies. Citations are
+	// rendered as they are written if Resolver is nil.
+	Resolver CitationResolver
+}
+
+// NewCitationConfig returns a new CitationConfig with defaults.
+func NewCitationConfig() CitationConfig {
+	return CitationConfig{}
+}
+
+// A CitationOption interface sets options for the Citation extension.
+type CitationOption interface {
+	SetCitationOption(*CitationConfig)
+}
+
+type withCitationResolver struct {
+	value CitationResolver
+}
+
+func (o *withCitationResolver) SetCitationOption(c *CitationConfig) {
+	c.Resolver = o.value
+}
+
+// WithCitationResolver is a functional option that sets a resolver that
+// formats citations and bibliographies.
+func WithCitationResolver(resolver CitationResolver) CitationOption {
+	return &withCitationResolver{resolver}
+}
+
+type citationParser struct {
+}
+
+var defaultCitationParser = &citationParser{}
+
+// NewCitationParser returns a new parser.InlineParser that can parse
+// Pandoc style citations like '[see @doe2019, p. 23; @smith2020]',
+// '[-@doe2019]' and '@doe2019 [p. 23]'.
+func NewCitationParser() parser.InlineParser {
+	return defaultCitationParser
+}
+
+func (s *citationParser) Trigger() []byte {
+	return []byte{'[', '@'}
+}
+
+var citationKeyPunctuations = []byte(":.#$%&-+?<>~/")
+
+// scanCitationKey returns a length of a citation key at the beginning of
+// the given bytes. Keys can contain internal punctuations like
+// 'doe:2019.a', but trailing punctuations are not a part of keys.
+func scanCitationKey(b []byte) int {
+	if len(b) == 0 || !(util.IsAlphaNumeric(b[0]) || b[0] == '_') {
+		return 0
+	}
+	length := 1
+	for i := 1; i < len(b); i++ {
+		c := b[i]
+		if util.IsAlphaNumeric(c) || c == '_' {
+			length = i + 1
+		} else if bytes.IndexByte(citationKeyPunctuations, c) < 0 {
+			break
+		}
+	}
+	return length
+}
+
+func trimCitationAffix(b []byte) []byte {
+	b = util.TrimRightSpace(util.TrimLeftSpace(b))
+	if len(b) == 0 {
+		return nil
+	}
+	return b
+}
+
+// parseCitationItem parses a citation item like 'see -@doe2019, p. 23'.
+func parseCitationItem(b []byte) *ast.CitationItem {
+	for i := 0; i < len(b); i++ {
+		if b[i] != '@' {
+			continue
+		}
+		start := i
+		mode := ast.NormalCitation
+		if i > 0 && b[i-1] == '-' {
+			start = i - 1
+			mode = ast.SuppressAuthor
+		}
+		if start > 0 && !util.IsSpace(b[start-1]) {
+			continue
+		}
+		length := scanCitationKey(b[i+1:])
+		if length == 0 {
+			continue
+		}
+		return &ast.CitationItem{
+			Key:    b[i+1 : i+1+length],
+			Prefix: trimCitationAffix(b[:start]),
+			Suffix: trimCitationAffix(b[i+1+length:]),
+			Mode:   mode,
+		}
+	}
+	return nil
+}
+
+// isCitationFollowedByLink returns true if a bracket at the given position
+// is followed by a link destination or a link label.
+func isCitationFollowedByLink(line []byte, pos int) bool {
+	return pos < len(line) && (line[pos] == '(' || line[pos] == '[')
+}
+
+func (s *citationParser) Parse(parent gast.Node, block text.Reader, pc parser.Context) gast.Node {
+	line, segment := block.PeekLine()
+	var items []*ast.CitationItem
+	length := 0
+	if line[0] == '@' {
+		c := block.PrecendingCharacter()
+		if unicode.IsLetter(c) || unicode.IsDigit(c) || c == '_' {
+			return nil
+		}
+		l := scanCitationKey(line[1:])
+		if l == 0 {
+			return nil
+		}
+		item := &ast.CitationItem{
+			Key:  line[1 : 1+l],
+			Mode: ast.AuthorInText,
+		}
+		length = 1 + l
+		// a locator like '@doe2019 [p. 23]'
+		rest := line[length:]
+		if len(rest) > 2 && rest[0] == ' ' && rest[1] == '[' {
+			closes := bytes.IndexByte(rest[2:], ']')
+			if closes > -1 {
+				suffix := rest[2 : 2+closes]
+				if !bytes.ContainsAny(suffix, "[@") && !isCitationFollowedByLink(rest, 2+closes+1) {
+					item.Suffix = trimCitationAffix(suffix)
+					length += 2 + closes + 1
+				}
+			}
+		}
+		items = append(items, item)
+	} else {
+		closes := bytes.IndexByte(line, ']')
+		if closes < 0 || isCitationFollowedByLink(line, closes+1) {
+			return nil
+		}
+		content := line[1:closes]
+		if bytes.IndexByte(content, '[') > -1 {
+			return nil
+		}
+		for _, part := range bytes.Split(content, []byte{';'}) {
+			item := parseCitationItem(part)
+			if item == nil {
+				return nil
+			}
+			items = append(items, item)
+		}
+		length = closes + 1
+	}
+	n := ast.NewCitation(items)
+	n.AppendChild(n, gast.NewTextSegment(text.NewSegment(segment.Start, segment.Start+length)))
+	block.Advance(length)
+	return n
+}
+
+type citationASTTransformer struct {
+	CitationConfig
+}
+
+// NewCitationASTTransformer returns a new parser.ASTTransformer that
+// formats citations with a resolver and appends a bibliography to
+// documents.
+func NewCitationASTTransformer(opts ...CitationOption) parser.ASTTransformer {
+	a := &citationASTTransformer{
+		CitationConfig: NewCitationConfig(),
+	}
+	for _, o := range opts {
+		o.SetCitationOption(&a.CitationConfig)
+	}
+	return a
+}
+
+func (a *citationASTTransformer) Transform(node *gast.Document, reader text.Reader, pc parser.Context) {
+	if a.Resolver == nil {
+		return
+	}
+	var keys [][]byte
+	cited := map[string]bool{}
+	_ = gast.Walk(node, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+		if !entering || n.Kind() != ast.KindCitation {
+			return gast.WalkContinue, nil
+		}
+		citation := n.(*ast.Citation)
+		if html, ok := a.Resolver.FormatCitation(citation); ok {
+			citation.HTML = html
+		}
+		for _, key := range citation.Keys() {
+			if !cited[string(key)] {
+				cited[string(key)] = true
+				keys = append(keys, key)
+			}
+		}
+		return gast.WalkSkipChildren, nil
+	})
+	if len(keys) == 0 {
+		return
+	}
+	if html := a.Resolver.FormatBibliography(keys); len(html) != 0 {
+		node.AppendChild(node, ast.NewBibliography(html))
+	}
+}
+
+// CitationHTMLRenderer is a renderer.NodeRenderer implementation that
+// renders Citation and Bibliography nodes.
+type CitationHTMLRenderer struct {
+	html.Config
+}
+
+// NewCitationHTMLRenderer returns a new CitationHTMLRenderer.
+func NewCitationHTMLRenderer(opts ...html.Option) renderer.NodeRenderer {
+	r := &CitationHTMLRenderer{
+		Config: html.NewConfig(),
+	}
+	for _, opt := range opts {
+		opt.SetHTMLOption(&r.Config)
+	}
+	return r
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *CitationHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindCitation, r.renderCitation)
+	reg.Register(ast.KindBibliography, r.renderBibliography)
+}
+
+func (r *CitationHTMLRenderer) renderCitation(w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	if !entering {
+		return gast.WalkContinue, nil
+	}
+	n := node.(*ast.Citation)
+	_, _ = w.WriteString(`<span class="citation" data-cites="`)
+	_, _ = w.Write(util.EscapeHTML(bytes.Join(n.Keys(), []byte{' '})))
+	_ = w.WriteByte('"')
+	if n.Attributes() != nil {
+		r.RenderAttributes(w, n)
+	}
+	_ = w.WriteByte('>')
+	if n.HTML != nil {
+		_, _ = w.Write(n.HTML)
+	} else {
+		_, _ = w.Write(util.EscapeHTML(n.Text(source)))
+	}
+	_, _ = w.WriteString("</span>")
+	return gast.WalkSkipChildren, nil
+}
+
+func (r *CitationHTMLRenderer) renderBibliography(w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	if !entering {
+		return gast.WalkContinue, nil
+	}
+	n := node.(*ast.Bibliography)
+	_, _ = w.WriteString("<div id=\"refs\" class=\"references\">\n")
+	_, _ = w.Write(n.HTML)
+	if n.HTML[len(n.HTML)-1] != '\n' {
+		_ = w.WriteByte('\n')
+	}
+	_, _ = w.WriteString("</div>\n")
+	return gast.WalkSkipChildren, nil
+}
+
+type citation struct {
+	options []CitationOption
+}
+
+// Citation is an extension that allows you to use Pandoc style citations
+// like '[@doe2019, p. 23]' and '@doe2019'.
+var Citation = &citation{}
+
+// NewCitation returns a new Extender that formats citations with the
+// given options.
+func NewCitation(opts ...CitationOption) goldmark.Extender {
+	return &citation{
+		options: opts,
+	}
+}
+
+func (e *citation) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithInlineParsers(
+			// citations must be parsed before links
+			util.Prioritized(NewCitationParser(), 199),
+		),
+		parser.WithASTTransformers(
+			util.Prioritized(NewCitationASTTransformer(e.options...), 999),
+		),
+	)
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(NewCitationHTMLRenderer(), 500),
+	))
+}
@@ -0,0 +1,79 @@
+package extension
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension/ast"
+)
+
+func TestCitation(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			Citation,
+		),
+	)
+	goldmark.DoTestCaseFile(markdown, "_test/citation.txt", t)
+}
+
+type testCitationResolver struct {
+	numbers map[string]int
+}
+
+func (r *testCitationResolver) FormatCitation(citation *ast.Citation) ([]byte, bool) {
+	var b bytes.Buffer
+	b.WriteByte('[')
+	for i, item := range citation.Items {
+		if item.Key[0] == 'x' {
+			return nil, false
+		}
+		if _, ok := r.numbers[string(item.Key)]; !ok {
+			r.numbers[string(item.Key)] = len(r.numbers) + 1
+		}
+		if i != 0 {
+			b.WriteString(", ")
+		}
+		fmt.Fprintf(&b, "%d%s", r.numbers[string(item.Key)], item.Suffix)
+	}
+	b.WriteByte(']')
+	return b.Bytes(), true
+}
+
+func (r *testCitationResolver) FormatBibliography(keys [][]byte) []byte {
+	var b bytes.Buffer
+	b.WriteString("<ol>\n")
+	for _, key := range keys {
+		fmt.Fprintf(&b, "<li>%s</li>\n", key)
+	}
+	b.WriteString("</ol>")
+	return b.Bytes()
+}
+
+func TestCitationResolver(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			NewCitation(
+				WithCitationResolver(&testCitationResolver{map[string]int{}}),
+			),
+		),
+	)
+	var b bytes.Buffer
+	source := []byte("As @smith2020 says [@doe2019, p. 23; @smith2020], but [@x].\n")
+	if err := markdown.Convert(source, &b); err != nil {
+		t.Fatal(err)
+	}
+	expected := `<p>As <span class="citation" data-cites="smith2020">[1]</span> says <span class="citation" data-cites="doe2019 smith2020">[2, p. 23, 1]</span>, but <span class="citation" data-cites="x">[@x]</span>.</p>
+<div id="refs" class="references">
+<ol>
+<li>smith2020</li>
+<li>doe2019</li>
+<li>x</li>
+</ol>
+</div>
+`
+	if b.String() != expected {
+		t.Errorf("unexpected output:\n%s", b.String())
+	}
+}
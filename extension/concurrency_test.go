@@ -0,0 +1,66 @@
+package extension
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/parser"
+)
+
+func TestConcurrentConvert(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithParserOptions(
+			parser.WithAutoHeadingID(),
+		),
+		goldmark.WithExtensions(
+			GFM,
+			Footnote,
+			DefinitionList,
+			Typographer,
+			CriticMarkup,
+			Figure,
+			NewListOfFigures(),
+			GridTable,
+			CustomContainer,
+			Hashtag,
+			Mention,
+		),
+	)
+	sources := [][]byte{
+		[]byte("# Title\n\nText with a footnote[^1] and {++an addition++}.\n\n[^1]: A note.\n"),
+		[]byte("[LOF]\n\n![Arch](arch.png)\nThe architecture\n\n| a | b |\n|---|---|\n| 1 | 2 |\n"),
+		[]byte("Term\n: Definition with \"quotes\" -- and ~~strike~~ https://example.com\n"),
+		[]byte("+-----+-----+\n| *a* | - b |\n+=====+=====+\n| c   | d   |\n+-----+-----+\n\n::: note\n#tag by @user\n:::\n"),
+	}
+	expected := make([]string, len(sources))
+	for i, source := range sources {
+		var b bytes.Buffer
+		if err := markdown.Convert(source, &b); err != nil {
+			t.Fatal(err)
+		}
+		expected[i] = b.String()
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				k := j % len(sources)
+				var b bytes.Buffer
+				if err := markdown.Convert(sources[k], &b); err != nil {
+					t.Error(err)
+					return
+				}
+				if b.String() != expected[k] {
+					t.Errorf("unexpected output for source %d:\n%s", k, b.String())
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
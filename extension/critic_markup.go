@@ -0,0 +1,329 @@
+package extension
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// A CriticMarkupMode represents how CriticMarkup changes are rendered.
+type CriticMarkupMode int
+
+const (
+	// CriticMarkupShowChanges renders additions, deletions and comments
+	// as HTML elements so that readers can review changes.
+	CriticMarkupShowChanges CriticMarkupMode = iota
+
+	// CriticMarkupAcceptAll renders a text that all changes are accepted.
+	// Comments are not rendered.
+	CriticMarkupAcceptAll
+
+	// CriticMarkupRejectAll renders a text that all changes are rejected.
+	// Comments are not rendered.
+	CriticMarkupRejectAll
+)
+
+// A CriticMarkupConfig struct is a data structure that holds configuration of the
+// CriticMarkup extension.
+type CriticMarkupConfig struct {
+	// Mode is a render mode of changes.
+	Mode CriticMarkupMode
+}
+
+// A CriticMarkupOption interface sets options for the CriticMarkup extension.
+type CriticMarkupOption interface {
+	SetCriticMarkupOption(*CriticMarkupConfig)
+}
+
+type withCriticMarkupMode struct {
+	value CriticMarkupMode
+}
+
+func (o *withCriticMarkupMode) SetCriticMarkupOption(c *CriticMarkupConfig) {
+	c.Mode = o.value
+}
+
+// WithCriticMarkupMode is a functional option that sets a render mode of changes.
+func WithCriticMarkupMode(mode CriticMarkupMode) CriticMarkupOption {
+	return &withCriticMarkupMode{mode}
+}
+
+var criticMarkupStateKey = parser.NewContextKey()
+
+// criticMarkupState is a temporary node that represents an opener or
+// a separator('~>') of CriticMarkup changes.
+type criticMarkupState struct {
+	gast.BaseInline
+
+	Segment text.Segment
+
+	// Char is '+', '-' or '~' for openers and '>' for separators.
+	Char byte
+
+	// Bottom is a last delimiter before this state.
+	Bottom gast.Node
+
+	Separator *criticMarkupState
+
+	Prev *criticMarkupState
+}
+
+func (s *criticMarkupState) Text(source []byte) []byte {
+	return s.Segment.Value(source)
+}
+
+func (s *criticMarkupState) Dump(source []byte, level int) {
+	fmt.Printf("%scriticMarkupState: \"%s\"\n", strings.Repeat("    ", level), s.Text(source))
+}
+
+var kindCriticMarkupState = gast.NewNodeKind("CriticMarkupState")
+
+func (s *criticMarkupState) Kind() gast.NodeKind {
+	return kindCriticMarkupState
+}
+
+func lastCriticMarkupState(pc parser.Context) *criticMarkupState {
+	v := pc.Get(criticMarkupStateKey)
+	if v == nil {
+		return nil
+	}
+	return v.(*criticMarkupState)
+}
+
+var criticCommentCloser = []byte("<<}")
+
+type criticMarkupParser struct {
+}
+
+var defaultCriticMarkupParser = &criticMarkupParser{}
+
+// NewCriticMarkupParser return a new InlineParser that parses
+// CriticMarkup changes like '{++added++}', '{--deleted--}',
+// '{~~old~>new~~}' and '{>>comment<<}'.
+func NewCriticMarkupParser() parser.InlineParser {
+	return defaultCriticMarkupParser
+}
+
+func (s *criticMarkupParser) Trigger() []byte {
+	return []byte{'{', '+', '-', '~'}
+}
+
+func (s *criticMarkupParser) Parse(parent gast.Node, block text.Reader, pc parser.Context) gast.Node {
+	line, segment := block.PeekLine()
+	if len(line) < 2 {
+		return nil
+	}
+	if line[0] == '{' {
+		return s.parseOpener(line, segment, block, pc)
+	}
+	last := lastCriticMarkupState(pc)
+	if last == nil {
+		return nil
+	}
+	if line[0] == '~' && line[1] == '>' {
+		if last.Char != '~' || last.Separator != nil {
+			return nil
+		}
+		parser.ProcessDelimiters(last.Bottom, pc)
+		separator := &criticMarkupState{
+			Segment: segment.WithStop(segment.Start + 2),
+			Char:    '>',
+		}
+		last.Separator = separator
+		last.Bottom = pc.LastDelimiter()
+		block.Advance(2)
+		return separator
+	}
+	if len(line) < 3 || line[1] != line[0] || line[2] != '}' || last.Char != line[0] {
+		return nil
+	}
+	if last.Char == '~' && last.Separator == nil {
+		return nil
+	}
+	parser.ProcessDelimiters(last.Bottom, pc)
+	pc.Set(criticMarkupStateKey, last.Prev)
+	block.Advance(3)
+
+	var node gast.Node
+	switch last.Char {
+	case '+':
+		node = ast.NewCriticAddition()
+		moveCriticMarkupChildren(node, last, nil)
+	case '-':
+		node = ast.NewCriticDeletion()
+		moveCriticMarkupChildren(node, last, nil)
+	default:
+		node = ast.NewCriticSubstitution()
+		deletion := ast.NewCriticDeletion()
+		moveCriticMarkupChildren(deletion, last, last.Separator)
+		addition := ast.NewCriticAddition()
+		moveCriticMarkupChildren(addition, last.Separator, nil)
+		last.Separator.Parent().RemoveChild(last.Separator.Parent(), last.Separator)
+		node.AppendChild(node, deletion)
+		node.AppendChild(node, addition)
+	}
+	last.Parent().RemoveChild(last.Parent(), last)
+	return node
+}
+
+func (s *criticMarkupParser) parseOpener(line []byte, segment text.Segment, block text.Reader, pc parser.Context) gast.Node {
+	if len(line) < 3 {
+		return nil
+	}
+	if line[1] == '>' && line[2] == '>' {
+		i := bytes.Index(line[3:], criticCommentCloser)
+		if i < 0 {
+			return nil
+		}
+		node := ast.NewCriticComment()
+		if i != 0 {
+			node.AppendChild(node, gast.NewTextSegment(text.NewSegment(segment.Start+3, segment.Start+3+i)))
+		}
+		block.Advance(i + 6)
+		return node
+	}
+	if line[1] != line[2] || (line[1] != '+' && line[1] != '-' && line[1] != '~') {
+		return nil
+	}
+	state := &criticMarkupState{
+		Segment: segment.WithStop(segment.Start + 3),
+		Char:    line[1],
+		Bottom:  pc.LastDelimiter(),
+		Prev:    lastCriticMarkupState(pc),
+	}
+	pc.Set(criticMarkupStateKey, state)
+	block.Advance(3)
+	return state
+}
+
+// moveCriticMarkupChildren moves siblings between from and to into the given node.
+func moveCriticMarkupChildren(node gast.Node, from, to gast.Node) {
+	parent := from.Parent()
+	for c := from.NextSibling(); c != nil && c != to; {
+		next := c.NextSibling()
+		parent.RemoveChild(parent, c)
+		node.AppendChild(node, c)
+		c = next
+	}
+}
+
+func (s *criticMarkupParser) CloseBlock(parent gast.Node, block text.Reader, pc parser.Context) {
+	for last := lastCriticMarkupState(pc); last != nil; last = last.Prev {
+		if last.Separator != nil {
+			gast.MergeOrReplaceTextSegment(last.Separator.Parent(), last.Separator, last.Separator.Segment)
+		}
+		gast.MergeOrReplaceTextSegment(last.Parent(), last, last.Segment)
+	}
+	pc.Set(criticMarkupStateKey, nil)
+}
+
+// CriticMarkupHTMLRenderer is a renderer.NodeRenderer implementation that
+// renders CriticMarkup nodes.
+type CriticMarkupHTMLRenderer struct {
+	html.Config
+	CriticMarkupConfig
+}
+
+// NewCriticMarkupHTMLRenderer returns a new CriticMarkupHTMLRenderer.
+func NewCriticMarkupHTMLRenderer(opts ...CriticMarkupOption) renderer.NodeRenderer {
+	r := &CriticMarkupHTMLRenderer{
+		Config: html.NewConfig(),
+	}
+	for _, opt := range opts {
+		opt.SetCriticMarkupOption(&r.CriticMarkupConfig)
+	}
+	return r
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *CriticMarkupHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindCriticAddition, r.renderCriticAddition)
+	reg.Register(ast.KindCriticDeletion, r.renderCriticDeletion)
+	reg.Register(ast.KindCriticSubstitution, r.renderCriticSubstitution)
+	reg.Register(ast.KindCriticComment, r.renderCriticComment)
+}
+
+func (r *CriticMarkupHTMLRenderer) renderChange(w util.BufWriter, n gast.Node, entering bool, tag string, visible bool) (gast.WalkStatus, error) {
+	switch r.Mode {
+	case CriticMarkupAcceptAll, CriticMarkupRejectAll:
+		if !visible {
+			return gast.WalkSkipChildren, nil
+		}
+		return gast.WalkContinue, nil
+	}
+	if entering {
+		_ = w.WriteByte('<')
+		_, _ = w.WriteString(tag)
+		if n.Attributes() != nil {
+			r.RenderAttributes(w, n)
+		}
+		_ = w.WriteByte('>')
+	} else {
+		_, _ = w.WriteString("</")
+		_, _ = w.WriteString(tag)
+		_ = w.WriteByte('>')
+	}
+	return gast.WalkContinue, nil
+}
+
+func (r *CriticMarkupHTMLRenderer) renderCriticAddition(w util.BufWriter, source []byte, n gast.Node, entering bool) (gast.WalkStatus, error) {
+	return r.renderChange(w, n, entering, "ins", r.Mode == CriticMarkupAcceptAll)
+}
+
+func (r *CriticMarkupHTMLRenderer) renderCriticDeletion(w util.BufWriter, source []byte, n gast.Node, entering bool) (gast.WalkStatus, error) {
+	return r.renderChange(w, n, entering, "del", r.Mode == CriticMarkupRejectAll)
+}
+
+func (r *CriticMarkupHTMLRenderer) renderCriticSubstitution(w util.BufWriter, source []byte, n gast.Node, entering bool) (gast.WalkStatus, error) {
+	return gast.WalkContinue, nil
+}
+
+func (r *CriticMarkupHTMLRenderer) renderCriticComment(w util.BufWriter, source []byte, n gast.Node, entering bool) (gast.WalkStatus, error) {
+	if r.Mode != CriticMarkupShowChanges {
+		return gast.WalkSkipChildren, nil
+	}
+	if entering {
+		_, _ = w.WriteString(`<span class="critic comment"`)
+		if n.Attributes() != nil {
+			r.RenderAttributes(w, n)
+		}
+		_ = w.WriteByte('>')
+	} else {
+		_, _ = w.WriteString("</span>")
+	}
+	return gast.WalkContinue, nil
+}
+
+type criticMarkup struct {
+	options []CriticMarkupOption
+}
+
+// CriticMarkup is an extension that allow you to use CriticMarkup changes
+// like '{++added++}', '{--deleted--}', '{~~old~>new~~}' and '{>>comment<<}'.
+var CriticMarkup = &criticMarkup{}
+
+// NewCriticMarkup returns a new Extender that allow you to use CriticMarkup
+// changes with the given options.
+func NewCriticMarkup(opts ...CriticMarkupOption) goldmark.Extender {
+	return &criticMarkup{
+		options: opts,
+	}
+}
+
+func (e *criticMarkup) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(parser.WithInlineParsers(
+		util.Prioritized(NewCriticMarkupParser(), 150),
+	))
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(NewCriticMarkupHTMLRenderer(e.options...), 500),
+	))
+}
@@ -0,0 +1,42 @@
+package extension
+
+import (
+	"testing"
+
+	"github.com/yuin/goldmark"
+)
+
+func TestCriticMarkup(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			CriticMarkup,
+			Strikethrough,
+			Insert,
+		),
+	)
+	goldmark.DoTestCaseFile(markdown, "_test/critic_markup.txt", t)
+}
+
+func TestCriticMarkupModes(t *testing.T) {
+	source := "A {++new++} {--old--} {~~bad~>good~~} text{>>note<<}."
+	accept := goldmark.New(
+		goldmark.WithExtensions(
+			NewCriticMarkup(WithCriticMarkupMode(CriticMarkupAcceptAll)),
+		),
+	)
+	goldmark.DoTestCases(accept, []goldmark.MarkdownTestCase{{
+		No:       1,
+		Markdown: source,
+		Expected: `<p>A new  good text.</p>`,
+	}}, t)
+	reject := goldmark.New(
+		goldmark.WithExtensions(
+			NewCriticMarkup(WithCriticMarkupMode(CriticMarkupRejectAll)),
+		),
+	)
+	goldmark.DoTestCases(reject, []goldmark.MarkdownTestCase{{
+		No:       1,
+		Markdown: source,
+		Expected: `<p>A  old bad text.</p>`,
+	}}, t)
+}
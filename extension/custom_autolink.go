@@ -0,0 +1,189 @@
+package extension
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// CustomAutolinkFunc is a function that builds a destination URL from
+// submatches of a pattern. CustomAutolinkFunc returns false if the matched
+// text should not be linked.
+type CustomAutolinkFunc func(match [][]byte) (destination string, ok bool)
+
+// A CustomAutolinkPattern struct is a pattern of texts that should be
+// linked.
+type CustomAutolinkPattern struct {
+	// Regexp matches texts at the beginning of words.
+	Regexp *regexp.Regexp
+
+	// URL builds a destination URL from submatches of Regexp.
+	URL CustomAutolinkFunc
+}
+
+// A CustomAutolinkConfig struct is a data structure that holds
+// configuration of the CustomAutolink extension.
+type CustomAutolinkConfig struct {
+	// Patterns are patterns of texts that should be linked. Patterns are
+	// tried in order.
+	Patterns []CustomAutolinkPattern
+}
+
+// NewCustomAutolinkConfig returns a new CustomAutolinkConfig with defaults.
+func NewCustomAutolinkConfig() CustomAutolinkConfig {
+	return CustomAutolinkConfig{}
+}
+
+// A CustomAutolinkOption interface sets options for the CustomAutolink
+// extension.
+type CustomAutolinkOption interface {
+	SetCustomAutolinkOption(*CustomAutolinkConfig)
+}
+
+type withCustomAutolinkPattern struct {
+	value CustomAutolinkPattern
+}
+
+func (o *withCustomAutolinkPattern) SetCustomAutolinkOption(c *CustomAutolinkConfig) {
+	c.Patterns = append(c.Patterns, o.value)
+}
+
+// anchorCustomAutolinkPattern returns the given pattern that matches at
+// the beginning of texts.
+func anchorCustomAutolinkPattern(pattern *regexp.Regexp) *regexp.Regexp {
+	if strings.HasPrefix(pattern.String(), "^") {
+		return pattern
+	}
+	return regexp.MustCompile("^(?:" + pattern.String() + ")")
+}
+
+// WithCustomAutolinkFunc is a functional option that links texts matched
+// with the given regular expression to URLs built by the given function.
+func WithCustomAutolinkFunc(pattern *regexp.Regexp, f CustomAutolinkFunc) CustomAutolinkOption {
+	return &withCustomAutolinkPattern{CustomAutolinkPattern{anchorCustomAutolinkPattern(pattern), f}}
+}
+
+// WithCustomAutolinkRegexp is a functional option that links texts matched
+// with the given regular expression to URLs like
+// 'https://jira.example.com/browse/$1'. Templates are expanded as
+// regexp.Regexp.Expand does.
+func WithCustomAutolinkRegexp(pattern *regexp.Regexp, template string) CustomAutolinkOption {
+	re := anchorCustomAutolinkPattern(pattern)
+	return WithCustomAutolinkFunc(re, func(match [][]byte) (string, bool) {
+		// Expand needs indices of submatches, so the matched text is
+		// matched again.
+		return string(re.Expand(nil, []byte(template), match[0], re.FindSubmatchIndex(match[0]))), true
+	})
+}
+
+// WithCustomAutolinkPrefix is a functional option that links texts like
+// 'TICKET-123' that start with the given prefix like 'TICKET-' and are
+// followed by alphanumeric characters, as custom autolinks of GitHub.
+// '<num>' in the template is replaced with the alphanumeric characters
+// like 'https://example.com/TICKET?query=<num>'.
+func WithCustomAutolinkPrefix(prefix, template string) CustomAutolinkOption {
+	pattern := regexp.MustCompile("^" + regexp.QuoteMeta(prefix) + "([A-Za-z0-9]+)")
+	return WithCustomAutolinkFunc(pattern, func(match [][]byte) (string, bool) {
+		return strings.Replace(template, "<num>", string(match[1]), -1), true
+	})
+}
+
+type customAutolinkParser struct {
+	CustomAutolinkConfig
+	triggers []byte
+}
+
+// NewCustomAutolinkParser returns a new parser.InlineParser that links
+// texts matched with the given patterns. Patterns are matched at the
+// beginning of words, and matched texts must not be followed by
+// alphanumeric characters.
+func NewCustomAutolinkParser(opts ...CustomAutolinkOption) parser.InlineParser {
+	p := &customAutolinkParser{
+		CustomAutolinkConfig: NewCustomAutolinkConfig(),
+		// ' ' indicates any white spaces and a line head
+		triggers: []byte{' ', '('},
+	}
+	for _, o := range opts {
+		o.SetCustomAutolinkOption(&p.CustomAutolinkConfig)
+	}
+	for _, pattern := range p.Patterns {
+		// patterns that start with punctuations like '#' need triggers
+		prefix, _ := pattern.Regexp.LiteralPrefix()
+		if len(prefix) != 0 && util.IsPunct(prefix[0]) && strings.IndexByte(string(p.triggers), prefix[0]) < 0 {
+			p.triggers = append(p.triggers, prefix[0])
+		}
+	}
+	return p
+}
+
+func (s *customAutolinkParser) Trigger() []byte {
+	return s.triggers
+}
+
+func (s *customAutolinkParser) Parse(parent gast.Node, block text.Reader, pc parser.Context) gast.Node {
+	line, segment := block.PeekLine()
+	consumes := 0
+	if c := line[0]; c == ' ' || c == '\t' || c == '(' {
+		consumes++
+		line = line[1:]
+	} else if util.IsPunct(c) {
+		if p := block.PrecendingCharacter(); unicode.IsLetter(p) || unicode.IsDigit(p) || p == '_' {
+			return nil
+		}
+	}
+	for _, pattern := range s.Patterns {
+		m := pattern.Regexp.FindSubmatchIndex(line)
+		if m == nil || m[1] == 0 {
+			continue
+		}
+		if m[1] < len(line) && (util.IsAlphaNumeric(line[m[1]]) || line[m[1]] == '_') {
+			continue
+		}
+		match := make([][]byte, len(m)/2)
+		for i := range match {
+			if m[2*i] >= 0 {
+				match[i] = line[m[2*i]:m[2*i+1]]
+			}
+		}
+		destination, ok := pattern.URL(match)
+		if !ok {
+			continue
+		}
+		if consumes != 0 {
+			gast.MergeOrAppendTextSegment(parent, segment.WithStop(segment.Start+consumes))
+		}
+		start := segment.Start + consumes
+		link := gast.NewLink()
+		link.Destination = []byte(destination)
+		link.AppendChild(link, gast.NewTextSegment(text.NewSegment(start, start+m[1])))
+		block.Advance(consumes + m[1])
+		return link
+	}
+	return nil
+}
+
+type customAutolink struct {
+	options []CustomAutolinkOption
+}
+
+// NewCustomAutolink returns a new Extender that links texts matched with
+// the given patterns like JIRA keys 'ABC-123'. Matched texts are parsed as
+// Link nodes.
+func NewCustomAutolink(opts ...CustomAutolinkOption) goldmark.Extender {
+	return &customAutolink{
+		options: opts,
+	}
+}
+
+func (e *customAutolink) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(parser.WithInlineParsers(
+		// custom autolinks must be parsed before linkify
+		util.Prioritized(NewCustomAutolinkParser(e.options...), 998),
+	))
+}
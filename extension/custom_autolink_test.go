@@ -0,0 +1,29 @@
+package extension
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/yuin/goldmark"
+)
+
+func TestCustomAutolink(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			NewCustomAutolink(
+				WithCustomAutolinkPrefix("TICKET-", "https://example.com/TICKET?query=<num>"),
+				WithCustomAutolinkRegexp(regexp.MustCompile(`(?P<project>[A-Z]{2,})-(?P<id>[0-9]+)`),
+					"https://jira.example.com/browse/${project}-${id}"),
+				WithCustomAutolinkFunc(regexp.MustCompile(`!([a-z]+)`), func(match [][]byte) (string, bool) {
+					if strings.HasPrefix(string(match[1]), "x") {
+						return "", false
+					}
+					return "/merge_requests/" + string(match[1]), true
+				}),
+			),
+			Linkify,
+		),
+	)
+	goldmark.DoTestCaseFile(markdown, "_test/custom_autolink.txt", t)
+}
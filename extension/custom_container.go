@@ -0,0 +1,299 @@
+package extension
+
+import (
+	"bytes"
+
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// A CustomContainerConfig struct is a data structure that holds
+// configuration of the CustomContainer extension.
+type CustomContainerConfig struct {
+	// Tag is a tag name of containers.
+	Tag []byte
+
+	// Tags are tag names of containers by their names like 'details'.
+	Tags map[string][]byte
+
+	// Class is a class of containers. Names of containers are added as
+	// classes too.
+	Class []byte
+}
+
+// NewCustomContainerConfig returns a new CustomContainerConfig with
+// defaults.
+func NewCustomContainerConfig() CustomContainerConfig {
+	return CustomContainerConfig{
+		Tag:  []byte("div"),
+		Tags: map[string][]byte{},
+	}
+}
+
+// A CustomContainerOption interface sets options for the CustomContainer
+// extension.
+type CustomContainerOption interface {
+	SetCustomContainerOption(*CustomContainerConfig)
+}
+
+type withCustomContainerTag struct {
+	name string
+	tag  []byte
+}
+
+func (o *withCustomContainerTag) SetCustomContainerOption(c *CustomContainerConfig) {
+	if len(o.name) == 0 {
+		c.Tag = o.tag
+		return
+	}
+	c.Tags[o.name] = o.tag
+}
+
+// WithCustomContainerTag is a functional option that sets a tag name of
+// containers with the given name like 'aside'. An empty name means all
+// containers that do not have their own tag names. The default is "div".
+func WithCustomContainerTag(name, tag string) CustomContainerOption {
+	return &withCustomContainerTag{name, []byte(tag)}
+}
+
+type withCustomContainerClass struct {
+	value []byte
+}
+
+func (o *withCustomContainerClass) SetCustomContainerOption(c *CustomContainerConfig) {
+	c.Class = o.value
+}
+
+// WithCustomContainerClass is a functional option that sets a class of
+// containers like 'container'. By default, only names of containers are
+// added as classes.
+func WithCustomContainerClass(class string) CustomContainerOption {
+	return &withCustomContainerClass{[]byte(class)}
+}
+
+var customContainerStackKey = parser.NewContextKey()
+
+type customContainerData struct {
+	node   gast.Node
+	length int
+}
+
+// customContainerStack is a stack of opened containers. Closing fences
+// belong to the innermost container.
+type customContainerStack struct {
+	data []customContainerData
+}
+
+type customContainerParser struct {
+}
+
+var defaultCustomContainerParser = &customContainerParser{}
+
+// NewCustomContainerParser returns a new parser.BlockParser that can parse
+// fenced containers like '::: warning' ... ':::'. Containers can be nested
+// and can have attributes like '::: warning {#id}'.
+func NewCustomContainerParser() parser.BlockParser {
+	return defaultCustomContainerParser
+}
+
+// scanCustomContainerFence returns a length of the fence at the beginning
+// of the given line.
+func scanCustomContainerFence(line []byte) int {
+	i := 0
+	for ; i < len(line) && line[i] == ':'; i++ {
+	}
+	if i < 3 {
+		return 0
+	}
+	return i
+}
+
+// advanceCustomContainerFence advances the given reader to the end of
+// the fence line. The last line of a document may not end with a newline.
+func advanceCustomContainerFence(reader text.Reader, line []byte, segment text.Segment) {
+	length := segment.Len()
+	if line[len(line)-1] == '\n' {
+		length--
+	}
+	reader.Advance(length)
+}
+
+func (b *customContainerParser) Open(parent gast.Node, reader text.Reader, pc parser.Context) (gast.Node, parser.State) {
+	line, segment := reader.PeekLine()
+	pos := pc.BlockOffset()
+	if pos < 0 {
+		return nil, parser.NoChildren
+	}
+	length := scanCustomContainerFence(line[pos:])
+	if length == 0 {
+		return nil, parser.NoChildren
+	}
+	rest := util.TrimRightSpace(util.TrimLeftSpace(line[pos+length:]))
+	i := 0
+	for ; i < len(rest) && (util.IsAlphaNumeric(rest[i]) || rest[i] == '-' || rest[i] == '_'); i++ {
+	}
+	if i == 0 || (i < len(rest) && !util.IsSpace(rest[i]) && rest[i] != '{') {
+		return nil, parser.NoChildren
+	}
+	name := rest[:i]
+	info := util.TrimLeftSpace(rest[i:])
+	var attrs map[string]interface{}
+	if j := bytes.IndexByte(info, '{'); j > -1 && info[len(info)-1] == '}' {
+		r := text.NewReader(info[j:])
+		var ok bool
+		attrs, ok = parser.ParseAttributes(r)
+		if _, p := r.Position(); !ok || p.Start != len(info)-j {
+			return nil, parser.NoChildren
+		}
+		info = util.TrimRightSpace(info[:j])
+	}
+	if len(info) == 0 {
+		info = nil
+	}
+	node := ast.NewCustomContainer(name, info)
+	if attrs != nil {
+		setAttributes(node, attrs)
+	}
+	stack := parser.ContextState(pc, customContainerStackKey, func() interface{} {
+		return &customContainerStack{}
+	}).(*customContainerStack)
+	stack.data = append(stack.data, customContainerData{node, length})
+	advanceCustomContainerFence(reader, line, segment)
+	return node, parser.HasChildren
+}
+
+func (b *customContainerParser) Continue(node gast.Node, reader text.Reader, pc parser.Context) parser.State {
+	line, segment := reader.PeekLine()
+	w, pos := util.IndentWidth(line, reader.LineOffset())
+	if w < 4 {
+		length := scanCustomContainerFence(line[pos:])
+		if length != 0 && util.IsBlank(line[pos+length:]) {
+			stack := pc.Get(customContainerStackKey).(*customContainerStack)
+			last := stack.data[len(stack.data)-1]
+			if last.node != node {
+				// the fence may close a nested container
+				return parser.Continue | parser.HasChildren
+			}
+			if length >= last.length {
+				advanceCustomContainerFence(reader, line, segment)
+				return parser.Close
+			}
+		}
+	}
+	return parser.Continue | parser.HasChildren
+}
+
+func (b *customContainerParser) Close(node gast.Node, reader text.Reader, pc parser.Context) {
+	stack := pc.Get(customContainerStackKey).(*customContainerStack)
+	for i := len(stack.data) - 1; i >= 0; i-- {
+		if stack.data[i].node == node {
+			stack.data = append(stack.data[:i], stack.data[i+1:]...)
+			break
+		}
+	}
+}
+
+func (b *customContainerParser) CanInterruptParagraph() bool {
+	return true
+}
+
+func (b *customContainerParser) CanAcceptIndentedLine() bool {
+	return false
+}
+
+// CustomContainerHTMLRenderer is a renderer.NodeRenderer implementation
+// that renders CustomContainer nodes.
+type CustomContainerHTMLRenderer struct {
+	html.Config
+	CustomContainerConfig
+}
+
+// NewCustomContainerHTMLRenderer returns a new CustomContainerHTMLRenderer.
+func NewCustomContainerHTMLRenderer(opts ...CustomContainerOption) renderer.NodeRenderer {
+	r := &CustomContainerHTMLRenderer{
+		Config:                html.NewConfig(),
+		CustomContainerConfig: NewCustomContainerConfig(),
+	}
+	for _, opt := range opts {
+		opt.SetCustomContainerOption(&r.CustomContainerConfig)
+	}
+	return r
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *CustomContainerHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindCustomContainer, r.renderCustomContainer)
+}
+
+func (r *CustomContainerHTMLRenderer) tag(n *ast.CustomContainer) []byte {
+	if tag, ok := r.Tags[string(n.Name)]; ok {
+		return tag
+	}
+	return r.Tag
+}
+
+func (r *CustomContainerHTMLRenderer) renderCustomContainer(w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	n := node.(*ast.CustomContainer)
+	if !entering {
+		_, _ = w.WriteString("</")
+		_, _ = w.Write(r.tag(n))
+		_, _ = w.WriteString(">\n")
+		return gast.WalkContinue, nil
+	}
+	classes := [][]byte{}
+	if len(r.Class) != 0 {
+		classes = append(classes, r.Class)
+	}
+	classes = append(classes, n.Name)
+	if class, ok := n.AttributeString("class"); ok {
+		classes = append(classes, class)
+	}
+	_ = w.WriteByte('<')
+	_, _ = w.Write(r.tag(n))
+	_, _ = w.WriteString(` class="`)
+	_, _ = w.Write(util.EscapeHTML(bytes.Join(classes, []byte{' '})))
+	_ = w.WriteByte('"')
+	for _, attr := range r.Attributes(w, n) {
+		if bytes.Equal(attr.Name, []byte("class")) || !r.AllowsAttribute(attr.Name) {
+			continue
+		}
+		_ = w.WriteByte(' ')
+		_, _ = w.Write(attr.Name)
+		_, _ = w.WriteString(`="`)
+		_, _ = w.Write(util.EscapeHTML(attr.Value))
+		_ = w.WriteByte('"')
+	}
+	_, _ = w.WriteString(">\n")
+	return gast.WalkContinue, nil
+}
+
+type customContainer struct {
+	options []CustomContainerOption
+}
+
+// CustomContainer is an extension that allows you to use fenced containers
+// like '::: warning' ... ':::' as in markdown-it-container.
+var CustomContainer = &customContainer{}
+
+// NewCustomContainer returns a new Extender that renders fenced containers
+// with the given options.
+func NewCustomContainer(opts ...CustomContainerOption) goldmark.Extender {
+	return &customContainer{
+		options: opts,
+	}
+}
+
+func (e *customContainer) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(parser.WithBlockParsers(
+		util.Prioritized(NewCustomContainerParser(), 999),
+	))
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(NewCustomContainerHTMLRenderer(e.options...), 500),
+	))
+}
@@ -0,0 +1,36 @@
+package extension
+
+import (
+	"testing"
+
+	"github.com/yuin/goldmark"
+)
+
+func TestCustomContainer(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			NewCustomContainer(
+				WithCustomContainerTag("aside", "aside"),
+			),
+		),
+	)
+	goldmark.DoTestCaseFile(markdown, "_test/custom_container.txt", t)
+}
+
+func TestCustomContainerWithDefinitionList(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			CustomContainer,
+			DefinitionList,
+		),
+	)
+	goldmark.DoTestCases(markdown, []goldmark.MarkdownTestCase{{
+		No:       1,
+		Markdown: "::: note\ntext\n:::",
+		Expected: "<div class=\"note\">\n<p>text</p>\n</div>",
+	}, {
+		No:       2,
+		Markdown: "term\n: ::: note\n  text\n  :::",
+		Expected: "<dl>\n<dt>term</dt>\n<dd><div class=\"note\">\n<p>text</p>\n</div>\n</dd>\n</dl>",
+	}}, t)
+}
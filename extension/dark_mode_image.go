@@ -0,0 +1,129 @@
+package extension
+
+import (
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+var attrNameDark = []byte("dark")
+
+type darkModeImageASTTransformer struct {
+}
+
+var defaultDarkModeImageASTTransformer = &darkModeImageASTTransformer{}
+
+// NewDarkModeImageASTTransformer returns a new parser.ASTTransformer that
+// wraps images that have a 'dark' attribute like
+// '![alt](diagram.png){dark=diagram-dark.png}' with Picture nodes.
+func NewDarkModeImageASTTransformer() parser.ASTTransformer {
+	return defaultDarkModeImageASTTransformer
+}
+
+func (a *darkModeImageASTTransformer) Transform(node *gast.Document, reader text.Reader, pc parser.Context) {
+	var images []gast.Node
+	_ = gast.Walk(node, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+		if entering && n.Kind() == gast.KindImage {
+			images = append(images, n)
+		}
+		return gast.WalkContinue, nil
+	})
+	for _, image := range images {
+		dark, ok := image.Attribute(attrNameDark)
+		if !ok || len(dark) == 0 {
+			continue
+		}
+		attrs := image.Attributes()
+		image.RemoveAttributes()
+		for _, attr := range attrs {
+			if string(attr.Name) != string(attrNameDark) {
+				image.SetAttribute(attr.Name, attr.Value)
+			}
+		}
+		picture := ast.NewPicture(ast.PictureSource{
+			Srcset: dark,
+			Media:  ast.PrefersDarkColorScheme,
+		})
+		image.Parent().ReplaceChild(image.Parent(), image, picture)
+		picture.AppendChild(picture, image)
+	}
+}
+
+// PictureHTMLRenderer is a renderer.NodeRenderer implementation that
+// renders Picture nodes.
+type PictureHTMLRenderer struct {
+	html.Config
+}
+
+// NewPictureHTMLRenderer returns a new PictureHTMLRenderer.
+func NewPictureHTMLRenderer(opts ...html.Option) renderer.NodeRenderer {
+	r := &PictureHTMLRenderer{
+		Config: html.NewConfig(),
+	}
+	for _, opt := range opts {
+		opt.SetHTMLOption(&r.Config)
+	}
+	return r
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *PictureHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindPicture, r.renderPicture)
+}
+
+func (r *PictureHTMLRenderer) renderPicture(w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	if !entering {
+		_, _ = w.WriteString("</picture>")
+		return gast.WalkContinue, nil
+	}
+	n := node.(*ast.Picture)
+	_, _ = w.WriteString("<picture")
+	if n.Attributes() != nil {
+		r.RenderAttributes(w, n)
+	}
+	_ = w.WriteByte('>')
+	for _, s := range n.Sources {
+		if !r.Unsafe && html.IsDangerousURL(s.Srcset) {
+			continue
+		}
+		_, _ = w.WriteString(`<source srcset="`)
+		_, _ = w.Write(util.EscapeHTML(util.URLEscape(s.Srcset, true)))
+		_ = w.WriteByte('"')
+		if len(s.Media) != 0 {
+			_, _ = w.WriteString(` media="`)
+			_, _ = w.Write(util.EscapeHTML(s.Media))
+			_ = w.WriteByte('"')
+		}
+		if r.XHTML {
+			_, _ = w.WriteString(" />")
+		} else {
+			_ = w.WriteByte('>')
+		}
+	}
+	return gast.WalkContinue, nil
+}
+
+type darkModeImage struct {
+}
+
+// DarkModeImage is an extension that renders images that have a dark mode
+// variant like '![alt](diagram.png){dark=diagram-dark.png}' as picture
+// elements that switch sources by 'prefers-color-scheme'.
+var DarkModeImage = &darkModeImage{}
+
+func (e *darkModeImage) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithASTTransformers(
+			util.Prioritized(NewImageAttributeTransformer(), 500),
+			util.Prioritized(NewDarkModeImageASTTransformer(), 700),
+		),
+	)
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(NewPictureHTMLRenderer(), 500),
+	))
+}
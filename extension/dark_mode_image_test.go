@@ -0,0 +1,16 @@
+package extension
+
+import (
+	"testing"
+
+	"github.com/yuin/goldmark"
+)
+
+func TestDarkModeImage(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			DarkModeImage,
+		),
+	)
+	goldmark.DoTestCaseFile(markdown, "_test/dark_mode_image.txt", t)
+}
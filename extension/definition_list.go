@@ -108,7 +108,10 @@ func (b *definitionDescriptionParser) Open(parent gast.Node, reader text.Reader,
 	if line[pos] != ':' {
 		return nil, parser.NoChildren
 	}
-	list, _ := parent.(*ast.DefinitionList)
+	list, ok := parent.(*ast.DefinitionList)
+	if !ok {
+		return nil, parser.NoChildren
+	}
 	para := list.TemporaryParagraph
 	list.TemporaryParagraph = nil
 	if para != nil {
@@ -157,17 +160,89 @@ func (b *definitionDescriptionParser) CanAcceptIndentedLine() bool {
 	return false
 }
 
+// A DefinitionListConfig struct is a data structure that holds
+// configuration of the DefinitionList extension.
+type DefinitionListConfig struct {
+	// Class is a class of definition lists.
+	Class []byte
+
+	// TermClass is a class of terms.
+	TermClass []byte
+
+	// DescriptionClass is a class of descriptions.
+	DescriptionClass []byte
+
+	// WrapperTag is a tag name of elements that wrap definition lists.
+	// Definition lists are not wrapped if WrapperTag is empty.
+	WrapperTag []byte
+
+	// WrapperClass is a class of elements that wrap definition lists.
+	WrapperClass []byte
+}
+
+// A DefinitionListOption interface sets options for the DefinitionList
+// extension.
+type DefinitionListOption interface {
+	SetDefinitionListOption(*DefinitionListConfig)
+}
+
+type withDefinitionListClasses struct {
+	list        []byte
+	term        []byte
+	description []byte
+}
+
+func (o *withDefinitionListClasses) SetDefinitionListOption(c *DefinitionListConfig) {
+	c.Class = o.list
+	c.TermClass = o.term
+	c.DescriptionClass = o.description
+}
+
+// WithDefinitionListClasses is a functional option that sets classes of
+// definition lists, terms and descriptions. Empty values mean no classes.
+func WithDefinitionListClasses(list, term, description string) DefinitionListOption {
+	return &withDefinitionListClasses{[]byte(list), []byte(term), []byte(description)}
+}
+
+type withDefinitionListWrapper struct {
+	tag   []byte
+	class []byte
+}
+
+func (o *withDefinitionListWrapper) SetDefinitionListOption(c *DefinitionListConfig) {
+	c.WrapperTag = o.tag
+	c.WrapperClass = o.class
+}
+
+// WithDefinitionListWrapper is a functional option that wraps definition
+// lists with elements of the given tag name and class like
+// '<div class="glossary">'.
+func WithDefinitionListWrapper(tag, class string) DefinitionListOption {
+	return &withDefinitionListWrapper{[]byte(tag), []byte(class)}
+}
+
 // DefinitionListHTMLRenderer is a renderer.NodeRenderer implementation that
 // renders DefinitionList nodes.
 type DefinitionListHTMLRenderer struct {
 	html.Config
+	DefinitionListConfig
 }
 
 // NewDefinitionListHTMLRenderer returns a new DefinitionListHTMLRenderer.
 func NewDefinitionListHTMLRenderer(opts ...html.Option) renderer.NodeRenderer {
+	return NewDefinitionListHTMLRendererWithOptions(nil, opts...)
+}
+
+// NewDefinitionListHTMLRendererWithOptions returns a new
+// DefinitionListHTMLRenderer with the given DefinitionListOptions and
+// html.Options.
+func NewDefinitionListHTMLRendererWithOptions(definitionListOpts []DefinitionListOption, opts ...html.Option) renderer.NodeRenderer {
 	r := &DefinitionListHTMLRenderer{
 		Config: html.NewConfig(),
 	}
+	for _, opt := range definitionListOpts {
+		opt.SetDefinitionListOption(&r.DefinitionListConfig)
+	}
 	for _, opt := range opts {
 		opt.SetHTMLOption(&r.Config)
 	}
@@ -181,18 +256,50 @@ func (r *DefinitionListHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFunc
 	reg.Register(ast.KindDefinitionDescription, r.renderDefinitionDescription)
 }
 
+// writeStartTag writes a start tag of the given node with the given class
+// without a closing '>'.
+func (r *DefinitionListHTMLRenderer) writeStartTag(w util.BufWriter, tag string, class []byte, n gast.Node) {
+	w.WriteByte('<')
+	w.WriteString(tag)
+	if len(class) != 0 {
+		w.WriteString(` class="`)
+		w.Write(util.EscapeHTML(class))
+		w.WriteByte('"')
+	}
+	if n.Attributes() != nil || r.SourcePos {
+		r.RenderAttributes(w, n)
+	}
+}
+
 func (r *DefinitionListHTMLRenderer) renderDefinitionList(w util.BufWriter, source []byte, n gast.Node, entering bool) (gast.WalkStatus, error) {
 	if entering {
-		w.WriteString("<dl>\n")
+		if len(r.WrapperTag) != 0 {
+			w.WriteByte('<')
+			w.Write(r.WrapperTag)
+			if len(r.WrapperClass) != 0 {
+				w.WriteString(` class="`)
+				w.Write(util.EscapeHTML(r.WrapperClass))
+				w.WriteByte('"')
+			}
+			w.WriteString(">\n")
+		}
+		r.writeStartTag(w, "dl", r.Class, n)
+		w.WriteString(">\n")
 	} else {
 		w.WriteString("</dl>\n")
+		if len(r.WrapperTag) != 0 {
+			w.WriteString("</")
+			w.Write(r.WrapperTag)
+			w.WriteString(">\n")
+		}
 	}
 	return gast.WalkContinue, nil
 }
 
 func (r *DefinitionListHTMLRenderer) renderDefinitionTerm(w util.BufWriter, source []byte, n gast.Node, entering bool) (gast.WalkStatus, error) {
 	if entering {
-		w.WriteString("<dt>")
+		r.writeStartTag(w, "dt", r.TermClass, n)
+		w.WriteString(">")
 	} else {
 		w.WriteString("</dt>\n")
 	}
@@ -202,10 +309,11 @@ func (r *DefinitionListHTMLRenderer) renderDefinitionTerm(w util.BufWriter, sour
 func (r *DefinitionListHTMLRenderer) renderDefinitionDescription(w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
 	if entering {
 		n := node.(*ast.DefinitionDescription)
+		r.writeStartTag(w, "dd", r.DescriptionClass, n)
 		if n.IsTight {
-			w.WriteString("<dd>")
+			w.WriteString(">")
 		} else {
-			w.WriteString("<dd>\n")
+			w.WriteString(">\n")
 		}
 	} else {
 		w.WriteString("</dd>\n")
@@ -214,17 +322,26 @@ func (r *DefinitionListHTMLRenderer) renderDefinitionDescription(w util.BufWrite
 }
 
 type definitionList struct {
+	options []DefinitionListOption
 }
 
 // DefinitionList is an extension that allow you to use PHP Markdown Extra Definition lists.
 var DefinitionList = &definitionList{}
 
+// NewDefinitionList returns a new Extender that allow you to use PHP
+// Markdown Extra Definition lists with the given options.
+func NewDefinitionList(opts ...DefinitionListOption) goldmark.Extender {
+	return &definitionList{
+		options: opts,
+	}
+}
+
 func (e *definitionList) Extend(m goldmark.Markdown) {
 	m.Parser().AddOptions(parser.WithBlockParsers(
 		util.Prioritized(NewDefinitionListParser(), 101),
 		util.Prioritized(NewDefinitionDescriptionParser(), 102),
 	))
 	m.Renderer().AddOptions(renderer.WithNodeRenderers(
-		util.Prioritized(NewDefinitionListHTMLRenderer(), 500),
+		util.Prioritized(NewDefinitionListHTMLRendererWithOptions(e.options), 500),
 	))
 }
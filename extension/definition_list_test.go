@@ -1,9 +1,12 @@
 package extension
 
 import (
+	"testing"
+
 	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/renderer"
 	"github.com/yuin/goldmark/renderer/html"
-	"testing"
+	"github.com/yuin/goldmark/util"
 )
 
 func TestDefinitionList(t *testing.T) {
@@ -17,3 +20,59 @@ func TestDefinitionList(t *testing.T) {
 	)
 	goldmark.DoTestCaseFile(markdown, "_test/definition_list.txt", t)
 }
+
+func TestDefinitionListOptions(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			NewDefinitionList(
+				WithDefinitionListClasses("terms", "term", "description"),
+				WithDefinitionListWrapper("div", "glossary"),
+			),
+		),
+	)
+	goldmark.DoTestCases(markdown, []goldmark.MarkdownTestCase{{
+		No: 1,
+		Markdown: `Apple
+:   Pomaceous fruit.`,
+		Expected: `<div class="glossary">
+<dl class="terms">
+<dt class="term">Apple</dt>
+<dd class="description">Pomaceous fruit.</dd>
+</dl>
+</div>`,
+	}}, t)
+}
+
+func TestDefinitionListHTMLRendererOptions(t *testing.T) {
+	source := `Apple
+:   Pomaceous fruit.`
+	for i, c := range []struct {
+		renderer renderer.NodeRenderer
+		expected string
+	}{
+		{NewDefinitionListHTMLRenderer(html.WithXHTML()), `<dl>
+<dt>Apple</dt>
+<dd>Pomaceous fruit.</dd>
+</dl>`},
+		{NewDefinitionListHTMLRendererWithOptions([]DefinitionListOption{WithDefinitionListClasses("terms", "", "")}, html.WithXHTML()), `<dl class="terms">
+<dt>Apple</dt>
+<dd>Pomaceous fruit.</dd>
+</dl>`},
+	} {
+		markdown := goldmark.New(
+			goldmark.WithExtensions(
+				DefinitionList,
+			),
+			goldmark.WithRendererOptions(
+				renderer.WithNodeRenderers(
+					util.Prioritized(c.renderer, 100),
+				),
+			),
+		)
+		goldmark.DoTestCases(markdown, []goldmark.MarkdownTestCase{{
+			No:       i + 1,
+			Markdown: source,
+			Expected: c.expected,
+		}}, t)
+	}
+}
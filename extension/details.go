@@ -0,0 +1,180 @@
+package extension
+
+import (
+	"bytes"
+
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// A DetailsConfig struct is a data structure that holds configuration
+// of the Details extension.
+type DetailsConfig struct {
+	// Class is a class of collapsible sections. Types of sections like
+	// 'note' are added as classes too.
+	Class []byte
+}
+
+// A DetailsOption interface sets options for the Details extension.
+type DetailsOption interface {
+	SetDetailsOption(*DetailsConfig)
+}
+
+type withDetailsClass struct {
+	value []byte
+}
+
+func (o *withDetailsClass) SetDetailsOption(c *DetailsConfig) {
+	c.Class = o.value
+}
+
+// WithDetailsClass is a functional option that sets a class of
+// collapsible sections. By default, only types of sections are added as
+// classes.
+func WithDetailsClass(class string) DetailsOption {
+	return &withDetailsClass{[]byte(class)}
+}
+
+var detailsMarker = []byte("???")
+
+type detailsParser struct {
+}
+
+var defaultDetailsParser = &detailsParser{}
+
+// NewDetailsParser returns a new parser.BlockParser that can parse
+// PyMdown Extensions style collapsible sections like '??? note "Summary"'.
+// Sections starting with '???+' are expanded by default.
+// Bodies of sections must be indented by 4 spaces.
+func NewDetailsParser() parser.BlockParser {
+	return defaultDetailsParser
+}
+
+func (b *detailsParser) Open(parent gast.Node, reader text.Reader, pc parser.Context) (gast.Node, parser.State) {
+	line, segment := reader.PeekLine()
+	pos := pc.BlockOffset()
+	if pos < 0 || !bytes.HasPrefix(line[pos:], detailsMarker) {
+		return nil, parser.NoChildren
+	}
+	rest := line[pos+len(detailsMarker):]
+	open := len(rest) != 0 && rest[0] == '+'
+	if open {
+		rest = rest[1:]
+	}
+	if len(rest) == 0 || !util.IsSpace(rest[0]) {
+		return nil, parser.NoChildren
+	}
+	typ, classes, summary, ok := parseAdmonitionHeader(rest, false)
+	if !ok {
+		return nil, parser.NoChildren
+	}
+	node := ast.NewDetails(typ, summary, open)
+	node.Classes = classes
+	reader.Advance(segment.Len() - 1)
+	return node, parser.HasChildren
+}
+
+func (b *detailsParser) Continue(node gast.Node, reader text.Reader, pc parser.Context) parser.State {
+	return defaultAdmonitionParser.Continue(node, reader, pc)
+}
+
+func (b *detailsParser) Close(node gast.Node, reader text.Reader, pc parser.Context) {
+	// nothing to do
+}
+
+func (b *detailsParser) CanInterruptParagraph() bool {
+	return false
+}
+
+func (b *detailsParser) CanAcceptIndentedLine() bool {
+	return false
+}
+
+// DetailsHTMLRenderer is a renderer.NodeRenderer implementation that
+// renders Details nodes.
+type DetailsHTMLRenderer struct {
+	html.Config
+	DetailsConfig
+}
+
+// NewDetailsHTMLRenderer returns a new DetailsHTMLRenderer.
+func NewDetailsHTMLRenderer(opts ...DetailsOption) renderer.NodeRenderer {
+	r := &DetailsHTMLRenderer{
+		Config: html.NewConfig(),
+	}
+	for _, opt := range opts {
+		opt.SetDetailsOption(&r.DetailsConfig)
+	}
+	return r
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *DetailsHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindDetails, r.renderDetails)
+}
+
+func (r *DetailsHTMLRenderer) renderDetails(w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	if !entering {
+		_, _ = w.WriteString("</details>\n")
+		return gast.WalkContinue, nil
+	}
+	n := node.(*ast.Details)
+	classes := [][]byte{}
+	if len(r.Class) != 0 {
+		classes = append(classes, r.Class)
+	}
+	if n.DetailsType != nil {
+		classes = append(classes, n.DetailsType)
+	}
+	classes = append(classes, n.Classes...)
+	_, _ = w.WriteString("<details")
+	if len(classes) != 0 {
+		_, _ = w.WriteString(` class="`)
+		_, _ = w.Write(util.EscapeHTML(bytes.Join(classes, []byte{' '})))
+		_ = w.WriteByte('"')
+	}
+	if n.IsOpen {
+		_, _ = w.WriteString(` open=""`)
+	}
+	if n.Attributes() != nil || r.SourcePos {
+		r.RenderAttributes(w, n)
+	}
+	_, _ = w.WriteString(">\n")
+	if n.Summary != nil {
+		_, _ = w.WriteString("<summary>")
+		_, _ = w.Write(util.EscapeHTML(n.Summary))
+		_, _ = w.WriteString("</summary>\n")
+	}
+	return gast.WalkContinue, nil
+}
+
+type details struct {
+	options []DetailsOption
+}
+
+// Details is an extension that allows you to use PyMdown Extensions style
+// collapsible sections like '??? note "Summary"' and '???+ note "Summary"'.
+var Details = &details{}
+
+// NewDetails returns a new Extender that renders collapsible sections with
+// the given options.
+func NewDetails(opts ...DetailsOption) goldmark.Extender {
+	return &details{
+		options: opts,
+	}
+}
+
+func (e *details) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(parser.WithBlockParsers(
+		util.Prioritized(NewDetailsParser(), 999),
+	))
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(NewDetailsHTMLRenderer(e.options...), 500),
+	))
+}
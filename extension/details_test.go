@@ -0,0 +1,31 @@
+package extension
+
+import (
+	"testing"
+
+	"github.com/yuin/goldmark"
+)
+
+func TestDetails(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			Details,
+		),
+	)
+	goldmark.DoTestCaseFile(markdown, "_test/details.txt", t)
+}
+
+func TestDetailsOptions(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			NewDetails(WithDetailsClass("collapsible")),
+		),
+	)
+	goldmark.DoTestCases(markdown, []goldmark.MarkdownTestCase{{
+		No:       1,
+		Markdown: "??? warning \"\"\n    body\n",
+		Expected: `<details class="collapsible warning">
+<p>body</p>
+</details>`,
+	}}, t)
+}
@@ -0,0 +1,245 @@
+package extension
+
+import (
+	"bufio"
+	"bytes"
+
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// DiagramRenderFunc is a function that renders a diagram on the server
+// side like rendering a diagram as an SVG image.
+//
+// If DiagramRenderFunc returns an error, contents written by the function
+// are discarded and the diagram will be passed through to the client side.
+// The error will be reported as a Diagnostic.
+type DiagramRenderFunc func(w util.BufWriter, language []byte, code []byte) error
+
+// A DiagramConfig struct is a data structure that holds configuration of
+// the Diagram extension.
+type DiagramConfig struct {
+	// Languages are languages of fenced code blocks that contain diagrams.
+	Languages [][]byte
+
+	// Tag is an HTML tag name for diagrams. Diagrams have their languages
+	// as classes like '<pre class="mermaid">' so that client side
+	// libraries can find them.
+	Tag []byte
+
+	// Renderer renders diagrams on the server side. Diagrams are passed
+	// through to the client side if Renderer is nil.
+	Renderer DiagramRenderFunc
+}
+
+// NewDiagramConfig returns a new DiagramConfig with defaults.
+func NewDiagramConfig() DiagramConfig {
+	return DiagramConfig{
+		Languages: [][]byte{
+			[]byte("mermaid"),
+			[]byte("dot"),
+			[]byte("graphviz"),
+			[]byte("plantuml"),
+		},
+		Tag: []byte("pre"),
+	}
+}
+
+// A DiagramOption interface sets options for the Diagram extension.
+type DiagramOption interface {
+	SetDiagramOption(*DiagramConfig)
+}
+
+type withDiagramLanguages struct {
+	value [][]byte
+}
+
+func (o *withDiagramLanguages) SetDiagramOption(c *DiagramConfig) {
+	c.Languages = o.value
+}
+
+// WithDiagramLanguages is a functional option that sets languages of
+// fenced code blocks that contain diagrams. The defaults are "mermaid",
+// "dot", "graphviz" and "plantuml".
+func WithDiagramLanguages(languages ...string) DiagramOption {
+	value := make([][]byte, 0, len(languages))
+	for _, language := range languages {
+		value = append(value, []byte(language))
+	}
+	return &withDiagramLanguages{value}
+}
+
+type withDiagramTag struct {
+	value []byte
+}
+
+func (o *withDiagramTag) SetDiagramOption(c *DiagramConfig) {
+	c.Tag = o.value
+}
+
+// WithDiagramTag is a functional option that sets an HTML tag name for
+// diagrams like "div". The default is "pre".
+func WithDiagramTag(tag string) DiagramOption {
+	return &withDiagramTag{[]byte(tag)}
+}
+
+type withDiagramRenderer struct {
+	value DiagramRenderFunc
+}
+
+func (o *withDiagramRenderer) SetDiagramOption(c *DiagramConfig) {
+	c.Renderer = o.value
+}
+
+// WithDiagramRenderer is a functional option that renders diagrams on the
+// server side with the given function.
+func WithDiagramRenderer(f DiagramRenderFunc) DiagramOption {
+	return &withDiagramRenderer{f}
+}
+
+type diagramASTTransformer struct {
+	DiagramConfig
+}
+
+// NewDiagramASTTransformer returns a new parser.ASTTransformer that
+// replaces fenced code blocks of diagram languages with Diagram nodes.
+func NewDiagramASTTransformer(opts ...DiagramOption) parser.ASTTransformer {
+	a := &diagramASTTransformer{
+		DiagramConfig: NewDiagramConfig(),
+	}
+	for _, o := range opts {
+		o.SetDiagramOption(&a.DiagramConfig)
+	}
+	return a
+}
+
+func (a *diagramASTTransformer) isDiagram(language []byte) bool {
+	for _, l := range a.Languages {
+		if bytes.Equal(l, language) {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *diagramASTTransformer) Transform(node *gast.Document, reader text.Reader, pc parser.Context) {
+	source := reader.Source()
+	var blocks []*gast.FencedCodeBlock
+	_ = gast.Walk(node, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+		if entering && n.Kind() == gast.KindFencedCodeBlock {
+			if b := n.(*gast.FencedCodeBlock); a.isDiagram(b.Language(source)) {
+				blocks = append(blocks, b)
+			}
+		}
+		return gast.WalkContinue, nil
+	})
+	for _, b := range blocks {
+		diagram := ast.NewDiagram(b.Language(source))
+		diagram.SetLines(b.Lines())
+		diagram.SetBlankPreviousLines(b.HasBlankPreviousLines())
+		for _, attr := range b.Attributes() {
+			diagram.SetAttribute(attr.Name, attr.Value)
+		}
+		b.Parent().ReplaceChild(b.Parent(), b, diagram)
+	}
+}
+
+// DiagramHTMLRenderer is a renderer.NodeRenderer implementation that
+// renders Diagram nodes.
+type DiagramHTMLRenderer struct {
+	html.Config
+	DiagramConfig
+}
+
+// NewDiagramHTMLRenderer returns a new DiagramHTMLRenderer.
+func NewDiagramHTMLRenderer(opts ...DiagramOption) renderer.NodeRenderer {
+	r := &DiagramHTMLRenderer{
+		Config:        html.NewConfig(),
+		DiagramConfig: NewDiagramConfig(),
+	}
+	for _, opt := range opts {
+		opt.SetDiagramOption(&r.DiagramConfig)
+	}
+	return r
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *DiagramHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindDiagram, r.renderDiagram)
+}
+
+// renderDiagramWithRenderer returns false if the diagram should be passed
+// through to the client side.
+func (r *DiagramHTMLRenderer) renderDiagramWithRenderer(w util.BufWriter, n *ast.Diagram, code []byte) bool {
+	if r.Renderer == nil {
+		return false
+	}
+	var buf bytes.Buffer
+	bw := bufio.NewWriter(&buf)
+	err := r.Renderer(bw, n.Language, code)
+	if err == nil {
+		err = bw.Flush()
+	}
+	if err != nil {
+		r.ReportDiagnostic(n, err)
+		return false
+	}
+	_, _ = w.Write(buf.Bytes())
+	return true
+}
+
+func (r *DiagramHTMLRenderer) renderDiagram(w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	if !entering {
+		return gast.WalkContinue, nil
+	}
+	n := node.(*ast.Diagram)
+	code := n.Lines().Value(source)
+	if r.renderDiagramWithRenderer(w, n, code) {
+		return gast.WalkSkipChildren, nil
+	}
+	_ = w.WriteByte('<')
+	_, _ = w.Write(r.Tag)
+	_, _ = w.WriteString(` class="`)
+	_, _ = w.Write(util.EscapeHTML(n.Language))
+	_ = w.WriteByte('"')
+	if n.Attributes() != nil || r.SourcePos {
+		r.RenderAttributes(w, n)
+	}
+	_ = w.WriteByte('>')
+	_, _ = w.Write(util.EscapeHTML(code))
+	_, _ = w.WriteString("</")
+	_, _ = w.Write(r.Tag)
+	_, _ = w.WriteString(">\n")
+	return gast.WalkSkipChildren, nil
+}
+
+type diagram struct {
+	options []DiagramOption
+}
+
+// Diagram is an extension that passes fenced code blocks of diagrams like
+// '```mermaid' through to client side libraries like mermaid.js.
+var Diagram = &diagram{}
+
+// NewDiagram returns a new Extender that renders diagrams with the given
+// options.
+func NewDiagram(opts ...DiagramOption) goldmark.Extender {
+	return &diagram{
+		options: opts,
+	}
+}
+
+func (e *diagram) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(parser.WithASTTransformers(
+		util.Prioritized(NewDiagramASTTransformer(e.options...), 500),
+	))
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(NewDiagramHTMLRenderer(e.options...), 500),
+	))
+}
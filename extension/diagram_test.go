@@ -0,0 +1,61 @@
+package extension
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/util"
+)
+
+func TestDiagram(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			Diagram,
+		),
+	)
+	goldmark.DoTestCaseFile(markdown, "_test/diagram.txt", t)
+}
+
+func TestDiagramRenderer(t *testing.T) {
+	var diagnostics []html.Diagnostic
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			NewDiagram(
+				WithDiagramTag("div"),
+				WithDiagramRenderer(func(w util.BufWriter, language []byte, code []byte) error {
+					if string(language) == "mermaid" {
+						_, _ = w.WriteString("<svg>partial")
+						return errors.New("mermaid is not supported")
+					}
+					_, _ = w.WriteString("<svg><!-- ")
+					_, _ = w.Write(bytes.TrimSpace(code))
+					_, _ = w.WriteString(" --></svg>\n")
+					return nil
+				}),
+			),
+		),
+		goldmark.WithRendererOptions(
+			html.WithDiagnosticHandler(func(d html.Diagnostic) {
+				diagnostics = append(diagnostics, d)
+			}),
+		),
+	)
+	var b bytes.Buffer
+	source := []byte("```dot\ndigraph { a -> b }\n```\n\n```mermaid\ngraph TD; A-->B;\n```\n")
+	if err := markdown.Convert(source, &b); err != nil {
+		t.Fatal(err)
+	}
+	expected := `<svg><!-- digraph { a -> b } --></svg>
+<div class="mermaid">graph TD; A--&gt;B;
+</div>
+`
+	if b.String() != expected {
+		t.Errorf("unexpected output:\n%s", b.String())
+	}
+	if len(diagnostics) != 1 || diagnostics[0].Err.Error() != "mermaid is not supported" {
+		t.Errorf("unexpected diagnostics: %v", diagnostics)
+	}
+}
@@ -0,0 +1,423 @@
+package extension
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// ErrUnsupportedEmbed is returned by EmbedFetchers when the given URL
+// can not be embedded.
+var ErrUnsupportedEmbed = errors.New("unsupported embed")
+
+// An EmbedData struct is metadata of an embedded URL.
+// EmbedData is compatible with oEmbed responses.
+type EmbedData struct {
+	// Type is one of 'link', 'photo', 'video' and 'rich'.
+	Type         string
+	ProviderName string
+	Title        string
+	Description  string
+	ThumbnailURL string
+	HTML         string
+	URL          string
+	Width        int
+	Height       int
+}
+
+// An EmbedFetcher interface fetches metadata of URLs.
+type EmbedFetcher interface {
+	// Fetch returns metadata of the given URL.
+	Fetch(url string) (*EmbedData, error)
+}
+
+// EmbedFetcherFunc is a function that implements EmbedFetcher.
+type EmbedFetcherFunc func(url string) (*EmbedData, error)
+
+// Fetch implements EmbedFetcher.Fetch.
+func (f EmbedFetcherFunc) Fetch(url string) (*EmbedData, error) {
+	return f(url)
+}
+
+// An OEmbedProvider struct is a configuration of an oEmbed provider.
+type OEmbedProvider struct {
+	// Name is a name of the provider.
+	Name string
+
+	// Endpoint is a URL of the oEmbed API.
+	Endpoint string
+
+	// Schemes are URL patterns that the provider supports.
+	// '*' matches any characters.
+	Schemes []string
+
+	// AllowHTML indicates that HTML snippets(i.e. iframes) provided by
+	// this provider can be rendered. Otherwise URLs are rendered as
+	// link cards.
+	AllowHTML bool
+}
+
+func (p *OEmbedProvider) match(u string) bool {
+	for _, scheme := range p.Schemes {
+		pattern := "^" + strings.Replace(regexp.QuoteMeta(scheme), `\*`, ".*", -1) + "$"
+		if ok, _ := regexp.MatchString(pattern, u); ok {
+			return true
+		}
+	}
+	return false
+}
+
+type oEmbedResponse struct {
+	Type            string      `json:"type"`
+	ProviderName    string      `json:"provider_name"`
+	Title           string      `json:"title"`
+	Description     string      `json:"description"`
+	ThumbnailURL    string      `json:"thumbnail_url"`
+	HTML            string      `json:"html"`
+	URL             string      `json:"url"`
+	Width           json.Number `json:"width"`
+	Height          json.Number `json:"height"`
+	ThumbnailWidth  json.Number `json:"thumbnail_width"`
+	ThumbnailHeight json.Number `json:"thumbnail_height"`
+}
+
+func atoiOrZero(n json.Number) int {
+	v, err := strconv.Atoi(string(n))
+	if err != nil {
+		return 0
+	}
+	return v
+}
+
+// NewOEmbedFetcher returns a new EmbedFetcher that fetches metadata from
+// the given oEmbed providers.
+// If client is nil, http.DefaultClient will be used.
+func NewOEmbedFetcher(client *http.Client, providers ...OEmbedProvider) EmbedFetcher {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return EmbedFetcherFunc(func(u string) (*EmbedData, error) {
+		var provider *OEmbedProvider
+		for i := range providers {
+			if providers[i].match(u) {
+				provider = &providers[i]
+				break
+			}
+		}
+		if provider == nil {
+			return nil, ErrUnsupportedEmbed
+		}
+		endpoint := provider.Endpoint
+		if strings.Contains(endpoint, "?") {
+			endpoint += "&"
+		} else {
+			endpoint += "?"
+		}
+		res, err := client.Get(endpoint + "format=json&url=" + url.QueryEscape(u))
+		if err != nil {
+			return nil, err
+		}
+		defer res.Body.Close()
+		if res.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("%s: unexpected status: %s", provider.Name, res.Status)
+		}
+		var v oEmbedResponse
+		if err := json.NewDecoder(res.Body).Decode(&v); err != nil {
+			return nil, err
+		}
+		data := &EmbedData{
+			Type:         v.Type,
+			ProviderName: v.ProviderName,
+			Title:        v.Title,
+			Description:  v.Description,
+			ThumbnailURL: v.ThumbnailURL,
+			HTML:         v.HTML,
+			URL:          v.URL,
+			Width:        atoiOrZero(v.Width),
+			Height:       atoiOrZero(v.Height),
+		}
+		if len(data.ProviderName) == 0 {
+			data.ProviderName = provider.Name
+		}
+		if !provider.AllowHTML {
+			data.HTML = ""
+		}
+		return data, nil
+	})
+}
+
+// NewEmbedFetchers returns a new EmbedFetcher that tries the given
+// fetchers in order until a fetcher supports a URL.
+func NewEmbedFetchers(fetchers ...EmbedFetcher) EmbedFetcher {
+	return EmbedFetcherFunc(func(url string) (*EmbedData, error) {
+		for _, f := range fetchers {
+			data, err := f.Fetch(url)
+			if err != ErrUnsupportedEmbed {
+				return data, err
+			}
+		}
+		return nil, ErrUnsupportedEmbed
+	})
+}
+
+// An EmbedConfig struct is a data structure that holds configuration of the
+// Embed extension.
+type EmbedConfig struct {
+	// Fetcher fetches metadata of URLs.
+	Fetcher EmbedFetcher
+}
+
+// An EmbedOption interface sets options for the Embed extension.
+type EmbedOption interface {
+	SetEmbedOption(*EmbedConfig)
+}
+
+type withEmbedFetcher struct {
+	value EmbedFetcher
+}
+
+func (o *withEmbedFetcher) SetEmbedOption(c *EmbedConfig) {
+	c.Fetcher = o.value
+}
+
+// WithEmbedFetcher is a functional option that sets a fetcher that fetches
+// metadata of URLs.
+func WithEmbedFetcher(fetcher EmbedFetcher) EmbedOption {
+	return &withEmbedFetcher{fetcher}
+}
+
+var bareURLRegexp = regexp.MustCompile(`^https?://[^\s<>]+$`)
+
+// bareURL returns a URL if the given paragraph consists solely of a bare URL.
+func bareURL(node gast.Node, source []byte) ([]byte, bool) {
+	if node.ChildCount() != 1 {
+		return nil, false
+	}
+	switch c := node.FirstChild().(type) {
+	case *gast.AutoLink:
+		if c.AutoLinkType == gast.AutoLinkURL {
+			return c.URL(source), true
+		}
+	case *gast.Text:
+		value := util.TrimRightSpace(util.TrimLeftSpace(c.Segment.Value(source)))
+		if bareURLRegexp.Match(value) {
+			return value, true
+		}
+	}
+	return nil, false
+}
+
+type embedASTTransformer struct {
+	fetcher EmbedFetcher
+}
+
+// NewEmbedASTTransformer returns a new parser.ASTTransformer that replaces
+// paragraphs consisting solely of a bare URL with Embed nodes.
+// Paragraphs that can not be fetched are left as they are.
+func NewEmbedASTTransformer(fetcher EmbedFetcher) parser.ASTTransformer {
+	return &embedASTTransformer{
+		fetcher: fetcher,
+	}
+}
+
+func (a *embedASTTransformer) Transform(node *gast.Document, reader text.Reader, pc parser.Context) {
+	if a.fetcher == nil {
+		return
+	}
+	source := reader.Source()
+	var paragraphs []gast.Node
+	_ = gast.Walk(node, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+		if !entering {
+			return gast.WalkContinue, nil
+		}
+		if n.Kind() == gast.KindParagraph {
+			if _, ok := bareURL(n, source); ok {
+				paragraphs = append(paragraphs, n)
+			}
+			return gast.WalkSkipChildren, nil
+		}
+		return gast.WalkContinue, nil
+	})
+	for _, paragraph := range paragraphs {
+		u, _ := bareURL(paragraph, source)
+		data, err := a.fetcher.Fetch(string(u))
+		if err != nil || data == nil {
+			continue
+		}
+		embed := newEmbed(u, data)
+		embed.SetLines(paragraph.Lines())
+		embed.SetBlankPreviousLines(paragraph.HasBlankPreviousLines())
+		paragraph.Parent().ReplaceChild(paragraph.Parent(), paragraph, embed)
+	}
+}
+
+// newEmbed returns a new Embed node of the given URL and metadata.
+func newEmbed(u []byte, data *EmbedData) *ast.Embed {
+	embed := ast.NewEmbed(u)
+	switch data.Type {
+	case "photo":
+		embed.EmbedType = ast.EmbedPhoto
+	case "video":
+		embed.EmbedType = ast.EmbedVideo
+	case "rich":
+		embed.EmbedType = ast.EmbedRich
+	default:
+		embed.EmbedType = ast.EmbedLink
+	}
+	embed.ProviderName = []byte(data.ProviderName)
+	embed.Title = []byte(data.Title)
+	embed.Description = []byte(data.Description)
+	embed.ThumbnailURL = []byte(data.ThumbnailURL)
+	if embed.EmbedType == ast.EmbedPhoto && len(data.URL) != 0 {
+		embed.ThumbnailURL = []byte(data.URL)
+	}
+	embed.HTML = []byte(data.HTML)
+	embed.Width = data.Width
+	embed.Height = data.Height
+	return embed
+}
+
+// EmbedHTMLRenderer is a renderer.NodeRenderer implementation that
+// renders Embed nodes.
+type EmbedHTMLRenderer struct {
+	html.Config
+}
+
+// NewEmbedHTMLRenderer returns a new EmbedHTMLRenderer.
+func NewEmbedHTMLRenderer(opts ...html.Option) renderer.NodeRenderer {
+	r := &EmbedHTMLRenderer{
+		Config: html.NewConfig(),
+	}
+	for _, opt := range opts {
+		opt.SetHTMLOption(&r.Config)
+	}
+	return r
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *EmbedHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindEmbed, r.renderEmbed)
+}
+
+func (r *EmbedHTMLRenderer) writeURL(w util.BufWriter, u []byte) {
+	if r.Unsafe || !html.IsDangerousURL(u) {
+		_, _ = w.Write(util.EscapeHTML(util.URLEscape(u, true)))
+	}
+}
+
+func (r *EmbedHTMLRenderer) writeSize(w util.BufWriter, n *ast.Embed) {
+	if n.Width > 0 {
+		_, _ = w.WriteString(` width="`)
+		_, _ = w.WriteString(strconv.Itoa(n.Width))
+		_ = w.WriteByte('"')
+	}
+	if n.Height > 0 {
+		_, _ = w.WriteString(` height="`)
+		_, _ = w.WriteString(strconv.Itoa(n.Height))
+		_ = w.WriteByte('"')
+	}
+}
+
+func (r *EmbedHTMLRenderer) renderEmbed(w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	if !entering {
+		return gast.WalkContinue, nil
+	}
+	n := node.(*ast.Embed)
+	_, _ = w.WriteString(`<div class="embed embed-`)
+	_, _ = w.WriteString(n.EmbedType.String())
+	_, _ = w.WriteString(`">`)
+	switch {
+	case len(n.HTML) != 0:
+		_, _ = w.Write(n.HTML)
+	case n.EmbedType == ast.EmbedPhoto && len(n.ThumbnailURL) != 0:
+		_, _ = w.WriteString(`<a href="`)
+		r.writeURL(w, n.URL)
+		_, _ = w.WriteString(`"><img src="`)
+		r.writeURL(w, n.ThumbnailURL)
+		_, _ = w.WriteString(`" alt="`)
+		_, _ = w.Write(util.EscapeHTML(n.Title))
+		_ = w.WriteByte('"')
+		r.writeSize(w, n)
+		if r.XHTML {
+			_, _ = w.WriteString(" />")
+		} else {
+			_, _ = w.WriteString(">")
+		}
+		_, _ = w.WriteString("</a>")
+	default:
+		_, _ = w.WriteString(`<a class="embed-card" href="`)
+		r.writeURL(w, n.URL)
+		_, _ = w.WriteString(`">`)
+		if len(n.ThumbnailURL) != 0 {
+			_, _ = w.WriteString(`<img class="embed-thumbnail" src="`)
+			r.writeURL(w, n.ThumbnailURL)
+			_, _ = w.WriteString(`" alt=""`)
+			if r.XHTML {
+				_, _ = w.WriteString(" />")
+			} else {
+				_, _ = w.WriteString(">")
+			}
+		}
+		title := n.Title
+		if len(title) == 0 {
+			title = n.URL
+		}
+		_, _ = w.WriteString(`<span class="embed-title">`)
+		_, _ = w.Write(util.EscapeHTML(title))
+		_, _ = w.WriteString(`</span>`)
+		if len(n.Description) != 0 {
+			_, _ = w.WriteString(`<span class="embed-description">`)
+			_, _ = w.Write(util.EscapeHTML(n.Description))
+			_, _ = w.WriteString(`</span>`)
+		}
+		if len(n.ProviderName) != 0 {
+			_, _ = w.WriteString(`<span class="embed-provider">`)
+			_, _ = w.Write(util.EscapeHTML(n.ProviderName))
+			_, _ = w.WriteString(`</span>`)
+		}
+		_, _ = w.WriteString("</a>")
+	}
+	_, _ = w.WriteString("</div>\n")
+	return gast.WalkContinue, nil
+}
+
+type embed struct {
+	options []EmbedOption
+}
+
+// NewEmbed returns a new Extender that replaces paragraphs consisting solely
+// of a bare URL with embedded contents like video players and link cards.
+func NewEmbed(opts ...EmbedOption) goldmark.Extender {
+	return &embed{
+		options: opts,
+	}
+}
+
+func (e *embed) Extend(m goldmark.Markdown) {
+	config := EmbedConfig{}
+	for _, opt := range e.options {
+		opt.SetEmbedOption(&config)
+	}
+	m.Parser().AddOptions(
+		parser.WithASTTransformers(
+			util.Prioritized(NewEmbedASTTransformer(config.Fetcher), 999),
+		),
+	)
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(NewEmbedHTMLRenderer(), 500),
+	))
+}
@@ -0,0 +1,62 @@
+package extension
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/renderer/html"
+)
+
+func TestEmbed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("url") {
+		case "https://video.example.com/watch/1":
+			fmt.Fprint(w, `{"type":"video","html":"<iframe src=\"https://video.example.com/embed/1\"></iframe>"}`)
+		case "https://photo.example.com/p/1":
+			fmt.Fprint(w, `{"type":"photo","title":"Photo","url":"https://photo.example.com/p/1.jpg","width":640,"height":"480","html":"<script></script>"}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	markdown := goldmark.New(
+		goldmark.WithRendererOptions(
+			html.WithXHTML(),
+		),
+		goldmark.WithExtensions(
+			NewEmbed(
+				WithEmbedFetcher(NewEmbedFetchers(
+					NewOEmbedFetcher(server.Client(),
+						OEmbedProvider{
+							Name:      "Video",
+							Endpoint:  server.URL,
+							Schemes:   []string{"https://video.example.com/watch/*"},
+							AllowHTML: true,
+						},
+						OEmbedProvider{
+							Name:     "Photo",
+							Endpoint: server.URL,
+							Schemes:  []string{"https://photo.example.com/*"},
+						},
+					),
+					EmbedFetcherFunc(func(url string) (*EmbedData, error) {
+						if url != "https://example.com/articles/1" {
+							return nil, ErrUnsupportedEmbed
+						}
+						return &EmbedData{
+							Type:         "link",
+							ProviderName: "Example",
+							Title:        "Article & Title",
+							ThumbnailURL: "https://example.com/thumb.png",
+						}, nil
+					}),
+				)),
+			),
+		),
+	)
+	goldmark.DoTestCaseFile(markdown, "_test/embed.txt", t)
+}
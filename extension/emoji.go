@@ -0,0 +1,295 @@
+package extension
+
+import (
+	"bytes"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// An EmojiDefinition struct is a definition of an emoji written as
+// ':name:'.
+type EmojiDefinition struct {
+	// Value is a Unicode string of the emoji like "\U0001F44D".
+	Value string
+
+	// Image is a URL of a custom emoji image. Value is ignored if Image is
+	// not empty.
+	Image string
+
+	// SkinTones is true if skin tone modifiers can be applied to the emoji
+	// like ':+1::skin-tone-3:' and ':+1_tone2:'.
+	SkinTones bool
+}
+
+var defaultEmojis = map[string]EmojiDefinition{
+	"smile":            {Value: "\U0001F604"},
+	"laughing":         {Value: "\U0001F606"},
+	"blush":            {Value: "\U0001F60A"},
+	"heart_eyes":       {Value: "\U0001F60D"},
+	"wink":             {Value: "\U0001F609"},
+	"joy":              {Value: "\U0001F602"},
+	"sob":              {Value: "\U0001F62D"},
+	"cry":              {Value: "\U0001F622"},
+	"thinking":         {Value: "\U0001F914"},
+	"sunglasses":       {Value: "\U0001F60E"},
+	"+1":               {Value: "\U0001F44D", SkinTones: true},
+	"thumbsup":         {Value: "\U0001F44D", SkinTones: true},
+	"-1":               {Value: "\U0001F44E", SkinTones: true},
+	"thumbsdown":       {Value: "\U0001F44E", SkinTones: true},
+	"ok_hand":          {Value: "\U0001F44C", SkinTones: true},
+	"wave":             {Value: "\U0001F44B", SkinTones: true},
+	"clap":             {Value: "\U0001F44F", SkinTones: true},
+	"pray":             {Value: "\U0001F64F", SkinTones: true},
+	"muscle":           {Value: "\U0001F4AA", SkinTones: true},
+	"raised_hands":     {Value: "\U0001F64C", SkinTones: true},
+	"point_up":         {Value: "\u261D\uFE0F", SkinTones: true},
+	"v":                {Value: "\u270C\uFE0F", SkinTones: true},
+	"heart":            {Value: "\u2764\uFE0F"},
+	"broken_heart":     {Value: "\U0001F494"},
+	"fire":             {Value: "\U0001F525"},
+	"star":             {Value: "\u2B50"},
+	"sparkles":         {Value: "\u2728"},
+	"tada":             {Value: "\U0001F389"},
+	"rocket":           {Value: "\U0001F680"},
+	"warning":          {Value: "\u26A0\uFE0F"},
+	"x":                {Value: "\u274C"},
+	"white_check_mark": {Value: "\u2705"},
+	"heavy_check_mark": {Value: "\u2714\uFE0F"},
+	"bug":              {Value: "\U0001F41B"},
+	"eyes":             {Value: "\U0001F440"},
+	"100":              {Value: "\U0001F4AF"},
+	"zap":              {Value: "\u26A1"},
+	"coffee":           {Value: "\u2615"},
+	"beer":             {Value: "\U0001F37A"},
+	"memo":             {Value: "\U0001F4DD"},
+	"bulb":             {Value: "\U0001F4A1"},
+	"lock":             {Value: "\U0001F512"},
+}
+
+// An EmojiConfig struct is a data structure that holds configuration of
+// the Emoji extension.
+type EmojiConfig struct {
+	// Emojis is a map of short names like 'smile' to emojis.
+	Emojis map[string]EmojiDefinition
+}
+
+// NewEmojiConfig returns a new EmojiConfig with defaults.
+// Defaults have common emojis like ':smile:', ':+1:' and ':tada:'.
+func NewEmojiConfig() EmojiConfig {
+	c := EmojiConfig{
+		Emojis: map[string]EmojiDefinition{},
+	}
+	for name, emoji := range defaultEmojis {
+		c.Emojis[name] = emoji
+	}
+	return c
+}
+
+// An EmojiOption interface sets options for the Emoji extension.
+type EmojiOption interface {
+	SetEmojiOption(*EmojiConfig)
+}
+
+type withEmojis struct {
+	value map[string]EmojiDefinition
+}
+
+func (o *withEmojis) SetEmojiOption(c *EmojiConfig) {
+	for name, emoji := range o.value {
+		c.Emojis[name] = emoji
+	}
+}
+
+// WithEmojis is a functional option that adds the given emojis like
+// custom emojis of chat platforms. Emojis that have the same names as
+// existing ones override them.
+func WithEmojis(emojis map[string]EmojiDefinition) EmojiOption {
+	return &withEmojis{emojis}
+}
+
+type emojiParser struct {
+	EmojiConfig
+}
+
+// NewEmojiParser returns a new parser.InlineParser that parses emojis like
+// ':smile:'. Skin tones can be applied like ':+1::skin-tone-3:' as in
+// Slack and ':+1_tone2:' as in Discord.
+func NewEmojiParser(opts ...EmojiOption) parser.InlineParser {
+	p := &emojiParser{
+		EmojiConfig: NewEmojiConfig(),
+	}
+	for _, o := range opts {
+		o.SetEmojiOption(&p.EmojiConfig)
+	}
+	return p
+}
+
+func (s *emojiParser) Trigger() []byte {
+	return []byte{':'}
+}
+
+// scanEmojiName returns a name and a length with colons of an emoji at
+// the beginning of the given bytes.
+func scanEmojiName(b []byte) ([]byte, int) {
+	if len(b) < 3 || b[0] != ':' {
+		return nil, 0
+	}
+	for i := 1; i < len(b); i++ {
+		c := b[i]
+		if c == ':' {
+			if i == 1 {
+				return nil, 0
+			}
+			return b[1:i], i + 1
+		}
+		if !util.IsAlphaNumeric(c) && c != '_' && c != '+' && c != '-' {
+			break
+		}
+	}
+	return nil, 0
+}
+
+var emojiToneSuffix = []byte("_tone")
+var emojiSkinTonePrefix = []byte("skin-tone-")
+
+// applySkinTone returns the given emoji with a skin tone modifier.
+// A tone is 1(light) to 5(dark).
+func applySkinTone(value string, tone int) string {
+	r, size := utf8.DecodeRuneInString(value)
+	// a modifier replaces the emoji presentation selector
+	rest := strings.TrimPrefix(value[size:], "\uFE0F")
+	return string(r) + string(rune(0x1F3FA+tone)) + rest
+}
+
+func (s *emojiParser) Parse(parent gast.Node, block text.Reader, pc parser.Context) gast.Node {
+	line, _ := block.PeekLine()
+	name, length := scanEmojiName(line)
+	if length == 0 {
+		return nil
+	}
+	tone := 0
+	emoji, ok := s.Emojis[string(name)]
+	if !ok {
+		i := len(name) - len(emojiToneSuffix) - 1
+		if i < 1 || !bytes.Equal(name[i:len(name)-1], emojiToneSuffix) {
+			return nil
+		}
+		c := name[len(name)-1]
+		if c < '1' || c > '5' {
+			return nil
+		}
+		emoji, ok = s.Emojis[string(name[:i])]
+		if !ok || !emoji.SkinTones {
+			return nil
+		}
+		tone = int(c - '0')
+	} else if emoji.SkinTones {
+		if modifier, l := scanEmojiName(line[length:]); l != 0 &&
+			len(modifier) == len(emojiSkinTonePrefix)+1 && bytes.HasPrefix(modifier, emojiSkinTonePrefix) {
+			// Slack uses 'skin-tone-2' for the lightest tone
+			if c := modifier[len(modifier)-1]; c >= '2' && c <= '6' {
+				tone = int(c - '1')
+				length += l
+			}
+		}
+	}
+	n := ast.NewEmoji(name)
+	if len(emoji.Image) != 0 {
+		n.Image = []byte(emoji.Image)
+	} else {
+		value := emoji.Value
+		if tone != 0 && len(value) != 0 {
+			value = applySkinTone(value, tone)
+		}
+		n.Value = []byte(value)
+	}
+	block.Advance(length)
+	return n
+}
+
+func (s *emojiParser) CloseBlock(parent gast.Node, pc parser.Context) {
+	// nothing to do
+}
+
+// EmojiHTMLRenderer is a renderer.NodeRenderer implementation that
+// renders Emoji nodes.
+type EmojiHTMLRenderer struct {
+	html.Config
+}
+
+// NewEmojiHTMLRenderer returns a new EmojiHTMLRenderer.
+func NewEmojiHTMLRenderer(opts ...html.Option) renderer.NodeRenderer {
+	r := &EmojiHTMLRenderer{
+		Config: html.NewConfig(),
+	}
+	for _, opt := range opts {
+		opt.SetHTMLOption(&r.Config)
+	}
+	return r
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *EmojiHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindEmoji, r.renderEmoji)
+}
+
+func (r *EmojiHTMLRenderer) renderEmoji(w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	if !entering {
+		return gast.WalkContinue, nil
+	}
+	n := node.(*ast.Emoji)
+	if len(n.Image) == 0 {
+		_, _ = w.Write(util.EscapeHTML(n.Value))
+		return gast.WalkContinue, nil
+	}
+	_, _ = w.WriteString(`<img class="emoji" src="`)
+	if r.Unsafe || !html.IsDangerousURL(n.Image) {
+		_, _ = w.Write(util.EscapeHTML(util.URLEscape(n.Image, true)))
+	}
+	_, _ = w.WriteString(`" alt=":`)
+	_, _ = w.Write(util.EscapeHTML(n.ShortName))
+	_, _ = w.WriteString(`:"`)
+	if n.Attributes() != nil {
+		r.RenderAttributes(w, n)
+	}
+	if r.XHTML {
+		_, _ = w.WriteString(" />")
+	} else {
+		_ = w.WriteByte('>')
+	}
+	return gast.WalkContinue, nil
+}
+
+type emoji struct {
+	options []EmojiOption
+}
+
+// Emoji is an extension that replaces emoji short names like ':smile:'
+// with emojis.
+var Emoji = &emoji{}
+
+// NewEmoji returns a new Extender that replaces emoji short names with
+// emojis with the given options.
+func NewEmoji(opts ...EmojiOption) goldmark.Extender {
+	return &emoji{
+		options: opts,
+	}
+}
+
+func (e *emoji) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(parser.WithInlineParsers(
+		util.Prioritized(NewEmojiParser(e.options...), 500),
+	))
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(NewEmojiHTMLRenderer(), 500),
+	))
+}
@@ -0,0 +1,33 @@
+package extension
+
+import (
+	"testing"
+
+	"github.com/yuin/goldmark"
+)
+
+func TestEmoji(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			Emoji,
+		),
+	)
+	goldmark.DoTestCaseFile(markdown, "_test/emoji.txt", t)
+}
+
+func TestEmojiCustom(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			NewEmoji(WithEmojis(map[string]EmojiDefinition{
+				"party-parrot": {Image: "https://example.com/emoji/parrot.gif"},
+				"smile":        {Value: ":-)"},
+				"salute":       {Value: "\U0001FAE1", SkinTones: true},
+			})),
+		),
+	)
+	goldmark.DoTestCases(markdown, []goldmark.MarkdownTestCase{{
+		No:       1,
+		Markdown: ":party-parrot: :smile: :salute_tone3: :tada:",
+		Expected: `<p><img class="emoji" src="https://example.com/emoji/parrot.gif" alt=":party-parrot:"> :-) 🫡🏽 🎉</p>`,
+	}}, t)
+}
@@ -0,0 +1,290 @@
+package extension
+
+import (
+	"bytes"
+	"strconv"
+
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// A FigureConfig struct is a data structure that holds configuration of the
+// Figure extension.
+type FigureConfig struct {
+	// Numbering is true if figures are numbered.
+	Numbering bool
+
+	// Label is a label of figure numbers like 'Figure'.
+	Label []byte
+
+	// ImageCaption is true if an image that is the sole content of
+	// a paragraph is rendered as a figure captioned by its title or alt text.
+	ImageCaption bool
+}
+
+// NewFigureConfig returns a new FigureConfig with defaults.
+func NewFigureConfig() FigureConfig {
+	return FigureConfig{
+		Label: []byte("Figure"),
+	}
+}
+
+// A FigureOption interface sets options for the Figure extension.
+type FigureOption interface {
+	SetFigureOption(*FigureConfig)
+}
+
+type withFigureNumbering struct {
+	label []byte
+}
+
+func (o *withFigureNumbering) SetFigureOption(c *FigureConfig) {
+	c.Numbering = true
+	if len(o.label) != 0 {
+		c.Label = o.label
+	}
+}
+
+// WithFigureNumbering is a functional option that numbers figures.
+// Numbered figures are given ids like 'figure-1' so that they can be
+// cross-referenced, and their captions are prefixed with the given label
+// and a number like 'Figure 1:'. An empty label means the default label.
+func WithFigureNumbering(label string) FigureOption {
+	return &withFigureNumbering{[]byte(label)}
+}
+
+type withFigureImageCaption struct {
+}
+
+func (o *withFigureImageCaption) SetFigureOption(c *FigureConfig) {
+	c.ImageCaption = true
+}
+
+// WithFigureImageCaption is a functional option that renders an image that
+// is the sole content of a paragraph as a figure. A caption of the figure is
+// the title of the image, or the alt text if the image does not have a title.
+func WithFigureImageCaption() FigureOption {
+	return &withFigureImageCaption{}
+}
+
+var figureCaptionPrefix = []byte("Figure:")
+
+type figureASTTransformer struct {
+	FigureConfig
+}
+
+// NewFigureASTTransformer returns a new parser.ASTTransformer that
+// converts a paragraph that contains only an image and caption text into
+// a Figure node. A caption can be written in lines following the image or
+// in a following paragraph starts with 'Figure:'. The 'Figure:' prefix is
+// removed from captions in both forms.
+func NewFigureASTTransformer(opts ...FigureOption) parser.ASTTransformer {
+	a := &figureASTTransformer{
+		FigureConfig: NewFigureConfig(),
+	}
+	for _, o := range opts {
+		o.SetFigureOption(&a.FigureConfig)
+	}
+	return a
+}
+
+func (a *figureASTTransformer) Transform(node *gast.Document, reader text.Reader, pc parser.Context) {
+	source := reader.Source()
+	var paragraphs []gast.Node
+	_ = gast.Walk(node, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+		if entering && n.Kind() == gast.KindParagraph {
+			paragraphs = append(paragraphs, n)
+			return gast.WalkSkipChildren, nil
+		}
+		return gast.WalkContinue, nil
+	})
+	number := 0
+	for _, paragraph := range paragraphs {
+		if paragraph.Parent() == nil {
+			// already used as a caption
+			continue
+		}
+		figure := a.transformParagraph(paragraph, source)
+		if figure == nil {
+			continue
+		}
+		if a.Numbering {
+			number++
+			figure.Number = number
+			if _, ok := figure.AttributeString("id"); !ok {
+				figure.SetAttribute(attrNameListOfFiguresID, []byte("figure-"+strconv.Itoa(number)))
+			}
+		}
+	}
+}
+
+func (a *figureASTTransformer) transformParagraph(paragraph gast.Node, source []byte) *ast.Figure {
+	image, ok := paragraph.FirstChild().(*gast.Image)
+	if !ok {
+		return nil
+	}
+	caption := ast.NewFigureCaption()
+	next := image.NextSibling()
+	if next == nil {
+		captionParagraph := paragraph.NextSibling()
+		if !isFigureCaptionParagraph(captionParagraph, source) {
+			if !a.ImageCaption {
+				return nil
+			}
+			value := image.Title
+			if len(value) == 0 {
+				value = image.Text(source)
+			}
+			if len(value) != 0 {
+				caption.AppendChild(caption, gast.NewString(value))
+			}
+			return newFigure(paragraph, image, caption)
+		}
+		t := captionParagraph.FirstChild().(*gast.Text)
+		trimFigureCaptionPrefix(t, source)
+		moveChildren(caption, t)
+		caption.SetLines(captionParagraph.Lines())
+		captionParagraph.Parent().RemoveChild(captionParagraph.Parent(), captionParagraph)
+	} else {
+		t, ok := next.(*gast.Text)
+		if !ok || !t.SoftLineBreak() || !util.IsBlank(t.Segment.Value(source)) || t.NextSibling() == nil {
+			return nil
+		}
+		if first, ok := t.NextSibling().(*gast.Text); ok {
+			trimFigureCaptionPrefix(first, source)
+		}
+		moveChildren(caption, t.NextSibling())
+		paragraph.RemoveChild(paragraph, t)
+	}
+	return newFigure(paragraph, image, caption)
+}
+
+// newFigure replaces the given paragraph with a figure that contains the
+// given image and caption. The caption is omitted if it is empty.
+func newFigure(paragraph gast.Node, image *gast.Image, caption *ast.FigureCaption) *ast.Figure {
+	figure := ast.NewFigure()
+	figure.SetLines(paragraph.Lines())
+	block := gast.NewTextBlock()
+	block.AppendChild(block, image)
+	figure.AppendChild(figure, block)
+	if caption.HasChildren() {
+		figure.AppendChild(figure, caption)
+	}
+	paragraph.Parent().ReplaceChild(paragraph.Parent(), paragraph, figure)
+	return figure
+}
+
+func isFigureCaptionParagraph(n gast.Node, source []byte) bool {
+	if n == nil || n.Kind() != gast.KindParagraph {
+		return false
+	}
+	t, ok := n.FirstChild().(*gast.Text)
+	return ok && bytes.HasPrefix(t.Segment.Value(source), figureCaptionPrefix)
+}
+
+// trimFigureCaptionPrefix removes a 'Figure:' prefix from the given text
+// that starts a caption.
+func trimFigureCaptionPrefix(t *gast.Text, source []byte) {
+	if !bytes.HasPrefix(t.Segment.Value(source), figureCaptionPrefix) {
+		return
+	}
+	t.Segment = t.Segment.WithStart(t.Segment.Start + len(figureCaptionPrefix))
+	t.Segment = t.Segment.TrimLeftSpace(source)
+}
+
+// moveChildren moves the given node and its following siblings into the given parent.
+func moveChildren(parent gast.Node, from gast.Node) {
+	for c := from; c != nil; {
+		next := c.NextSibling()
+		parent.AppendChild(parent, c)
+		c = next
+	}
+}
+
+// FigureHTMLRenderer is a renderer.NodeRenderer implementation that
+// renders Figure nodes.
+type FigureHTMLRenderer struct {
+	html.Config
+	FigureConfig
+}
+
+// NewFigureHTMLRenderer returns a new FigureHTMLRenderer.
+func NewFigureHTMLRenderer(opts ...FigureOption) renderer.NodeRenderer {
+	r := &FigureHTMLRenderer{
+		Config:       html.NewConfig(),
+		FigureConfig: NewFigureConfig(),
+	}
+	for _, opt := range opts {
+		opt.SetFigureOption(&r.FigureConfig)
+	}
+	return r
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *FigureHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindFigure, r.renderFigure)
+	reg.Register(ast.KindFigureCaption, r.renderFigureCaption)
+}
+
+func (r *FigureHTMLRenderer) renderFigure(w util.BufWriter, source []byte, n gast.Node, entering bool) (gast.WalkStatus, error) {
+	if entering {
+		_, _ = w.WriteString("<figure")
+		if n.Attributes() != nil || r.SourcePos {
+			r.RenderAttributes(w, n)
+		}
+		_, _ = w.WriteString(">\n")
+	} else {
+		if last := n.LastChild(); last != nil && last.Kind() == gast.KindTextBlock {
+			// figures without captions
+			_ = w.WriteByte('\n')
+		}
+		_, _ = w.WriteString("</figure>\n")
+	}
+	return gast.WalkContinue, nil
+}
+
+func (r *FigureHTMLRenderer) renderFigureCaption(w util.BufWriter, source []byte, n gast.Node, entering bool) (gast.WalkStatus, error) {
+	if entering {
+		_, _ = w.WriteString("<figcaption>")
+		if figure, ok := n.Parent().(*ast.Figure); ok && figure.Number != 0 {
+			_, _ = w.WriteString(`<span class="figure-number">`)
+			_, _ = w.Write(util.EscapeHTML(r.Label))
+			_ = w.WriteByte(' ')
+			_, _ = w.WriteString(strconv.Itoa(figure.Number))
+			_, _ = w.WriteString(":</span> ")
+		}
+	} else {
+		_, _ = w.WriteString("</figcaption>\n")
+	}
+	return gast.WalkContinue, nil
+}
+
+type figure struct {
+	options []FigureOption
+}
+
+// Figure is an extension that renders captioned images as figures.
+var Figure = &figure{}
+
+// NewFigure returns a new Extender that renders captioned images as figures
+// with the given options.
+func NewFigure(opts ...FigureOption) goldmark.Extender {
+	return &figure{
+		options: opts,
+	}
+}
+
+func (e *figure) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(parser.WithASTTransformers(
+		util.Prioritized(NewFigureASTTransformer(e.options...), 500),
+	))
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(NewFigureHTMLRenderer(e.options...), 500),
+	))
+}
@@ -0,0 +1,104 @@
+package extension
+
+import (
+	"testing"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/renderer/html"
+)
+
+func TestFigure(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithRendererOptions(
+			html.WithXHTML(),
+		),
+		goldmark.WithExtensions(
+			Figure,
+		),
+	)
+	goldmark.DoTestCaseFile(markdown, "_test/figure.txt", t)
+}
+
+func TestFigureNumbering(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithRendererOptions(
+			html.WithXHTML(),
+		),
+		goldmark.WithExtensions(
+			NewFigure(WithFigureNumbering("Fig.")),
+			ListOfFigures,
+		),
+	)
+	goldmark.DoTestCases(markdown, []goldmark.MarkdownTestCase{{
+		No: 1,
+		Markdown: `[LOF]
+
+![A](a.png)
+First
+
+![B](b.png)
+
+Figure: Second
+
+![C](c.png)
+Figure: Third`,
+		Expected: `<ul class="list-of-figures">
+<li><a href="#figure-1">Figure 1: First</a></li>
+<li><a href="#figure-2">Figure 2: Second</a></li>
+<li><a href="#figure-3">Figure 3: Third</a></li>
+</ul>
+<figure id="figure-1">
+<img src="a.png" alt="A" />
+<figcaption><span class="figure-number">Fig. 1:</span> First</figcaption>
+</figure>
+<figure id="figure-2">
+<img src="b.png" alt="B" />
+<figcaption><span class="figure-number">Fig. 2:</span> Second</figcaption>
+</figure>
+<figure id="figure-3">
+<img src="c.png" alt="C" />
+<figcaption><span class="figure-number">Fig. 3:</span> Third</figcaption>
+</figure>`,
+	}}, t)
+}
+
+func TestFigureImageCaption(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithRendererOptions(
+			html.WithXHTML(),
+		),
+		goldmark.WithExtensions(
+			NewFigure(WithFigureImageCaption()),
+		),
+	)
+	goldmark.DoTestCases(markdown, []goldmark.MarkdownTestCase{{
+		No: 1,
+		Markdown: `![A *cat* and a dog](cat.png)
+
+![Alt](title.png "A <title>")
+
+![](empty.png)
+
+![Explicit](explicit.png)
+
+Figure: An explicit caption
+
+![Inline](inline.png) is not a figure.`,
+		Expected: `<figure>
+<img src="cat.png" alt="A cat and a dog" />
+<figcaption>A cat and a dog</figcaption>
+</figure>
+<figure>
+<img src="title.png" alt="Alt" title="A &lt;title&gt;" />
+<figcaption>A &lt;title&gt;</figcaption>
+</figure>
+<figure>
+<img src="empty.png" alt="" />
+</figure>
+<figure>
+<img src="explicit.png" alt="Explicit" />
+<figcaption>An explicit caption</figcaption>
+</figure>
+<p><img src="inline.png" alt="Inline" /> is not a figure.</p>`,
+	}}, t)
+}
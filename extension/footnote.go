@@ -13,8 +13,118 @@ import (
 	"strconv"
 )
 
+// A FootnoteConfig struct is a data structure that holds configuration of
+// the Footnote extension.
+type FootnoteConfig struct {
+	// IDPrefix is a prefix of IDs of footnotes and their references like
+	// 'doc1-'.
+	IDPrefix []byte
+
+	// SectionLevel is a level of headings that start sections. Footnotes
+	// are rendered at the end of sections that refer to them if
+	// SectionLevel is greater than 0, otherwise at the end of the document.
+	SectionLevel int
+
+	// ListClass is a class of footnote lists. An empty value means
+	// 'footnotes'.
+	ListClass []byte
+
+	// LinkClass is a class of links to footnotes. An empty value means
+	// 'footnote-ref'.
+	LinkClass []byte
+
+	// BacklinkClass is a class of links back to references. An empty value
+	// means 'footnote-backref'.
+	BacklinkClass []byte
+
+	// ListTag is a tag name of elements that wrap footnote lists. An empty
+	// value means 'section', or 'div' if XHTML is enabled.
+	ListTag []byte
+}
+
+// A FootnoteOption interface sets options for the Footnote extension.
+type FootnoteOption interface {
+	SetFootnoteOption(*FootnoteConfig)
+}
+
+type withFootnoteIDPrefix struct {
+	value []byte
+}
+
+func (o *withFootnoteIDPrefix) SetFootnoteOption(c *FootnoteConfig) {
+	c.IDPrefix = o.value
+}
+
+// WithFootnoteIDPrefix is a functional option that sets a prefix of IDs of
+// footnotes and their references like 'doc1-', so IDs do not collide when
+// multiple documents are embedded in one HTML page.
+func WithFootnoteIDPrefix(prefix string) FootnoteOption {
+	return &withFootnoteIDPrefix{[]byte(prefix)}
+}
+
+type withFootnoteSectionLevel struct {
+	value int
+}
+
+func (o *withFootnoteSectionLevel) SetFootnoteOption(c *FootnoteConfig) {
+	c.SectionLevel = o.value
+}
+
+// WithFootnoteSectionLevel is a functional option that renders footnotes
+// at the end of sections that refer to them instead of the end of the
+// document. Sections are started by headings of the given level or higher
+// like '## Section' for 2.
+func WithFootnoteSectionLevel(level int) FootnoteOption {
+	return &withFootnoteSectionLevel{level}
+}
+
+type withFootnoteClasses struct {
+	list     []byte
+	link     []byte
+	backlink []byte
+}
+
+func (o *withFootnoteClasses) SetFootnoteOption(c *FootnoteConfig) {
+	c.ListClass = o.list
+	c.LinkClass = o.link
+	c.BacklinkClass = o.backlink
+}
+
+// WithFootnoteClasses is a functional option that sets classes of footnote
+// lists, links to footnotes and links back to references. Empty values mean
+// default classes.
+func WithFootnoteClasses(list, link, backlink string) FootnoteOption {
+	return &withFootnoteClasses{[]byte(list), []byte(link), []byte(backlink)}
+}
+
+type withFootnoteListTag struct {
+	value []byte
+}
+
+func (o *withFootnoteListTag) SetFootnoteOption(c *FootnoteConfig) {
+	c.ListTag = o.value
+}
+
+// WithFootnoteListTag is a functional option that sets a tag name of
+// elements that wrap footnote lists like 'aside'.
+func WithFootnoteListTag(tag string) FootnoteOption {
+	return &withFootnoteListTag{[]byte(tag)}
+}
+
+// footnoteIDPrefixOption is a document option key used in
+// WithFootnoteDocumentIDPrefix.
+var footnoteIDPrefixOption = gast.NewDocumentOptionKey()
+
+// WithFootnoteDocumentIDPrefix is a functional option for Parse and Convert
+// that overrides the WithFootnoteIDPrefix option for the parsed document.
+func WithFootnoteDocumentIDPrefix(prefix string) parser.ParseOption {
+	return parser.WithDocumentOption(footnoteIDPrefixOption, prefix)
+}
+
 var footnoteListKey = parser.NewContextKey()
 
+var footnoteMarkersKey = parser.NewContextKey()
+
 type footnoteBlockParser struct {
 }
 
@@ -22,6 +132,8 @@ var defaultFootnoteBlockParser = &footnoteBlockParser{}
 
 // NewFootnoteBlockParser returns a new parser.BlockParser that can parse
 // footnotes of the Markdown(PHP Markdown Extra) text.
+// A line that consists of '[^]:' is a marker where footnotes referred
+// before it are rendered.
 func NewFootnoteBlockParser() parser.BlockParser {
 	return defaultFootnoteBlockParser
 }
@@ -49,6 +161,11 @@ func (b *footnoteBlockParser) Open(parent gast.Node, reader text.Reader, pc pars
 		return nil, parser.NoChildren
 	}
 	label := reader.Value(text.NewSegment(segment.Start+open, segment.Start+closes))
+	if closes == open && util.IsBlank(line[closes+2:]) {
+		pc.Set(footnoteMarkersKey, true)
+		reader.Advance(segment.Len() - 1)
+		return ast.NewFootnoteList(), parser.NoChildren
+	}
 	if util.IsBlank(label) {
 		return nil, parser.NoChildren
 	}
@@ -60,6 +177,9 @@ func (b *footnoteBlockParser) Open(parent gast.Node, reader text.Reader, pc pars
 }
 
 func (b *footnoteBlockParser) Continue(node gast.Node, reader text.Reader, pc parser.Context) parser.State {
+	if _, ok := node.(*ast.Footnote); !ok {
+		return parser.Close
+	}
 	line, _ := reader.PeekLine()
 	if util.IsBlank(line) {
 		return parser.Continue | parser.HasChildren
@@ -73,18 +193,18 @@ func (b *footnoteBlockParser) Continue(node gast.Node, reader text.Reader, pc pa
 }
 
 func (b *footnoteBlockParser) Close(node gast.Node, reader text.Reader, pc parser.Context) {
-	var list *ast.FootnoteList
-	if tlist := pc.Get(footnoteListKey); tlist != nil {
-		list = tlist.(*ast.FootnoteList)
-	} else {
-		list = ast.NewFootnoteList()
-		pc.Set(footnoteListKey, list)
+	if _, ok := node.(*ast.Footnote); !ok {
+		return
+	}
+	list := parser.ContextState(pc, footnoteListKey, func() interface{} {
+		list := ast.NewFootnoteList()
 		var root gast.Node
 		for n := node; n != nil; n = n.Parent() {
 			root = n
 		}
 		root.AppendChild(root, list)
-	}
+		return list
+	}).(*ast.FootnoteList)
 	node.Parent().RemoveChild(node.Parent(), node)
 	n := node.(*ast.Footnote)
 	index := list.ChildCount() + 1
@@ -157,14 +277,24 @@ func (s *footnoteParser) Parse(parent gast.Node, block text.Reader, pc parser.Co
 }
 
 type footnoteASTTransformer struct {
+	FootnoteConfig
 }
 
 var defaultFootnoteASTTransformer = &footnoteASTTransformer{}
 
 // NewFootnoteASTTransformer returns a new parser.ASTTransformer that
 // insert a footnote list to the last of the document.
-func NewFootnoteASTTransformer() parser.ASTTransformer {
-	return defaultFootnoteASTTransformer
+// Footnotes are moved to markers like '[^]:' and the end of sections if
+// WithFootnoteSectionLevel is set.
+func NewFootnoteASTTransformer(opts ...FootnoteOption) parser.ASTTransformer {
+	if len(opts) == 0 {
+		return defaultFootnoteASTTransformer
+	}
+	a := &footnoteASTTransformer{}
+	for _, o := range opts {
+		o.SetFootnoteOption(&a.FootnoteConfig)
+	}
+	return a
 }
 
 func (a *footnoteASTTransformer) Transform(node *gast.Document, reader text.Reader, pc parser.Context) {
@@ -172,30 +302,147 @@ func (a *footnoteASTTransformer) Transform(node *gast.Document, reader text.Read
 	if tlist := pc.Get(footnoteListKey); tlist != nil {
 		list = tlist.(*ast.FootnoteList)
 		list.Parent().RemoveChild(list.Parent(), list)
-	} else {
-		return
 	}
 	pc.Set(footnoteListKey, nil)
-	node.AppendChild(node, list)
+	hasMarkers := pc.Get(footnoteMarkersKey) != nil
+	if list == nil {
+		if hasMarkers {
+			for _, marker := range footnoteLists(node) {
+				marker.Parent().RemoveChild(marker.Parent(), marker)
+			}
+		}
+		return
+	}
+	if a.SectionLevel > 0 {
+		for c := node.FirstChild(); c != nil; c = c.NextSibling() {
+			if h, ok := c.(*gast.Heading); ok && h.Level <= a.SectionLevel && c.PreviousSibling() != nil {
+				node.InsertBefore(node, c, ast.NewFootnoteList())
+			}
+		}
+	} else if !hasMarkers {
+		node.AppendChild(node, list)
+		return
+	}
+	placeFootnotes(node, list)
+}
+
+// footnoteLists returns footnote lists in the given node.
+func footnoteLists(node gast.Node) []*ast.FootnoteList {
+	var lists []*ast.FootnoteList
+	_ = gast.Walk(node, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+		if list, ok := n.(*ast.FootnoteList); ok && entering {
+			lists = append(lists, list)
+			return gast.WalkSkipChildren, nil
+		}
+		return gast.WalkContinue, nil
+	})
+	return lists
+}
+
+// placeFootnotes moves footnotes in the given list to the first empty
+// footnote list after their first references, and renumbers footnotes in
+// order of appearance. Footnotes that are not referred before any lists are
+// placed at the end of the document.
+func placeFootnotes(node *gast.Document, list *ast.FootnoteList) {
+	footnotes := map[int]*ast.Footnote{}
+	for c := list.FirstChild(); c != nil; c = c.NextSibling() {
+		fn := c.(*ast.Footnote)
+		footnotes[fn.Index] = fn
+	}
+	var pending []*ast.Footnote
+	_ = gast.Walk(node, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+		if !entering {
+			return gast.WalkContinue, nil
+		}
+		switch v := n.(type) {
+		case *ast.FootnoteLink:
+			if fn, ok := footnotes[v.Index]; ok {
+				delete(footnotes, v.Index)
+				pending = append(pending, fn)
+			}
+		case *ast.FootnoteList:
+			for _, fn := range pending {
+				list.RemoveChild(list, fn)
+				v.AppendChild(v, fn)
+			}
+			pending = pending[:0]
+			return gast.WalkSkipChildren, nil
+		}
+		return gast.WalkContinue, nil
+	})
+	if list.HasChildren() {
+		node.AppendChild(node, list)
+	}
+
+	indexes := map[int]int{}
+	for _, l := range footnoteLists(node) {
+		if !l.HasChildren() {
+			l.Parent().RemoveChild(l.Parent(), l)
+			continue
+		}
+		for c := l.FirstChild(); c != nil; c = c.NextSibling() {
+			fn := c.(*ast.Footnote)
+			indexes[fn.Index] = len(indexes) + 1
+			fn.Index = len(indexes)
+		}
+	}
+	_ = gast.Walk(node, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+		if link, ok := n.(*ast.FootnoteLink); ok && entering {
+			link.Index = indexes[link.Index]
+		}
+		return gast.WalkContinue, nil
+	})
 }
 
 // FootnoteHTMLRenderer is a renderer.NodeRenderer implementation that
 // renders FootnoteLink nodes.
 type FootnoteHTMLRenderer struct {
 	html.Config
+	FootnoteConfig
 }
 
 // NewFootnoteHTMLRenderer returns a new FootnoteHTMLRenderer.
 func NewFootnoteHTMLRenderer(opts ...html.Option) renderer.NodeRenderer {
+	return NewFootnoteHTMLRendererWithOptions(nil, opts...)
+}
+
+// NewFootnoteHTMLRendererWithOptions returns a new FootnoteHTMLRenderer
+// with the given FootnoteOptions and html.Options.
+func NewFootnoteHTMLRendererWithOptions(footnoteOpts []FootnoteOption, opts ...html.Option) renderer.NodeRenderer {
 	r := &FootnoteHTMLRenderer{
 		Config: html.NewConfig(),
 	}
+	for _, opt := range footnoteOpts {
+		opt.SetFootnoteOption(&r.FootnoteConfig)
+	}
 	for _, opt := range opts {
 		opt.SetHTMLOption(&r.Config)
 	}
 	return r
 }
 
+// idPrefix returns a prefix of IDs in the document that contains the given
+// node.
+func (r *FootnoteHTMLRenderer) idPrefix(n gast.Node) []byte {
+	for ; n.Parent() != nil; n = n.Parent() {
+	}
+	if doc, ok := n.(*gast.Document); ok {
+		if v, ok := doc.Option(footnoteIDPrefixOption).(string); ok {
+			return util.EscapeHTML([]byte(v))
+		}
+	}
+	return util.EscapeHTML(r.IDPrefix)
+}
+
+// footnoteClass returns the given class escaped, or the default class if the
+// given class is empty.
+func footnoteClass(class []byte, defaultClass string) []byte {
+	if len(class) == 0 {
+		return []byte(defaultClass)
+	}
+	return util.EscapeHTML(class)
+}
+
 // RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
 func (r *FootnoteHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
 	reg.Register(ast.KindFootnoteLink, r.renderFootnoteLink)
@@ -207,11 +454,18 @@ func (r *FootnoteHTMLRenderer) renderFootnoteLink(w util.BufWriter, source []byt
 	if entering {
 		n := node.(*ast.FootnoteLink)
 		is := strconv.Itoa(n.Index)
-		w.WriteString(`<sup id="fnref:`)
+		prefix := r.idPrefix(n)
+		w.WriteString(`<sup id="`)
+		w.Write(prefix)
+		w.WriteString(`fnref:`)
 		w.WriteString(is)
-		w.WriteString(`"><a href="#fn:`)
+		w.WriteString(`"><a href="#`)
+		w.Write(prefix)
+		w.WriteString(`fn:`)
 		w.WriteString(is)
-		w.WriteString(`" class="footnote-ref" role="doc-noteref">`)
+		w.WriteString(`" class="`)
+		w.Write(footnoteClass(r.LinkClass, "footnote-ref"))
+		w.WriteString(`" role="doc-noteref">`)
 		w.WriteString(is)
 		w.WriteString(`</a></sup>`)
 	}
@@ -221,47 +475,91 @@ func (r *FootnoteHTMLRenderer) renderFootnoteLink(w util.BufWriter, source []byt
 func (r *FootnoteHTMLRenderer) renderFootnote(w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
 	n := node.(*ast.Footnote)
 	is := strconv.Itoa(n.Index)
+	prefix := r.idPrefix(n)
 	if entering {
-		w.WriteString(`<li id="fn:`)
+		w.WriteString(`<li id="`)
+		w.Write(prefix)
+		w.WriteString(`fn:`)
 		w.WriteString(is)
-		w.WriteString(`" role="doc-endnote">`)
+		// doc-endnote is deprecated in DPUB-ARIA 1.1, but kept for
+		// compatibility unless accessibility attributes are requested.
+		if r.Config.Accessibility {
+			w.WriteString(`" role="doc-footnote">`)
+		} else {
+			w.WriteString(`" role="doc-endnote">`)
+		}
 		w.WriteString("\n")
 	} else {
+		if r.Config.Accessibility {
+			w.WriteString(`<a href="#`)
+			w.Write(prefix)
+			w.WriteString(`fnref:`)
+			w.WriteString(is)
+			w.WriteString(`" class="`)
+			w.Write(footnoteClass(r.BacklinkClass, "footnote-backref"))
+			w.WriteString(`" role="doc-backlink" aria-label="Back to reference `)
+			w.WriteString(is)
+			w.WriteString(`">&#x21a9;&#xfe0e;</a>`)
+			w.WriteString("\n")
+		}
 		w.WriteString("</li>\n")
 	}
 	return gast.WalkContinue, nil
 }
 
 func (r *FootnoteHTMLRenderer) renderFootnoteList(w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
-	tag := "section"
-	if r.Config.XHTML {
-		tag = "div"
+	tag := []byte("section")
+	if len(r.ListTag) != 0 {
+		tag = r.ListTag
+	} else if r.Config.XHTML {
+		tag = []byte("div")
 	}
 	if entering {
 		w.WriteString("<")
-		w.WriteString(tag)
-		w.WriteString(` class="footnotes" role="doc-endnotes">`)
+		w.Write(tag)
+		w.WriteString(` class="`)
+		w.Write(footnoteClass(r.ListClass, "footnotes"))
+		w.WriteString(`" role="doc-endnotes"`)
+		if r.Config.Accessibility {
+			w.WriteString(` aria-label="Footnotes"`)
+		}
+		w.WriteString(">")
 		if r.Config.XHTML {
 			w.WriteString("\n<hr />\n")
 		} else {
 			w.WriteString("\n<hr>\n")
 		}
-		w.WriteString("<ol>\n")
+		w.WriteString("<ol")
+		if fn, ok := node.FirstChild().(*ast.Footnote); ok && fn.Index != 1 {
+			w.WriteString(` start="`)
+			w.WriteString(strconv.Itoa(fn.Index))
+			w.WriteString(`"`)
+		}
+		w.WriteString(">\n")
 	} else {
 		w.WriteString("</ol>\n")
-		w.WriteString("<")
-		w.WriteString(tag)
+		w.WriteString("</")
+		w.Write(tag)
 		w.WriteString(">\n")
 	}
 	return gast.WalkContinue, nil
 }
 
 type footnote struct {
+	options []FootnoteOption
 }
 
 // Footnote is an extension that allow you to use PHP Markdown Extra Footnotes.
 var Footnote = &footnote{}
 
+// NewFootnote returns a new Extender that allow you to use PHP Markdown
+// Extra Footnotes with the given options.
+func NewFootnote(opts ...FootnoteOption) goldmark.Extender {
+	return &footnote{
+		options: opts,
+	}
+}
+
 func (e *footnote) Extend(m goldmark.Markdown) {
 	m.Parser().AddOptions(
 		parser.WithBlockParsers(
@@ -271,10 +569,10 @@ func (e *footnote) Extend(m goldmark.Markdown) {
 			util.Prioritized(NewFootnoteParser(), 101),
 		),
 		parser.WithASTTransformers(
-			util.Prioritized(NewFootnoteASTTransformer(), 999),
+			util.Prioritized(NewFootnoteASTTransformer(e.options...), 999),
 		),
 	)
 	m.Renderer().AddOptions(renderer.WithNodeRenderers(
-		util.Prioritized(NewFootnoteHTMLRenderer(), 500),
+		util.Prioritized(NewFootnoteHTMLRendererWithOptions(e.options), 500),
 	))
 }
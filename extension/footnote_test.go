@@ -1,9 +1,14 @@
 package extension
 
 import (
+	"bytes"
+	"fmt"
+	"testing"
+
 	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/renderer"
 	"github.com/yuin/goldmark/renderer/html"
-	"testing"
+	"github.com/yuin/goldmark/util"
 )
 
 func TestFootnote(t *testing.T) {
@@ -17,3 +22,245 @@ func TestFootnote(t *testing.T) {
 	)
 	goldmark.DoTestCaseFile(markdown, "_test/footnote.txt", t)
 }
+
+func TestFootnoteAccessibility(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithRendererOptions(
+			html.WithAccessibility(),
+		),
+		goldmark.WithExtensions(
+			Footnote,
+		),
+	)
+	goldmark.DoTestCases(markdown, []goldmark.MarkdownTestCase{
+		{
+			No: 1,
+			Markdown: `Text[^a].
+
+[^a]: Note.`,
+			Expected: `<p>Text<sup id="fnref:1"><a href="#fn:1" class="footnote-ref" role="doc-noteref">1</a></sup>.</p>
+<section class="footnotes" role="doc-endnotes" aria-label="Footnotes">
+<hr>
+<ol>
+<li id="fn:1" role="doc-footnote">
+<p>Note.</p>
+<a href="#fnref:1" class="footnote-backref" role="doc-backlink" aria-label="Back to reference 1">&#x21a9;&#xfe0e;</a>
+</li>
+</ol>
+</section>`,
+		},
+	}, t)
+}
+
+func TestFootnoteIDPrefix(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithRendererOptions(
+			html.WithAccessibility(),
+		),
+		goldmark.WithExtensions(
+			NewFootnote(WithFootnoteIDPrefix("doc1-")),
+		),
+	)
+	source := []byte(`Text[^a].
+
+[^a]: Note.`)
+	expected := `<p>Text<sup id="%[1]sfnref:1"><a href="#%[1]sfn:1" class="footnote-ref" role="doc-noteref">1</a></sup>.</p>
+<section class="footnotes" role="doc-endnotes" aria-label="Footnotes">
+<hr>
+<ol>
+<li id="%[1]sfn:1" role="doc-footnote">
+<p>Note.</p>
+<a href="#%[1]sfnref:1" class="footnote-backref" role="doc-backlink" aria-label="Back to reference 1">&#x21a9;&#xfe0e;</a>
+</li>
+</ol>
+</section>
+`
+	var buf bytes.Buffer
+	if err := markdown.Convert(source, &buf); err != nil {
+		t.Fatal(err)
+	}
+	if v := fmt.Sprintf(expected, "doc1-"); buf.String() != v {
+		t.Errorf("expected:\n%s\nactual:\n%s", v, buf.String())
+	}
+
+	buf.Reset()
+	if err := markdown.Convert(source, &buf, WithFootnoteDocumentIDPrefix("doc2-")); err != nil {
+		t.Fatal(err)
+	}
+	if v := fmt.Sprintf(expected, "doc2-"); buf.String() != v {
+		t.Errorf("expected:\n%s\nactual:\n%s", v, buf.String())
+	}
+}
+
+func TestFootnotePlacement(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			Footnote,
+		),
+	)
+	goldmark.DoTestCases(markdown, []goldmark.MarkdownTestCase{
+		{
+			No: 1,
+			Markdown: `a[^x] b[^y]
+
+[^]:
+
+c[^z]
+
+[^x]: X
+[^y]: Y
+[^z]: Z
+[^w]: W`,
+			Expected: `<p>a<sup id="fnref:1"><a href="#fn:1" class="footnote-ref" role="doc-noteref">1</a></sup> b<sup id="fnref:2"><a href="#fn:2" class="footnote-ref" role="doc-noteref">2</a></sup></p>
+<section class="footnotes" role="doc-endnotes">
+<hr>
+<ol>
+<li id="fn:1" role="doc-endnote">
+<p>X</p>
+</li>
+<li id="fn:2" role="doc-endnote">
+<p>Y</p>
+</li>
+</ol>
+</section>
+<p>c<sup id="fnref:3"><a href="#fn:3" class="footnote-ref" role="doc-noteref">3</a></sup></p>
+<section class="footnotes" role="doc-endnotes">
+<hr>
+<ol start="3">
+<li id="fn:3" role="doc-endnote">
+<p>Z</p>
+</li>
+<li id="fn:4" role="doc-endnote">
+<p>W</p>
+</li>
+</ol>
+</section>`,
+		},
+		{
+			No: 2,
+			Markdown: `text
+
+[^]:`,
+			Expected: `<p>text</p>`,
+		},
+	}, t)
+
+	markdown = goldmark.New(
+		goldmark.WithExtensions(
+			NewFootnote(WithFootnoteSectionLevel(2)),
+		),
+	)
+	goldmark.DoTestCases(markdown, []goldmark.MarkdownTestCase{
+		{
+			No: 3,
+			Markdown: `## One
+
+a[^b]
+
+### Sub
+
+## Two
+
+c[^a]
+
+[^a]: A
+[^b]: B`,
+			Expected: `<h2>One</h2>
+<p>a<sup id="fnref:1"><a href="#fn:1" class="footnote-ref" role="doc-noteref">1</a></sup></p>
+<h3>Sub</h3>
+<section class="footnotes" role="doc-endnotes">
+<hr>
+<ol>
+<li id="fn:1" role="doc-endnote">
+<p>B</p>
+</li>
+</ol>
+</section>
+<h2>Two</h2>
+<p>c<sup id="fnref:2"><a href="#fn:2" class="footnote-ref" role="doc-noteref">2</a></sup></p>
+<section class="footnotes" role="doc-endnotes">
+<hr>
+<ol start="2">
+<li id="fn:2" role="doc-endnote">
+<p>A</p>
+</li>
+</ol>
+</section>`,
+		},
+	}, t)
+}
+
+func TestFootnoteClasses(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithRendererOptions(
+			html.WithAccessibility(),
+		),
+		goldmark.WithExtensions(
+			NewFootnote(
+				WithFootnoteClasses("notes", "note-ref", "note-backref"),
+				WithFootnoteListTag("aside"),
+			),
+		),
+	)
+	goldmark.DoTestCases(markdown, []goldmark.MarkdownTestCase{{
+		No: 1,
+		Markdown: `Text[^a].
+
+[^a]: Note.`,
+		Expected: `<p>Text<sup id="fnref:1"><a href="#fn:1" class="note-ref" role="doc-noteref">1</a></sup>.</p>
+<aside class="notes" role="doc-endnotes" aria-label="Footnotes">
+<hr>
+<ol>
+<li id="fn:1" role="doc-footnote">
+<p>Note.</p>
+<a href="#fnref:1" class="note-backref" role="doc-backlink" aria-label="Back to reference 1">&#x21a9;&#xfe0e;</a>
+</li>
+</ol>
+</aside>`,
+	}}, t)
+}
+
+func TestFootnoteHTMLRendererOptions(t *testing.T) {
+	source := `Text[^a].
+
+[^a]: Note.`
+	for i, c := range []struct {
+		renderer renderer.NodeRenderer
+		expected string
+	}{
+		{NewFootnoteHTMLRenderer(html.WithXHTML()), `<p>Text<sup id="fnref:1"><a href="#fn:1" class="footnote-ref" role="doc-noteref">1</a></sup>.</p>
+<div class="footnotes" role="doc-endnotes">
+<hr />
+<ol>
+<li id="fn:1" role="doc-endnote">
+<p>Note.</p>
+</li>
+</ol>
+</div>`},
+		{NewFootnoteHTMLRendererWithOptions([]FootnoteOption{WithFootnoteIDPrefix("a-")}, html.WithXHTML()), `<p>Text<sup id="a-fnref:1"><a href="#a-fn:1" class="footnote-ref" role="doc-noteref">1</a></sup>.</p>
+<div class="footnotes" role="doc-endnotes">
+<hr />
+<ol>
+<li id="a-fn:1" role="doc-endnote">
+<p>Note.</p>
+</li>
+</ol>
+</div>`},
+	} {
+		markdown := goldmark.New(
+			goldmark.WithExtensions(
+				Footnote,
+			),
+			goldmark.WithRendererOptions(
+				renderer.WithNodeRenderers(
+					util.Prioritized(c.renderer, 100),
+				),
+			),
+		)
+		goldmark.DoTestCases(markdown, []goldmark.MarkdownTestCase{{
+			No:       i + 1,
+			Markdown: source,
+			Expected: c.expected,
+		}}, t)
+	}
+}
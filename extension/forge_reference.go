@@ -0,0 +1,301 @@
+package extension
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// A ForgeReferenceConfig struct is a data structure that holds
+// configuration of the ForgeReference extension.
+type ForgeReferenceConfig struct {
+	// Owner and Repository are a current repository like 'yuin/goldmark'.
+	// References like '#123' refer to the current repository.
+	Owner      string
+	Repository string
+
+	// IssueURL is a URL template of issues. '{owner}', '{repo}' and
+	// '{number}' are replaced with an owner, a repository and a number of
+	// an issue.
+	IssueURL string
+
+	// CommitURL is a URL template of commits. '{owner}', '{repo}' and
+	// '{sha}' are replaced with an owner, a repository and a SHA of
+	// a commit.
+	CommitURL string
+}
+
+// NewForgeReferenceConfig returns a new ForgeReferenceConfig with defaults.
+func NewForgeReferenceConfig() ForgeReferenceConfig {
+	return ForgeReferenceConfig{
+		IssueURL:  "https://github.com/{owner}/{repo}/issues/{number}",
+		CommitURL: "https://github.com/{owner}/{repo}/commit/{sha}",
+	}
+}
+
+// A ForgeReferenceOption interface sets options for the ForgeReference
+// extension.
+type ForgeReferenceOption interface {
+	SetForgeReferenceOption(*ForgeReferenceConfig)
+}
+
+type withForgeRepository struct {
+	owner      string
+	repository string
+}
+
+func (o *withForgeRepository) SetForgeReferenceOption(c *ForgeReferenceConfig) {
+	c.Owner = o.owner
+	c.Repository = o.repository
+}
+
+// WithForgeRepository is a functional option that sets a current
+// repository like 'yuin', 'goldmark'. References like '#123' are not
+// linked if URL templates need a current repository and it is not set.
+func WithForgeRepository(owner, repository string) ForgeReferenceOption {
+	return &withForgeRepository{owner, repository}
+}
+
+type withForgeIssueURL struct {
+	value string
+}
+
+func (o *withForgeIssueURL) SetForgeReferenceOption(c *ForgeReferenceConfig) {
+	c.IssueURL = o.value
+}
+
+// WithForgeIssueURL is a functional option that sets a URL template of
+// issues like 'https://git.example.com/{owner}/{repo}/issues/{number}'.
+// The default is a template of GitHub.
+func WithForgeIssueURL(template string) ForgeReferenceOption {
+	return &withForgeIssueURL{template}
+}
+
+type withForgeCommitURL struct {
+	value string
+}
+
+func (o *withForgeCommitURL) SetForgeReferenceOption(c *ForgeReferenceConfig) {
+	c.CommitURL = o.value
+}
+
+// WithForgeCommitURL is a functional option that sets a URL template of
+// commits like 'https://git.example.com/{owner}/{repo}/commit/{sha}'.
+// The default is a template of GitHub.
+func WithForgeCommitURL(template string) ForgeReferenceOption {
+	return &withForgeCommitURL{template}
+}
+
+// expandForgeURL expands the given URL template. expandForgeURL returns
+// false if the template needs a repository that is not given.
+func expandForgeURL(template string, owner, repository []byte, key string, value []byte) (string, bool) {
+	if (len(owner) == 0 && strings.Contains(template, "{owner}")) ||
+		(len(repository) == 0 && strings.Contains(template, "{repo}")) {
+		return "", false
+	}
+	r := strings.NewReplacer("{owner}", string(owner), "{repo}", string(repository), key, string(value))
+	return r.Replace(template), true
+}
+
+var (
+	issueReferenceRegexp      = regexp.MustCompile(`^#([0-9]+)\b`)
+	ghIssueReferenceRegexp    = regexp.MustCompile(`^GH-([0-9]+)\b`)
+	crossIssueReferenceRegexp = regexp.MustCompile(`^([A-Za-z0-9][A-Za-z0-9-]*)/([A-Za-z0-9._-]+)#([0-9]+)\b`)
+	commitReferenceRegexp     = regexp.MustCompile(`^(?:([A-Za-z0-9][A-Za-z0-9-]*)/([A-Za-z0-9._-]+)@)?([0-9a-f]{40})\b`)
+)
+
+type forgeReferenceParser struct {
+	ForgeReferenceConfig
+}
+
+// NewForgeReferenceParser returns a new parser.InlineParser that parses
+// GitHub style references like '#123', 'GH-42', 'owner/repo#7', 40
+// characters SHAs and 'owner/repo@SHA'.
+func NewForgeReferenceParser(opts ...ForgeReferenceOption) parser.InlineParser {
+	p := &forgeReferenceParser{
+		ForgeReferenceConfig: NewForgeReferenceConfig(),
+	}
+	for _, o := range opts {
+		o.SetForgeReferenceOption(&p.ForgeReferenceConfig)
+	}
+	return p
+}
+
+func (s *forgeReferenceParser) Trigger() []byte {
+	// ' ' indicates any white spaces and a line head
+	return []byte{' ', '(', '#'}
+}
+
+func (s *forgeReferenceParser) parseReference(line []byte) (gast.Node, int) {
+	if m := issueReferenceRegexp.FindSubmatch(line); m != nil {
+		return s.newIssueReference(nil, nil, m[1]), len(m[0])
+	}
+	if m := ghIssueReferenceRegexp.FindSubmatch(line); m != nil {
+		return s.newIssueReference(nil, nil, m[1]), len(m[0])
+	}
+	if m := crossIssueReferenceRegexp.FindSubmatch(line); m != nil {
+		return s.newIssueReference(m[1], m[2], m[3]), len(m[0])
+	}
+	if m := commitReferenceRegexp.FindSubmatch(line); m != nil {
+		return s.newCommitReference(m[1], m[2], m[3]), len(m[0])
+	}
+	return nil, 0
+}
+
+// repository returns the given repository, or the current repository if
+// the given owner is nil.
+func (s *forgeReferenceParser) repository(owner, repository []byte) ([]byte, []byte) {
+	if owner == nil {
+		return []byte(s.Owner), []byte(s.Repository)
+	}
+	return owner, repository
+}
+
+func (s *forgeReferenceParser) newIssueReference(owner, repository, number []byte) gast.Node {
+	o, r := s.repository(owner, repository)
+	destination, ok := expandForgeURL(s.IssueURL, o, r, "{number}", number)
+	if !ok {
+		return nil
+	}
+	n := ast.NewIssueReference(owner, repository, number)
+	n.Destination = []byte(destination)
+	return n
+}
+
+func (s *forgeReferenceParser) newCommitReference(owner, repository, sha []byte) gast.Node {
+	o, r := s.repository(owner, repository)
+	destination, ok := expandForgeURL(s.CommitURL, o, r, "{sha}", sha)
+	if !ok {
+		return nil
+	}
+	n := ast.NewCommitReference(owner, repository, sha)
+	n.Destination = []byte(destination)
+	return n
+}
+
+func (s *forgeReferenceParser) Parse(parent gast.Node, block text.Reader, pc parser.Context) gast.Node {
+	line, segment := block.PeekLine()
+	consumes := 0
+	c := line[0]
+	if c == '#' {
+		// references must not follow words like 'C#1'
+		if p := block.PrecendingCharacter(); unicode.IsLetter(p) || unicode.IsDigit(p) || p == '_' || p == '&' || p == '/' {
+			return nil
+		}
+	} else if c == ' ' || c == '(' || c == '\t' {
+		consumes++
+		line = line[1:]
+	}
+	n, length := s.parseReference(line)
+	if n == nil {
+		return nil
+	}
+	if consumes != 0 {
+		gast.MergeOrAppendTextSegment(parent, segment.WithStop(segment.Start+consumes))
+	}
+	start := segment.Start + consumes
+	n.AppendChild(n, gast.NewTextSegment(text.NewSegment(start, start+length)))
+	block.Advance(consumes + length)
+	return n
+}
+
+// ForgeReferenceHTMLRenderer is a renderer.NodeRenderer implementation that
+// renders IssueReference and CommitReference nodes.
+type ForgeReferenceHTMLRenderer struct {
+	html.Config
+}
+
+// NewForgeReferenceHTMLRenderer returns a new ForgeReferenceHTMLRenderer.
+func NewForgeReferenceHTMLRenderer(opts ...html.Option) renderer.NodeRenderer {
+	r := &ForgeReferenceHTMLRenderer{
+		Config: html.NewConfig(),
+	}
+	for _, opt := range opts {
+		opt.SetHTMLOption(&r.Config)
+	}
+	return r
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *ForgeReferenceHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindIssueReference, r.renderIssueReference)
+	reg.Register(ast.KindCommitReference, r.renderCommitReference)
+}
+
+func (r *ForgeReferenceHTMLRenderer) writeLink(w util.BufWriter, n gast.Node, destination []byte, class string) {
+	_, _ = w.WriteString(`<a href="`)
+	if r.Unsafe || !html.IsDangerousURL(destination) {
+		_, _ = w.Write(util.EscapeHTML(util.URLEscape(destination, false)))
+	}
+	_, _ = w.WriteString(`" class="`)
+	_, _ = w.WriteString(class)
+	_ = w.WriteByte('"')
+	if n.Attributes() != nil {
+		r.RenderAttributes(w, n)
+	}
+	_ = w.WriteByte('>')
+}
+
+func (r *ForgeReferenceHTMLRenderer) renderIssueReference(w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	if entering {
+		r.writeLink(w, node, node.(*ast.IssueReference).Destination, "issue-link")
+	} else {
+		_, _ = w.WriteString("</a>")
+	}
+	return gast.WalkContinue, nil
+}
+
+func (r *ForgeReferenceHTMLRenderer) renderCommitReference(w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	if !entering {
+		return gast.WalkContinue, nil
+	}
+	n := node.(*ast.CommitReference)
+	r.writeLink(w, n, n.Destination, "commit-link")
+	// SHAs are abbreviated as GitHub does
+	if len(n.Owner) != 0 {
+		_, _ = w.Write(util.EscapeHTML(n.Owner))
+		_ = w.WriteByte('/')
+		_, _ = w.Write(util.EscapeHTML(n.Repository))
+		_ = w.WriteByte('@')
+	}
+	_, _ = w.WriteString("<code>")
+	_, _ = w.Write(n.SHA[:7])
+	_, _ = w.WriteString("</code></a>")
+	return gast.WalkSkipChildren, nil
+}
+
+type forgeReference struct {
+	options []ForgeReferenceOption
+}
+
+// ForgeReference is an extension that links GitHub style references to
+// issues and commits like '#123' and 'owner/repo#7'.
+var ForgeReference = &forgeReference{}
+
+// NewForgeReference returns a new Extender that links references with the
+// given options. Use WithForgeIssueURL and WithForgeCommitURL for
+// self-hosted forges.
+func NewForgeReference(opts ...ForgeReferenceOption) goldmark.Extender {
+	return &forgeReference{
+		options: opts,
+	}
+}
+
+func (e *forgeReference) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(parser.WithInlineParsers(
+		// references must be parsed before linkify
+		util.Prioritized(NewForgeReferenceParser(e.options...), 998),
+	))
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(NewForgeReferenceHTMLRenderer(), 500),
+	))
+}
@@ -0,0 +1,37 @@
+package extension
+
+import (
+	"testing"
+
+	"github.com/yuin/goldmark"
+)
+
+func TestForgeReference(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			NewForgeReference(
+				WithForgeRepository("yuin", "goldmark"),
+			),
+			Linkify,
+		),
+	)
+	goldmark.DoTestCaseFile(markdown, "_test/forge_reference.txt", t)
+}
+
+func TestForgeReferenceURL(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			NewForgeReference(
+				WithForgeIssueURL("https://git.example.com/{owner}/{repo}/-/issues/{number}"),
+				WithForgeCommitURL("/commit/{sha}"),
+			),
+		),
+	)
+	goldmark.DoTestCases(markdown, []goldmark.MarkdownTestCase{
+		{
+			No:       1,
+			Markdown: "#1 and a/b#2 and 0123456789abcdef0123456789abcdef01234567",
+			Expected: `<p>#1 and <a href="https://git.example.com/a/b/-/issues/2" class="issue-link">a/b#2</a> and <a href="/commit/0123456789abcdef0123456789abcdef01234567" class="commit-link"><code>0123456</code></a></p>`,
+		},
+	}, t)
+}
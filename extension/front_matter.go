@@ -0,0 +1,739 @@
+package extension
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// FrontMatterValues is a set of values written in YAML front matter.
+// Values are strings, ints, float64s, bools, nils, []interface{} and
+// map[string]interface{}.
+type FrontMatterValues map[string]interface{}
+
+// Get returns a value of the given key.
+func (v FrontMatterValues) Get(key string) (interface{}, bool) {
+	value, ok := v[key]
+	return value, ok
+}
+
+// String returns a string value of the given key.
+func (v FrontMatterValues) String(key string) (string, bool) {
+	value, ok := v[key].(string)
+	return value, ok
+}
+
+// Int returns an int value of the given key.
+// Floats that do not have fractional parts are also returned as ints.
+func (v FrontMatterValues) Int(key string) (int, bool) {
+	switch value := v[key].(type) {
+	case int:
+		return value, true
+	case float64:
+		if value == float64(int(value)) {
+			return int(value), true
+		}
+	}
+	return 0, false
+}
+
+// Float returns a float64 value of the given key.
+// Ints are also returned as float64s.
+func (v FrontMatterValues) Float(key string) (float64, bool) {
+	switch value := v[key].(type) {
+	case int:
+		return float64(value), true
+	case float64:
+		return value, true
+	}
+	return 0, false
+}
+
+// Bool returns a bool value of the given key.
+func (v FrontMatterValues) Bool(key string) (bool, bool) {
+	value, ok := v[key].(bool)
+	return value, ok
+}
+
+// Strings returns a list of strings of the given key like 'tags: [a, b]'.
+// A string value is returned as a list that has only the string.
+func (v FrontMatterValues) Strings(key string) ([]string, bool) {
+	switch value := v[key].(type) {
+	case string:
+		return []string{value}, true
+	case []interface{}:
+		result := make([]string, 0, len(value))
+		for _, e := range value {
+			s, ok := e.(string)
+			if !ok {
+				return nil, false
+			}
+			result = append(result, s)
+		}
+		return result, true
+	}
+	return nil, false
+}
+
+// Slice returns a list of values of the given key.
+func (v FrontMatterValues) Slice(key string) ([]interface{}, bool) {
+	value, ok := v[key].([]interface{})
+	return value, ok
+}
+
+// Map returns nested values of the given key.
+func (v FrontMatterValues) Map(key string) (FrontMatterValues, bool) {
+	switch value := v[key].(type) {
+	case map[string]interface{}:
+		return FrontMatterValues(value), true
+	case FrontMatterValues:
+		return value, true
+	}
+	return nil, false
+}
+
+var frontMatterTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// Time returns a time of the given key written like '2006-01-02' or
+// '2006-01-02T15:04:05Z07:00'. Times without time zones are in UTC.
+func (v FrontMatterValues) Time(key string) (time.Time, bool) {
+	s, ok := v[key].(string)
+	if !ok {
+		return time.Time{}, false
+	}
+	for _, layout := range frontMatterTimeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+var frontMatterKey = parser.NewContextKey()
+var frontMatterErrorKey = parser.NewContextKey()
+
+// GetFrontMatter returns values of front matter of a document parsed with
+// the given context. GetFrontMatter returns nil if the document does not
+// have front matter.
+func GetFrontMatter(pc parser.Context) FrontMatterValues {
+	v := pc.Get(frontMatterKey)
+	if v == nil {
+		return nil
+	}
+	return v.(FrontMatterValues)
+}
+
+// FrontMatterError returns an error occurred while parsing front matter
+// of a document parsed with the given context. Front matter that has
+// errors is rendered as Markdown text.
+func FrontMatterError(pc parser.Context) error {
+	v := pc.Get(frontMatterErrorKey)
+	if v == nil {
+		return nil
+	}
+	return v.(error)
+}
+
+// DocumentFrontMatter returns metadata of the given document as
+// FrontMatterValues.
+func DocumentFrontMatter(doc *gast.Document) FrontMatterValues {
+	return FrontMatterValues(doc.Meta())
+}
+
+type frontMatterParser struct {
+}
+
+var defaultFrontMatterParser = &frontMatterParser{}
+
+// NewFrontMatterParser returns a new parser.BlockParser that parses
+// YAML front matter delimited by '---' at the beginning of documents.
+func NewFrontMatterParser() parser.BlockParser {
+	return defaultFrontMatterParser
+}
+
+func isFrontMatterDelimiter(line []byte, closing bool) bool {
+	line = util.TrimRightSpace(line)
+	return string(line) == "---" || (closing && string(line) == "...")
+}
+
+func (b *frontMatterParser) Open(parent gast.Node, reader text.Reader, pc parser.Context) (gast.Node, parser.State) {
+	if parent.Kind() != gast.KindDocument || parent.HasChildren() {
+		return nil, parser.NoChildren
+	}
+	line, segment := reader.PeekLine()
+	if segment.Start != 0 || !isFrontMatterDelimiter(line, false) {
+		return nil, parser.NoChildren
+	}
+	// '---' without closing delimiters or followed by contents that are
+	// not YAML is a thematic break
+	source := reader.Source()
+	for pos := segment.Stop; pos < len(source); {
+		stop := len(source)
+		if i := bytes.IndexByte(source[pos:], '\n'); i > -1 {
+			stop = pos + i + 1
+		}
+		if !isFrontMatterDelimiter(source[pos:stop], true) {
+			pos = stop
+			continue
+		}
+		values, err := parseYAML(source[segment.Stop:pos])
+		if err != nil {
+			pc.Set(frontMatterErrorKey, err)
+			return nil, parser.NoChildren
+		}
+		pc.Set(frontMatterKey, FrontMatterValues(values))
+		reader.Advance(segment.Len() - 1)
+		return ast.NewFrontMatter(), parser.NoChildren
+	}
+	return nil, parser.NoChildren
+}
+
+func (b *frontMatterParser) Continue(node gast.Node, reader text.Reader, pc parser.Context) parser.State {
+	line, segment := reader.PeekLine()
+	if isFrontMatterDelimiter(line, true) {
+		reader.Advance(segment.Len() - 1)
+		return parser.Close
+	}
+	node.Lines().Append(segment)
+	reader.Advance(segment.Len() - 1)
+	return parser.Continue | parser.NoChildren
+}
+
+func (b *frontMatterParser) Close(node gast.Node, reader text.Reader, pc parser.Context) {
+	// nothing to do
+}
+
+func (b *frontMatterParser) CanInterruptParagraph() bool {
+	return false
+}
+
+func (b *frontMatterParser) CanAcceptIndentedLine() bool {
+	return false
+}
+
+type frontMatterASTTransformer struct {
+}
+
+var defaultFrontMatterASTTransformer = &frontMatterASTTransformer{}
+
+// NewFrontMatterASTTransformer returns a new parser.ASTTransformer that
+// removes front matter from documents and adds its values parsed by
+// the front matter parser to metadata of the documents.
+func NewFrontMatterASTTransformer() parser.ASTTransformer {
+	return defaultFrontMatterASTTransformer
+}
+
+func (a *frontMatterASTTransformer) Transform(node *gast.Document, reader text.Reader, pc parser.Context) {
+	fm, ok := node.FirstChild().(*ast.FrontMatter)
+	if !ok {
+		return
+	}
+	node.RemoveChild(node, fm)
+	for key, value := range GetFrontMatter(pc) {
+		node.AddMeta(key, value)
+	}
+}
+
+type frontMatter struct {
+}
+
+// FrontMatter is an extension that parses YAML front matter like
+//
+//	---
+//	title: Hello
+//	tags: [a, b]
+//	---
+//
+// at the beginning of documents. Front matter is not rendered, and its
+// values are added to metadata of documents(see DocumentFrontMatter) and
+// parser contexts(see GetFrontMatter).
+//
+// Front matter supports a subset of YAML: block and flow mappings and
+// sequences, plain and quoted scalars, literal and folded block scalars
+// and comments. Anchors, aliases, tags and multiple documents are not
+// supported. Front matter that is not valid YAML is rendered as Markdown
+// text, and errors are available via FrontMatterError.
+var FrontMatter = &frontMatter{}
+
+func (e *frontMatter) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithBlockParsers(
+			util.Prioritized(NewFrontMatterParser(), 0),
+		),
+		parser.WithASTTransformers(
+			util.Prioritized(NewFrontMatterASTTransformer(), 0),
+		),
+	)
+}
+
+// A yamlLine struct is a line of YAML documents.
+type yamlLine struct {
+	// number is a 1-based line number in the Markdown source.
+	number int
+	indent int
+	text   string
+}
+
+func (l yamlLine) isBlank() bool {
+	return len(l.text) == 0 || l.text[0] == '#'
+}
+
+func (l yamlLine) isSequenceItem() bool {
+	return l.text == "-" || strings.HasPrefix(l.text, "- ")
+}
+
+type yamlParser struct {
+	lines []yamlLine
+	pos   int
+}
+
+func (p *yamlParser) errorf(format string, args ...interface{}) error {
+	number := 0
+	if p.pos < len(p.lines) {
+		number = p.lines[p.pos].number
+	} else if len(p.lines) != 0 {
+		number = p.lines[len(p.lines)-1].number
+	}
+	return fmt.Errorf("front matter: line %d: %s", number, fmt.Sprintf(format, args...))
+}
+
+// parseYAML parses the given YAML document into a map.
+func parseYAML(source []byte) (map[string]interface{}, error) {
+	p := &yamlParser{}
+	for i, line := range strings.Split(string(source), "\n") {
+		line = strings.TrimRight(line, " \t\r")
+		text := strings.TrimLeft(line, " ")
+		// lines start after the opening delimiter
+		p.lines = append(p.lines, yamlLine{i + 2, len(line) - len(text), text})
+	}
+	p.skipBlankLines()
+	if p.pos == len(p.lines) {
+		return map[string]interface{}{}, nil
+	}
+	v, err := p.parseNode(p.lines[p.pos].indent)
+	if err != nil {
+		return nil, err
+	}
+	p.skipBlankLines()
+	if p.pos != len(p.lines) {
+		return nil, p.errorf("unexpected indentation")
+	}
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("front matter: front matter must be a mapping")
+	}
+	return m, nil
+}
+
+func (p *yamlParser) skipBlankLines() {
+	for p.pos < len(p.lines) && p.lines[p.pos].isBlank() {
+		p.pos++
+	}
+}
+
+func (p *yamlParser) parseNode(indent int) (interface{}, error) {
+	line := p.lines[p.pos]
+	if line.isSequenceItem() {
+		return p.parseSequence(indent)
+	}
+	if _, _, ok := splitYAMLKey(line.text); ok {
+		return p.parseMapping(indent)
+	}
+	p.pos++
+	return p.parseValue(line.text, indent, false)
+}
+
+func (p *yamlParser) parseMapping(indent int) (interface{}, error) {
+	m := map[string]interface{}{}
+	for {
+		p.skipBlankLines()
+		if p.pos == len(p.lines) || p.lines[p.pos].indent < indent {
+			return m, nil
+		}
+		line := p.lines[p.pos]
+		if line.indent > indent {
+			return nil, p.errorf("unexpected indentation")
+		}
+		key, rest, ok := splitYAMLKey(line.text)
+		if !ok {
+			return nil, p.errorf("expected a mapping key")
+		}
+		if _, ok := m[key]; ok {
+			return nil, p.errorf("duplicated key %q", key)
+		}
+		p.pos++
+		v, err := p.parseValue(rest, indent, true)
+		if err != nil {
+			return nil, err
+		}
+		m[key] = v
+	}
+}
+
+func (p *yamlParser) parseSequence(indent int) (interface{}, error) {
+	s := []interface{}{}
+	for {
+		p.skipBlankLines()
+		if p.pos == len(p.lines) || p.lines[p.pos].indent < indent {
+			return s, nil
+		}
+		line := p.lines[p.pos]
+		if line.indent > indent {
+			return nil, p.errorf("unexpected indentation")
+		}
+		if !line.isSequenceItem() {
+			return s, nil
+		}
+		rest := strings.TrimLeft(line.text[1:], " ")
+		var v interface{}
+		var err error
+		if _, _, ok := splitYAMLKey(rest); ok || rest == "-" || strings.HasPrefix(rest, "- ") {
+			// items like '- key: value' are parsed as nodes that start
+			// after '- '
+			p.lines[p.pos] = yamlLine{line.number, indent + len(line.text) - len(rest), rest}
+			v, err = p.parseNode(p.lines[p.pos].indent)
+		} else {
+			p.pos++
+			v, err = p.parseValue(rest, indent, false)
+		}
+		if err != nil {
+			return nil, err
+		}
+		s = append(s, v)
+	}
+}
+
+// parseValue parses a value that follows a mapping key or a sequence
+// indicator. Values may be written in following lines.
+func (p *yamlParser) parseValue(value string, indent int, inMapping bool) (interface{}, error) {
+	value = stripYAMLComment(value)
+	if len(value) == 0 {
+		p.skipBlankLines()
+		if p.pos == len(p.lines) {
+			return nil, nil
+		}
+		next := p.lines[p.pos]
+		if next.indent > indent {
+			return p.parseNode(next.indent)
+		}
+		// sequences can be at the same indentation as their keys
+		if inMapping && next.indent == indent && next.isSequenceItem() {
+			return p.parseSequence(indent)
+		}
+		return nil, nil
+	}
+	if value[0] == '|' || value[0] == '>' {
+		return p.parseBlockScalar(value, indent)
+	}
+	v, err := parseYAMLFlow(value)
+	if err != nil {
+		p.pos--
+		return nil, p.errorf("%s", err)
+	}
+	return v, nil
+}
+
+var yamlBlockScalarHeaderRegexp = regexp.MustCompile(`^[|>]([-+]?)([1-9]?)$`)
+
+func (p *yamlParser) parseBlockScalar(header string, indent int) (interface{}, error) {
+	m := yamlBlockScalarHeaderRegexp.FindStringSubmatch(header)
+	if m == nil {
+		p.pos--
+		return nil, p.errorf("invalid block scalar header %q", header)
+	}
+	blockIndent := -1
+	if len(m[2]) != 0 {
+		blockIndent = indent + int(m[2][0]-'0')
+	}
+	var lines []string
+	for ; p.pos < len(p.lines); p.pos++ {
+		line := p.lines[p.pos]
+		if len(line.text) == 0 {
+			lines = append(lines, "")
+			continue
+		}
+		if line.indent <= indent {
+			break
+		}
+		if blockIndent < 0 {
+			blockIndent = line.indent
+		}
+		if line.indent < blockIndent {
+			return nil, p.errorf("unexpected indentation")
+		}
+		lines = append(lines, strings.Repeat(" ", line.indent-blockIndent)+line.text)
+	}
+	trailing := 0
+	for len(lines) != 0 && len(lines[len(lines)-1]) == 0 {
+		lines = lines[:len(lines)-1]
+		trailing++
+	}
+	var buf bytes.Buffer
+	for i, line := range lines {
+		if i != 0 {
+			prev := lines[i-1]
+			if header[0] == '|' || strings.HasPrefix(line, " ") || strings.HasPrefix(prev, " ") {
+				_ = buf.WriteByte('\n')
+			} else if len(prev) == 0 {
+				// a line break before empty lines is folded
+				_ = buf.WriteByte('\n')
+			} else if len(line) != 0 {
+				_ = buf.WriteByte(' ')
+			}
+		}
+		_, _ = buf.WriteString(line)
+	}
+	switch {
+	case len(lines) == 0 || m[1] == "-":
+	case m[1] == "+":
+		_, _ = buf.WriteString(strings.Repeat("\n", trailing+1))
+	default:
+		_ = buf.WriteByte('\n')
+	}
+	return buf.String(), nil
+}
+
+// splitYAMLKey splits the given line like 'key: value' into a key and
+// a value.
+func splitYAMLKey(line string) (string, string, bool) {
+	if len(line) == 0 {
+		return "", "", false
+	}
+	switch line[0] {
+	case '"', '\'':
+		key, n, err := parseYAMLQuoted(line)
+		if err != nil {
+			return "", "", false
+		}
+		rest := strings.TrimLeft(line[n:], " ")
+		if len(rest) == 0 || rest[0] != ':' || (len(rest) > 1 && rest[1] != ' ') {
+			return "", "", false
+		}
+		return key, strings.TrimSpace(rest[1:]), true
+	case '[', '{', '#', '-', '|', '>':
+		return "", "", false
+	}
+	for i := 0; i < len(line); i++ {
+		if line[i] == '#' && i != 0 && line[i-1] == ' ' {
+			return "", "", false
+		}
+		if line[i] == ':' && (i == len(line)-1 || line[i+1] == ' ') {
+			key := strings.TrimSpace(line[:i])
+			return key, strings.TrimSpace(line[i+1:]), len(key) != 0
+		}
+	}
+	return "", "", false
+}
+
+// stripYAMLComment removes a comment like ' # comment' from the given value.
+func stripYAMLComment(value string) string {
+	var quote byte
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		switch {
+		case quote == '"' && c == '\\':
+			i++
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			if i == 0 || strings.IndexByte(" [{,:", value[i-1]) > -1 {
+				quote = c
+			}
+		case c == '#' && (i == 0 || value[i-1] == ' '):
+			return strings.TrimSpace(value[:i])
+		}
+	}
+	return strings.TrimSpace(value)
+}
+
+// parseYAMLQuoted parses a quoted scalar at the beginning of the given
+// value, and returns the scalar and the length of the quoted scalar.
+func parseYAMLQuoted(value string) (string, int, error) {
+	quote := value[0]
+	for i := 1; i < len(value); i++ {
+		c := value[i]
+		if quote == '"' && c == '\\' {
+			i++
+			continue
+		}
+		if c != quote {
+			continue
+		}
+		if quote == '\'' {
+			if i+1 < len(value) && value[i+1] == '\'' {
+				i++
+				continue
+			}
+			return strings.Replace(value[1:i], "''", "'", -1), i + 1, nil
+		}
+		s, err := strconv.Unquote(strings.Replace(value[:i+1], `\/`, "/", -1))
+		if err != nil {
+			return "", 0, fmt.Errorf("invalid double-quoted scalar %s", value[:i+1])
+		}
+		return s, i + 1, nil
+	}
+	return "", 0, fmt.Errorf("unclosed quoted scalar %s", value)
+}
+
+var (
+	yamlIntRegexp   = regexp.MustCompile(`^[-+]?[0-9]+$`)
+	yamlHexRegexp   = regexp.MustCompile(`^0x[0-9a-fA-F]+$`)
+	yamlFloatRegexp = regexp.MustCompile(`^[-+]?(\.[0-9]+|[0-9]+(\.[0-9]*)?)([eE][-+]?[0-9]+)?$`)
+)
+
+// resolveYAMLScalar resolves a type of the given plain scalar.
+func resolveYAMLScalar(s string) interface{} {
+	switch s {
+	case "", "~", "null", "Null", "NULL":
+		return nil
+	case "true", "True", "TRUE":
+		return true
+	case "false", "False", "FALSE":
+		return false
+	}
+	if yamlIntRegexp.MatchString(s) {
+		if v, err := strconv.ParseInt(s, 10, 0); err == nil {
+			return int(v)
+		}
+	} else if yamlHexRegexp.MatchString(s) {
+		if v, err := strconv.ParseInt(s[2:], 16, 0); err == nil {
+			return int(v)
+		}
+	} else if yamlFloatRegexp.MatchString(s) {
+		if v, err := strconv.ParseFloat(s, 64); err == nil {
+			return v
+		}
+	}
+	return s
+}
+
+// parseYAMLFlow parses a scalar or a flow collection like '[a, b]'
+// written in a line.
+func parseYAMLFlow(value string) (interface{}, error) {
+	if value[0] != '[' && value[0] != '{' && value[0] != '"' && value[0] != '\'' {
+		return resolveYAMLScalar(value), nil
+	}
+	p := &yamlFlowParser{value: value}
+	v, err := p.parse()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpaces()
+	if p.pos != len(p.value) {
+		return nil, fmt.Errorf("unexpected characters %q", p.value[p.pos:])
+	}
+	return v, nil
+}
+
+type yamlFlowParser struct {
+	value string
+	pos   int
+}
+
+func (p *yamlFlowParser) skipSpaces() {
+	for p.pos < len(p.value) && p.value[p.pos] == ' ' {
+		p.pos++
+	}
+}
+
+func (p *yamlFlowParser) parse() (interface{}, error) {
+	p.skipSpaces()
+	if p.pos == len(p.value) {
+		return nil, fmt.Errorf("unexpected end of a flow collection")
+	}
+	switch p.value[p.pos] {
+	case '[':
+		p.pos++
+		s := []interface{}{}
+		for {
+			p.skipSpaces()
+			if p.pos < len(p.value) && p.value[p.pos] == ']' {
+				p.pos++
+				return s, nil
+			}
+			v, err := p.parse()
+			if err != nil {
+				return nil, err
+			}
+			s = append(s, v)
+			if err := p.separator(']'); err != nil {
+				return nil, err
+			}
+		}
+	case '{':
+		p.pos++
+		m := map[string]interface{}{}
+		for {
+			p.skipSpaces()
+			if p.pos < len(p.value) && p.value[p.pos] == '}' {
+				p.pos++
+				return m, nil
+			}
+			k, err := p.parse()
+			if err != nil {
+				return nil, err
+			}
+			p.skipSpaces()
+			if p.pos == len(p.value) || p.value[p.pos] != ':' {
+				return nil, fmt.Errorf("expected ':' in a flow mapping")
+			}
+			p.pos++
+			v, err := p.parse()
+			if err != nil {
+				return nil, err
+			}
+			m[fmt.Sprint(k)] = v
+			if err := p.separator('}'); err != nil {
+				return nil, err
+			}
+		}
+	case '"', '\'':
+		s, n, err := parseYAMLQuoted(p.value[p.pos:])
+		if err != nil {
+			return nil, err
+		}
+		p.pos += n
+		return s, nil
+	}
+	start := p.pos
+	for ; p.pos < len(p.value); p.pos++ {
+		c := p.value[p.pos]
+		if c == ',' || c == ']' || c == '}' || (c == ':' && (p.pos+1 == len(p.value) || p.value[p.pos+1] == ' ')) {
+			break
+		}
+	}
+	return resolveYAMLScalar(strings.TrimSpace(p.value[start:p.pos])), nil
+}
+
+func (p *yamlFlowParser) separator(closer byte) error {
+	p.skipSpaces()
+	if p.pos == len(p.value) {
+		return fmt.Errorf("unclosed flow collection")
+	}
+	switch p.value[p.pos] {
+	case ',':
+		p.pos++
+		return nil
+	case closer:
+		return nil
+	}
+	return fmt.Errorf("unexpected character %q in a flow collection", p.value[p.pos])
+}
@@ -0,0 +1,122 @@
+package extension
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+)
+
+func TestFrontMatter(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			FrontMatter,
+		),
+	)
+	goldmark.DoTestCaseFile(markdown, "_test/front_matter.txt", t)
+}
+
+func TestFrontMatterValues(t *testing.T) {
+	markdown := goldmark.New(goldmark.WithExtensions(FrontMatter))
+	source := []byte(`---
+title: "Hello: world" # comment
+date: 2024-01-02
+weight: 3
+draft: false
+tags: [go, 'it''s']
+author:
+  name: Ann
+  links:
+    - https://example.com
+    - {rel: me}
+summary: >
+  folded
+  text
+---
+body
+`)
+	pc := parser.NewContext()
+	doc := markdown.Parser().Parse(text.NewReader(source), parser.WithContext(pc))
+	if err := FrontMatterError(pc); err != nil {
+		t.Fatal(err)
+	}
+	values := GetFrontMatter(pc)
+	if v, ok := values.String("title"); !ok || v != "Hello: world" {
+		t.Errorf("unexpected title: %q", v)
+	}
+	if v, ok := values.Time("date"); !ok || !v.Equal(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected date: %v", v)
+	}
+	if v, ok := values.Int("weight"); !ok || v != 3 {
+		t.Errorf("unexpected weight: %v", v)
+	}
+	if v, ok := values.Bool("draft"); !ok || v {
+		t.Errorf("unexpected draft: %v", v)
+	}
+	if v, ok := values.Strings("tags"); !ok || len(v) != 2 || v[1] != "it's" {
+		t.Errorf("unexpected tags: %v", v)
+	}
+	if v, ok := values.String("summary"); !ok || v != "folded text\n" {
+		t.Errorf("unexpected summary: %q", v)
+	}
+	author, ok := values.Map("author")
+	if !ok {
+		t.Fatal("author must be a mapping")
+	}
+	if v, ok := author.String("name"); !ok || v != "Ann" {
+		t.Errorf("unexpected name: %q", v)
+	}
+	if v, ok := author.Slice("links"); !ok || len(v) != 2 {
+		t.Errorf("unexpected links: %v", v)
+	}
+	if _, ok := values.String("weight"); ok {
+		t.Error("weight must not be a string")
+	}
+	meta := DocumentFrontMatter(doc.(*gast.Document))
+	if v, ok := meta.String("title"); !ok || v != "Hello: world" {
+		t.Errorf("unexpected title in document metadata: %q", v)
+	}
+}
+
+func TestFrontMatterError(t *testing.T) {
+	markdown := goldmark.New(goldmark.WithExtensions(FrontMatter))
+	source := []byte("---\ntitle: a\n  b: c\n---\nbody\n")
+	pc := parser.NewContext()
+	doc := markdown.Parser().Parse(text.NewReader(source), parser.WithContext(pc))
+	err := FrontMatterError(pc)
+	if err == nil || err.Error() != "front matter: line 3: unexpected indentation" {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if GetFrontMatter(pc) != nil {
+		t.Error("values must not be set")
+	}
+	// invalid front matter is kept as Markdown text
+	if doc.FirstChild().Kind() != gast.KindThemanticBreak || doc.ChildCount() != 3 {
+		t.Errorf("front matter must be parsed as Markdown: %s", doc.FirstChild().Kind())
+	}
+}
+
+func TestFrontMatterDocumentOptions(t *testing.T) {
+	markdown := goldmark.New(goldmark.WithExtensions(FrontMatter))
+	// front matter must not change options of the document
+	source := []byte("---\nhtml.HardWraps: true\n---\na\nb\n")
+	var b bytes.Buffer
+	if err := markdown.Convert(source, &b); err != nil {
+		t.Fatal(err)
+	}
+	if b.String() != "<p>a\nb</p>\n" {
+		t.Errorf("unexpected output: %q", b.String())
+	}
+	// document options must not be exposed as front matter
+	doc := markdown.Parser().Parse(text.NewReader([]byte("---\ntitle: a\n---\n")),
+		html.WithDocumentHardWraps(true))
+	meta := DocumentFrontMatter(doc.(*gast.Document))
+	if len(meta) != 1 {
+		t.Errorf("unexpected metadata: %v", meta)
+	}
+}
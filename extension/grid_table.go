@@ -0,0 +1,740 @@
+package extension
+
+import (
+	"bytes"
+	"unicode/utf8"
+
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// A gridTableLine struct is a line of grid tables and multiline tables.
+// Columns of tables are counted in runes.
+type gridTableLine struct {
+	// segment is a segment of the line without a newline.
+	segment text.Segment
+	runes   []rune
+	// offsets are byte offsets of runes in the line.
+	offsets []int
+}
+
+func newGridTableLine(source []byte, segment text.Segment) *gridTableLine {
+	start := segment.Start
+	if i := bytes.LastIndexByte(source[:start], '\n'); i+1 != start {
+		// tables in container blocks like blockquotes: columns are
+		// counted from the head of lines.
+		start = i + 1
+	}
+	stop := segment.Stop
+	if stop > start && source[stop-1] == '\n' {
+		stop--
+	}
+	l := &gridTableLine{
+		segment: text.NewSegment(start, stop),
+	}
+	value := source[start:stop]
+	for i := 0; i < len(value); {
+		r, size := utf8.DecodeRune(value[i:])
+		l.runes = append(l.runes, r)
+		l.offsets = append(l.offsets, i)
+		i += size
+	}
+	l.offsets = append(l.offsets, len(value))
+	return l
+}
+
+// column returns a column of the given byte offset in the source.
+func (l *gridTableLine) column(offset int) int {
+	for col, o := range l.offsets {
+		if l.segment.Start+o >= offset {
+			return col
+		}
+	}
+	return len(l.runes)
+}
+
+// at returns a rune at the given column, or 0 if the line is shorter.
+func (l *gridTableLine) at(col int) rune {
+	if col < len(l.runes) {
+		return l.runes[col]
+	}
+	return 0
+}
+
+// isBlankFrom returns true if the line is blank after the given column.
+func (l *gridTableLine) isBlankFrom(col int) bool {
+	for ; col < len(l.runes); col++ {
+		if l.runes[col] != ' ' && l.runes[col] != '\t' && l.runes[col] != '\r' {
+			return false
+		}
+	}
+	return true
+}
+
+// textRange returns a range of non-space runes between the given columns.
+func (l *gridTableLine) textRange(start, stop int) (int, int, bool) {
+	if stop > len(l.runes) {
+		stop = len(l.runes)
+	}
+	for ; start < stop && util.IsSpace(byte(l.runes[start])); start++ {
+	}
+	for ; stop > start && util.IsSpace(byte(l.runes[stop-1])); stop-- {
+	}
+	return start, stop, start < stop
+}
+
+// peekGridTableLines returns lines from the current line. Lines that do not
+// satisfy the given function are not returned. A position of the reader is
+// not changed.
+func peekGridTableLines(reader text.Reader, accept func(*gridTableLine) bool) []*gridTableLine {
+	savedLine, savedPosition := reader.Position()
+	defer reader.SetPosition(savedLine, savedPosition)
+	var lines []*gridTableLine
+	for {
+		line, segment := reader.PeekLine()
+		if line == nil {
+			break
+		}
+		l := newGridTableLine(reader.Source(), segment)
+		if !accept(l) {
+			break
+		}
+		lines = append(lines, l)
+		reader.AdvanceLine()
+	}
+	return lines
+}
+
+// A gridTableCell struct is a cell of grid tables and multiline tables.
+// Contents of cells are parsed as blocks after the whole document is parsed,
+// so that cells can contain lists and code blocks and can refer link
+// reference definitions in the document.
+type gridTableCell struct {
+	node  *ast.TableCell
+	lines *text.Segments
+
+	// source is a copy of the source whose lines of the cell end with
+	// newlines.
+	source []byte
+}
+
+var gridTableStateKey = parser.NewContextKey()
+
+// gridTableState is a per-parse state of grid tables and multiline tables.
+type gridTableState struct {
+	// cells are cells that the GridTableCellTransformer parses later.
+	cells []*gridTableCell
+
+	// remaining is a number of lines of the current table that were read
+	// while opening the table and are not consumed yet.
+	remaining int
+}
+
+func getGridTableState(pc parser.Context) *gridTableState {
+	return parser.ContextState(pc, gridTableStateKey, func() interface{} {
+		return &gridTableState{}
+	}).(*gridTableState)
+}
+
+// A gridTableBuilder struct builds Table nodes from lines of grid tables
+// and multiline tables.
+type gridTableBuilder struct {
+	source []byte
+	cells  []*gridTableCell
+}
+
+func newGridTableBuilder(source []byte) *gridTableBuilder {
+	// one more byte for a newline of the last line.
+	s := make([]byte, len(source)+1)
+	copy(s, source)
+	s[len(source)] = '\n'
+	return &gridTableBuilder{
+		source: s,
+	}
+}
+
+// newCell returns a new TableCell that consists of the given columns of
+// the given lines. If stop is -1, the cell continues to the end of lines.
+func (b *gridTableBuilder) newCell(lines []*gridTableLine, start, stop int, alignment ast.Alignment) *ast.TableCell {
+	node := ast.NewTableCell()
+	node.Alignment = alignment
+	starts := make([]int, len(lines))
+	stops := make([]int, len(lines))
+	indent := -1
+	for i, l := range lines {
+		s, e := len(l.runes), len(l.runes)
+		if start < s {
+			s = start
+		}
+		if stop > -1 && stop < e {
+			e = stop
+		}
+		starts[i], stops[i] = l.offsets[s], l.offsets[e]
+		value := b.source[l.segment.Start+starts[i] : l.segment.Start+stops[i]]
+		stops[i] = starts[i] + len(util.TrimRightSpace(value))
+		if w := util.TrimLeftSpaceLength(value); w != len(value) && (indent < 0 || w < indent) {
+			indent = w
+		}
+	}
+	lines2 := text.NewSegments()
+	for i, l := range lines {
+		s, e := l.segment.Start+starts[i], l.segment.Start+stops[i]
+		if s != e {
+			s += indent
+		}
+		// a position after the cell is the border or the space between
+		// columns, or the end of the line.
+		b.source[e] = '\n'
+		lines2.Append(text.NewSegment(s, e+1))
+	}
+	b.cells = append(b.cells, &gridTableCell{
+		node:   node,
+		lines:  lines2,
+		source: b.source,
+	})
+	return node
+}
+
+// finish registers cells to the context, so the GridTableCellTransformer
+// parses them later.
+func (b *gridTableBuilder) finish(pc parser.Context) {
+	state := getGridTableState(pc)
+	state.cells = append(state.cells, b.cells...)
+}
+
+// isGridTableBorder returns true if the given line is a border like
+// '+---+:==+' of a grid table whose columns are separated at the given
+// columns. chars are characters allowed between '+'.
+func isGridTableBorder(l *gridTableLine, columns []int, chars string) bool {
+	for i, col := range columns {
+		if l.at(col) != '+' {
+			return false
+		}
+		if i == len(columns)-1 {
+			break
+		}
+		for c := col + 1; c < columns[i+1]; c++ {
+			if l.at(c) == 0 || !bytes.ContainsRune([]byte(chars), l.at(c)) {
+				return false
+			}
+		}
+	}
+	return l.isBlankFrom(columns[len(columns)-1] + 1)
+}
+
+// isGridTableRow returns true if the given line is a line of a row like
+// '| a | b |' of a grid table whose columns are separated at the given
+// columns.
+func isGridTableRow(l *gridTableLine, columns []int) bool {
+	for _, col := range columns {
+		if l.at(col) != '|' {
+			return false
+		}
+	}
+	return l.isBlankFrom(columns[len(columns)-1] + 1)
+}
+
+// gridTableAlignments returns alignments of columns specified by colons in
+// the given border like '+:---+---:+'.
+func gridTableAlignments(l *gridTableLine, columns []int) []ast.Alignment {
+	alignments := make([]ast.Alignment, len(columns)-1)
+	for i := range alignments {
+		left := l.at(columns[i]+1) == ':'
+		right := l.at(columns[i+1]-1) == ':'
+		switch {
+		case left && right:
+			alignments[i] = ast.AlignCenter
+		case left:
+			alignments[i] = ast.AlignLeft
+		case right:
+			alignments[i] = ast.AlignRight
+		default:
+			alignments[i] = ast.AlignNone
+		}
+	}
+	return alignments
+}
+
+type gridTableParser struct {
+}
+
+var defaultGridTableParser = &gridTableParser{}
+
+// NewGridTableParser returns a new BlockParser that parses Pandoc style grid
+// tables like
+//
+//	+-------+----------+
+//	| Fruit | Features |
+//	+=======+==========+
+//	| Apple | - red    |
+//	|       | - sweet  |
+//	+-------+----------+
+//
+// Cells can contain blocks like lists and code blocks.
+func NewGridTableParser() parser.BlockParser {
+	return defaultGridTableParser
+}
+
+func (b *gridTableParser) Trigger() []byte {
+	return []byte{'+'}
+}
+
+func (b *gridTableParser) Open(parent gast.Node, reader text.Reader, pc parser.Context) (gast.Node, parser.State) {
+	line, segment := reader.PeekLine()
+	pos := pc.BlockOffset()
+	if pos < 0 || line[pos] != '+' {
+		return nil, parser.NoChildren
+	}
+	first := newGridTableLine(reader.Source(), segment)
+	var columns []int
+	for col, r := range first.runes {
+		if r == '+' {
+			columns = append(columns, col)
+		}
+	}
+	if len(columns) < 2 || !isGridTableBorder(first, columns, "-:") {
+		return nil, parser.NoChildren
+	}
+	lines := peekGridTableLines(reader, func(l *gridTableLine) bool {
+		return isGridTableRow(l, columns) || isGridTableBorder(l, columns, "-=:")
+	})
+	if isGridTableRow(lines[len(lines)-1], columns) {
+		// the table is not closed.
+		return nil, parser.NoChildren
+	}
+
+	builder := newGridTableBuilder(reader.Source())
+	alignments := gridTableAlignments(first, columns)
+	var rows [][]*gridTableLine
+	headerRows := 0
+	var row []*gridTableLine
+	for _, l := range lines[1:] {
+		if isGridTableRow(l, columns) {
+			row = append(row, l)
+			continue
+		}
+		if len(row) == 0 {
+			return nil, parser.NoChildren
+		}
+		rows = append(rows, row)
+		row = nil
+		if isGridTableBorder(l, columns, "=:") {
+			if len(rows) != 1 {
+				return nil, parser.NoChildren
+			}
+			headerRows = 1
+			alignments = gridTableAlignments(l, columns)
+		}
+	}
+	if len(rows) == 0 {
+		return nil, parser.NoChildren
+	}
+
+	node := ast.NewTable()
+	node.Alignments = alignments
+	for i, lines := range rows {
+		r := ast.NewTableRow(alignments)
+		for j, alignment := range alignments {
+			r.AppendChild(r, builder.newCell(lines, columns[j]+1, columns[j+1], alignment))
+		}
+		if i < headerRows {
+			node.AppendChild(node, ast.NewTableHeader(r))
+		} else {
+			node.AppendChild(node, r)
+		}
+	}
+	builder.finish(pc)
+	getGridTableState(pc).remaining = len(lines) - 1
+	reader.Advance(segment.Len() - 1)
+	return node, parser.NoChildren
+}
+
+func (b *gridTableParser) Continue(node gast.Node, reader text.Reader, pc parser.Context) parser.State {
+	return continueGridTable(reader, pc)
+}
+
+func (b *gridTableParser) Close(node gast.Node, reader text.Reader, pc parser.Context) {
+	getGridTableState(pc).remaining = 0
+}
+
+func (b *gridTableParser) CanInterruptParagraph() bool {
+	return false
+}
+
+func (b *gridTableParser) CanAcceptIndentedLine() bool {
+	return false
+}
+
+// continueGridTable consumes lines that were read while opening tables.
+func continueGridTable(reader text.Reader, pc parser.Context) parser.State {
+	state := getGridTableState(pc)
+	if state.remaining == 0 {
+		return parser.Close
+	}
+	state.remaining--
+	_, segment := reader.PeekLine()
+	reader.Advance(segment.Len() - 1)
+	return parser.Continue | parser.NoChildren
+}
+
+// multilineTableColumns returns columns of dashes like '---- -----' in the
+// given line after the given column.
+func multilineTableColumns(l *gridTableLine, from int) [][2]int {
+	var columns [][2]int
+	start := -1
+	for col := from; col < len(l.runes); col++ {
+		r := l.runes[col]
+		switch {
+		case r == '-':
+			if start < 0 {
+				start = col
+			}
+		case r == ' ' || r == '\t' || r == '\r':
+			if start > -1 {
+				columns = append(columns, [2]int{start, col})
+				start = -1
+			}
+		default:
+			return nil
+		}
+	}
+	if start > -1 {
+		columns = append(columns, [2]int{start, len(l.runes)})
+	}
+	return columns
+}
+
+// multilineTableAlignments returns alignments of columns determined by
+// positions of texts in the given lines relative to the dashes.
+func multilineTableAlignments(lines []*gridTableLine, columns [][2]int) []ast.Alignment {
+	alignments := make([]ast.Alignment, len(columns))
+	for i, column := range columns {
+		textStart, textStop, found := -1, -1, false
+		for _, l := range lines {
+			stop := len(l.runes)
+			if i < len(columns)-1 {
+				stop = columns[i+1][0] - 1
+			}
+			s, e, ok := l.textRange(column[0], stop)
+			if !ok {
+				continue
+			}
+			if !found || s < textStart {
+				textStart = s
+			}
+			if !found || e > textStop {
+				textStop = e
+			}
+			found = true
+		}
+		if !found {
+			continue
+		}
+		left := textStart == column[0]
+		right := textStop == column[1]
+		switch {
+		case left && right:
+			alignments[i] = ast.AlignNone
+		case left:
+			alignments[i] = ast.AlignLeft
+		case right:
+			alignments[i] = ast.AlignRight
+		default:
+			alignments[i] = ast.AlignCenter
+		}
+	}
+	return alignments
+}
+
+type multilineTableParser struct {
+}
+
+var defaultMultilineTableParser = &multilineTableParser{}
+
+// NewMultilineTableParser returns a new BlockParser that parses Pandoc style
+// multiline tables like
+//
+//	------------------------
+//	 Fruit   Features
+//	-------- ---------------
+//	 Apple   Red and sweet.
+//	         Grows on trees.
+//
+//	 Lemon   Sour.
+//	------------------------
+//
+// Rows are separated by blank lines. Tables without headers start with
+// dashes of columns instead of a row of dashes.
+func NewMultilineTableParser() parser.BlockParser {
+	return defaultMultilineTableParser
+}
+
+func (b *multilineTableParser) Trigger() []byte {
+	return []byte{'-'}
+}
+
+func (b *multilineTableParser) Open(parent gast.Node, reader text.Reader, pc parser.Context) (gast.Node, parser.State) {
+	line, segment := reader.PeekLine()
+	pos := pc.BlockOffset()
+	if pos < 0 || line[pos] != '-' {
+		return nil, parser.NoChildren
+	}
+	first := newGridTableLine(reader.Source(), segment)
+	// columns before the table are container blocks like blockquotes.
+	from := first.column(segment.Start + pos)
+	firstColumns := multilineTableColumns(first, from)
+	if len(firstColumns) == 0 {
+		return nil, parser.NoChildren
+	}
+	for _, column := range firstColumns {
+		// avoids thematic breaks like '- - -'
+		if column[1]-column[0] < 3 {
+			return nil, parser.NoChildren
+		}
+	}
+	// a table with a header has 3 rows of dashes, and a table without
+	// headers has 2 rows of dashes.
+	dashes, maxDashes := 0, 2
+	if len(firstColumns) == 1 {
+		maxDashes = 3
+	}
+	blank := false
+	lines := peekGridTableLines(reader, func(l *gridTableLine) bool {
+		if dashes == maxDashes {
+			return false
+		}
+		if multilineTableColumns(l, from) != nil {
+			dashes++
+			blank = false
+			return true
+		}
+		if l.isBlankFrom(from) {
+			if blank || (maxDashes == 3 && dashes == 1) {
+				// headers do not have blank lines.
+				return false
+			}
+			blank = true
+		} else {
+			blank = false
+		}
+		return true
+	})
+
+	var header []*gridTableLine
+	var columns [][2]int
+	i := 1
+	if len(firstColumns) == 1 {
+		for ; i < len(lines) && multilineTableColumns(lines[i], from) == nil; i++ {
+			if lines[i].isBlankFrom(from) {
+				return nil, parser.NoChildren
+			}
+			header = append(header, lines[i])
+		}
+		if len(header) == 0 || i == len(lines) {
+			return nil, parser.NoChildren
+		}
+		columns = multilineTableColumns(lines[i], from)
+		i++
+	} else {
+		columns = firstColumns
+		if len(lines) < 2 || lines[1].isBlankFrom(from) {
+			return nil, parser.NoChildren
+		}
+	}
+	if len(columns) < 2 {
+		return nil, parser.NoChildren
+	}
+
+	var rows [][]*gridTableLine
+	var row []*gridTableLine
+	closed := false
+	for ; i < len(lines); i++ {
+		l := lines[i]
+		if multilineTableColumns(l, from) != nil {
+			closed = true
+			break
+		}
+		if l.isBlankFrom(from) {
+			if len(row) != 0 {
+				rows = append(rows, row)
+				row = nil
+			}
+			continue
+		}
+		row = append(row, l)
+	}
+	if len(row) != 0 {
+		rows = append(rows, row)
+	}
+	if !closed || len(rows) == 0 {
+		return nil, parser.NoChildren
+	}
+
+	var alignments []ast.Alignment
+	if header != nil {
+		alignments = multilineTableAlignments(header, columns)
+	} else {
+		alignments = multilineTableAlignments(rows[0], columns)
+	}
+	builder := newGridTableBuilder(reader.Source())
+	newRow := func(lines []*gridTableLine) *ast.TableRow {
+		r := ast.NewTableRow(alignments)
+		for j, column := range columns {
+			stop := -1
+			if j < len(columns)-1 {
+				stop = columns[j+1][0] - 1
+			}
+			r.AppendChild(r, builder.newCell(lines, column[0], stop, alignments[j]))
+		}
+		return r
+	}
+	node := ast.NewTable()
+	node.Alignments = alignments
+	if header != nil {
+		node.AppendChild(node, ast.NewTableHeader(newRow(header)))
+	}
+	for _, r := range rows {
+		node.AppendChild(node, newRow(r))
+	}
+	builder.finish(pc)
+	getGridTableState(pc).remaining = i
+	reader.Advance(segment.Len() - 1)
+	return node, parser.NoChildren
+}
+
+func (b *multilineTableParser) Continue(node gast.Node, reader text.Reader, pc parser.Context) parser.State {
+	return continueGridTable(reader, pc)
+}
+
+func (b *multilineTableParser) Close(node gast.Node, reader text.Reader, pc parser.Context) {
+	getGridTableState(pc).remaining = 0
+}
+
+func (b *multilineTableParser) CanInterruptParagraph() bool {
+	return false
+}
+
+func (b *multilineTableParser) CanAcceptIndentedLine() bool {
+	return false
+}
+
+type gridTableCellTransformer struct {
+	parser parser.Parser
+}
+
+// NewGridTableCellTransformer returns a new ASTTransformer that parses
+// contents of cells of grid tables and multiline tables as blocks with the
+// given parser.
+func NewGridTableCellTransformer(p parser.Parser) parser.ASTTransformer {
+	return &gridTableCellTransformer{
+		parser: p,
+	}
+}
+
+func (t *gridTableCellTransformer) Transform(node *gast.Document, reader text.Reader, pc parser.Context) {
+	state := getGridTableState(pc)
+	cells := state.cells
+	if len(cells) == 0 {
+		return
+	}
+	state.cells = nil
+	source := reader.Source()
+	for _, cell := range cells {
+		ctx := parser.NewContext()
+		for _, ref := range pc.References() {
+			ctx.AddReference(ref)
+		}
+		doc := t.parser.Parse(text.NewBlockReader(cell.source, cell.lines), parser.WithContext(ctx))
+		restoreGridTableNewlines(doc, source, cell.source)
+		if c := doc.FirstChild(); c != nil && c == doc.LastChild() && c.Kind() == gast.KindParagraph {
+			// a cell that consists of a paragraph is rendered without
+			// paragraph tags like tight lists.
+			textBlock := gast.NewTextBlock()
+			textBlock.SetLines(c.Lines())
+			moveChildren(textBlock, c.FirstChild())
+			doc.ReplaceChild(doc, c, textBlock)
+		}
+		moveChildren(cell.node, doc.FirstChild())
+	}
+}
+
+// restoreGridTableNewlines replaces newlines at the end of cell lines with
+// newlines in the source, so that contents like code blocks are rendered
+// with the source.
+func restoreGridTableNewlines(node gast.Node, source, cellSource []byte) {
+	_ = gast.Walk(node, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+		if !entering {
+			return gast.WalkContinue, nil
+		}
+		if n.Type() == gast.TypeBlock {
+			restoreGridTableSegments(n.Lines(), source, cellSource)
+		} else if raw, ok := n.(*gast.RawHTML); ok {
+			restoreGridTableSegments(raw.Segments, source, cellSource)
+		}
+		return gast.WalkContinue, nil
+	})
+}
+
+func restoreGridTableSegments(segments *text.Segments, source, cellSource []byte) {
+	var restored []text.Segment
+	changed := false
+	for i := 0; i < segments.Len(); i++ {
+		segment := segments.At(i)
+		last := segment.Stop - 1
+		if segment.IsEmpty() || cellSource[last] != '\n' || (last < len(source) && source[last] == '\n') {
+			restored = append(restored, segment)
+			continue
+		}
+		newline := -1
+		if last < len(source) {
+			newline = bytes.IndexByte(source[last:], '\n')
+		}
+		if newline < 0 {
+			newline = bytes.LastIndexByte(source, '\n')
+		} else {
+			newline += last
+		}
+		if newline < 0 {
+			restored = append(restored, segment)
+			continue
+		}
+		changed = true
+		if segment.Len() > 1 {
+			restored = append(restored, segment.WithStop(last))
+		}
+		restored = append(restored, text.NewSegment(newline, newline+1))
+	}
+	if changed {
+		segments.Clear()
+		segments.AppendAll(restored)
+	}
+}
+
+type gridTable struct {
+}
+
+// GridTable is an extension that allow you to use Pandoc style grid tables
+// and multiline tables. Cells of these tables are rendered as cells of
+// GFM tables.
+var GridTable = &gridTable{}
+
+func (e *gridTable) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithBlockParsers(
+			util.Prioritized(NewGridTableParser(), 150),
+			// multiline tables must be parsed before thematic breaks
+			util.Prioritized(NewMultilineTableParser(), 150),
+		),
+		parser.WithASTTransformers(
+			util.Prioritized(NewGridTableCellTransformer(m.Parser()), 100),
+		),
+	)
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(NewTableHTMLRenderer(), 500),
+	))
+}
@@ -0,0 +1,16 @@
+package extension
+
+import (
+	"testing"
+
+	"github.com/yuin/goldmark"
+)
+
+func TestGridTable(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			GridTable,
+		),
+	)
+	goldmark.DoTestCaseFile(markdown, "_test/grid_table.txt", t)
+}
@@ -0,0 +1,227 @@
+package extension
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// A HashtagResolver interface resolves destinations of hashtags.
+type HashtagResolver interface {
+	// Resolve returns a destination URL of the given tag like 'tag'.
+	// An empty destination means the tag is not linked.
+	Resolve(tag string) string
+}
+
+// HashtagResolverFunc is a function that implements HashtagResolver.
+type HashtagResolverFunc func(tag string) string
+
+// Resolve implements HashtagResolver.Resolve.
+func (f HashtagResolverFunc) Resolve(tag string) string {
+	return f(tag)
+}
+
+// A HashtagConfig struct is a data structure that holds configuration of
+// the Hashtag extension.
+type HashtagConfig struct {
+	// Resolver resolves destinations of hashtags. Hashtags are not linked
+	// if Resolver is nil.
+	Resolver HashtagResolver
+}
+
+// NewHashtagConfig returns a new HashtagConfig with defaults.
+func NewHashtagConfig() HashtagConfig {
+	return HashtagConfig{}
+}
+
+// A HashtagOption interface sets options for the Hashtag extension.
+type HashtagOption interface {
+	SetHashtagOption(*HashtagConfig)
+}
+
+type withHashtagResolver struct {
+	value HashtagResolver
+}
+
+func (o *withHashtagResolver) SetHashtagOption(c *HashtagConfig) {
+	c.Resolver = o.value
+}
+
+// WithHashtagResolver is a functional option that sets a resolver that
+// resolves destinations of hashtags.
+func WithHashtagResolver(resolver HashtagResolver) HashtagOption {
+	return &withHashtagResolver{resolver}
+}
+
+var hashtagsKey = parser.NewContextKey()
+
+type hashtagList struct {
+	tags  []string
+	found map[string]bool
+}
+
+// Hashtags returns tags without '#' found in the document in order of
+// first appearance.
+func Hashtags(pc parser.Context) []string {
+	if v, ok := pc.Get(hashtagsKey).(*hashtagList); ok {
+		return v.tags
+	}
+	return nil
+}
+
+type hashtagParser struct {
+	HashtagConfig
+}
+
+// NewHashtagParser returns a new parser.InlineParser that parses hashtags
+// like '#tag' and '#日本語'. Tags consist of letters, digits, '_', '-' and
+// '/' like '#project/goldmark', and must contain at least one
+// non-numeric character.
+func NewHashtagParser(opts ...HashtagOption) parser.InlineParser {
+	p := &hashtagParser{
+		HashtagConfig: NewHashtagConfig(),
+	}
+	for _, o := range opts {
+		o.SetHashtagOption(&p.HashtagConfig)
+	}
+	return p
+}
+
+func (s *hashtagParser) Trigger() []byte {
+	return []byte{'#'}
+}
+
+func isHashtagRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.Is(unicode.M, r) ||
+		r == '_' || r == '-' || r == '/'
+}
+
+// scanHashtag returns a length of a tag at the beginning of the given
+// bytes.
+func scanHashtag(b []byte) int {
+	length := 0
+	numeric := true
+	for i := 0; i < len(b); {
+		r, size := utf8.DecodeRune(b[i:])
+		if !isHashtagRune(r) {
+			break
+		}
+		i += size
+		if r != '-' && r != '/' {
+			length = i
+			numeric = numeric && unicode.IsDigit(r)
+		}
+	}
+	if numeric {
+		return 0
+	}
+	return length
+}
+
+func (s *hashtagParser) Parse(parent gast.Node, block text.Reader, pc parser.Context) gast.Node {
+	// tags must not follow words like 'C#' and URLs like '/#fragment'
+	if c := block.PrecendingCharacter(); isHashtagRune(c) || c == '&' || c == '#' || c == ':' {
+		return nil
+	}
+	line, segment := block.PeekLine()
+	length := scanHashtag(line[1:])
+	if length == 0 {
+		return nil
+	}
+	tag := line[1 : 1+length]
+	n := ast.NewHashtag(tag)
+	if s.Resolver != nil {
+		n.Destination = []byte(s.Resolver.Resolve(string(tag)))
+	}
+	list := parser.ContextState(pc, hashtagsKey, func() interface{} {
+		return &hashtagList{found: map[string]bool{}}
+	}).(*hashtagList)
+	if !list.found[string(tag)] {
+		list.found[string(tag)] = true
+		list.tags = append(list.tags, string(tag))
+	}
+	n.AppendChild(n, gast.NewTextSegment(text.NewSegment(segment.Start, segment.Start+1+length)))
+	block.Advance(1 + length)
+	return n
+}
+
+// HashtagHTMLRenderer is a renderer.NodeRenderer implementation that
+// renders Hashtag nodes.
+type HashtagHTMLRenderer struct {
+	html.Config
+}
+
+// NewHashtagHTMLRenderer returns a new HashtagHTMLRenderer.
+func NewHashtagHTMLRenderer(opts ...html.Option) renderer.NodeRenderer {
+	r := &HashtagHTMLRenderer{
+		Config: html.NewConfig(),
+	}
+	for _, opt := range opts {
+		opt.SetHTMLOption(&r.Config)
+	}
+	return r
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *HashtagHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindHashtag, r.renderHashtag)
+}
+
+func (r *HashtagHTMLRenderer) renderHashtag(w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	n := node.(*ast.Hashtag)
+	if !entering {
+		if len(n.Destination) != 0 {
+			_, _ = w.WriteString("</a>")
+		} else {
+			_, _ = w.WriteString("</span>")
+		}
+		return gast.WalkContinue, nil
+	}
+	if len(n.Destination) != 0 {
+		_, _ = w.WriteString(`<a href="`)
+		if r.Unsafe || !html.IsDangerousURL(n.Destination) {
+			_, _ = w.Write(util.EscapeHTML(util.URLEscape(n.Destination, false)))
+		}
+		_, _ = w.WriteString(`" class="hashtag"`)
+	} else {
+		_, _ = w.WriteString(`<span class="hashtag"`)
+	}
+	if n.Attributes() != nil {
+		r.RenderAttributes(w, n)
+	}
+	_ = w.WriteByte('>')
+	return gast.WalkContinue, nil
+}
+
+type hashtag struct {
+	options []HashtagOption
+}
+
+// Hashtag is an extension that allows you to use hashtags like '#tag'.
+// Tags found in a document are available via Hashtags.
+var Hashtag = &hashtag{}
+
+// NewHashtag returns a new Extender that resolves hashtags with the given
+// options.
+func NewHashtag(opts ...HashtagOption) goldmark.Extender {
+	return &hashtag{
+		options: opts,
+	}
+}
+
+func (e *hashtag) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(parser.WithInlineParsers(
+		util.Prioritized(NewHashtagParser(e.options...), 500),
+	))
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(NewHashtagHTMLRenderer(), 500),
+	))
+}
@@ -0,0 +1,38 @@
+package extension
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+)
+
+func TestHashtag(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			NewHashtag(
+				WithHashtagResolver(HashtagResolverFunc(func(tag string) string {
+					if strings.HasPrefix(tag, "private") {
+						return ""
+					}
+					return "/tags/" + tag
+				})),
+			),
+		),
+	)
+	goldmark.DoTestCaseFile(markdown, "_test/hashtag.txt", t)
+}
+
+func TestHashtags(t *testing.T) {
+	markdown := goldmark.New(goldmark.WithExtensions(Hashtag))
+	source := []byte("# Title\n\n#go and #日本語, #go again\n\n- #project/goldmark\n")
+	pc := parser.NewContext()
+	markdown.Parser().Parse(text.NewReader(source), parser.WithContext(pc))
+	expected := []string{"go", "日本語", "project/goldmark"}
+	if tags := Hashtags(pc); !reflect.DeepEqual(tags, expected) {
+		t.Errorf("unexpected tags: %v", tags)
+	}
+}
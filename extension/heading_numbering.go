@@ -0,0 +1,192 @@
+package extension
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// A HeadingNumberFormat function returns a text prepended to a heading from
+// the given hierarchical numbers like [1, 2] for the second subsection of
+// the first section.
+type HeadingNumberFormat func(numbers []int) string
+
+// DefaultHeadingNumberFormat is a default HeadingNumberFormat that formats
+// numbers like '1.', '1.1' and '1.1.1'.
+func DefaultHeadingNumberFormat(numbers []int) string {
+	if len(numbers) == 1 {
+		return strconv.Itoa(numbers[0]) + "."
+	}
+	return joinHeadingNumbers(numbers)
+}
+
+func joinHeadingNumbers(numbers []int) string {
+	var b strings.Builder
+	for i, number := range numbers {
+		if i != 0 {
+			b.WriteByte('.')
+		}
+		b.WriteString(strconv.Itoa(number))
+	}
+	return b.String()
+}
+
+// HeadingNumberAttributeName is a name of the attribute that holds a number
+// of a heading like '1.1'.
+var HeadingNumberAttributeName = []byte("data-number")
+
+// HeadingNumber returns a number of the given heading like '1.1' and true if
+// the heading is numbered by the HeadingNumbering extension, otherwise nil
+// and false.
+func HeadingNumber(n gast.Node) ([]byte, bool) {
+	return n.Attribute(HeadingNumberAttributeName)
+}
+
+// A HeadingNumberingConfig struct is a data structure that holds
+// configuration of the HeadingNumbering extension.
+type HeadingNumberingConfig struct {
+	// StartLevel is a level of headings numbered as top level sections.
+	// Headings above this level are not numbered.
+	StartLevel int
+
+	// Format formats numbers of headings.
+	Format HeadingNumberFormat
+}
+
+// NewHeadingNumberingConfig returns a new HeadingNumberingConfig with
+// defaults.
+func NewHeadingNumberingConfig() HeadingNumberingConfig {
+	return HeadingNumberingConfig{
+		StartLevel: 1,
+		Format:     DefaultHeadingNumberFormat,
+	}
+}
+
+// A HeadingNumberingOption interface sets options for the HeadingNumbering
+// extension.
+type HeadingNumberingOption interface {
+	SetHeadingNumberingOption(*HeadingNumberingConfig)
+}
+
+type withHeadingNumberingStartLevel struct {
+	value int
+}
+
+func (o *withHeadingNumberingStartLevel) SetHeadingNumberingOption(c *HeadingNumberingConfig) {
+	c.StartLevel = o.value
+}
+
+// WithHeadingNumberingStartLevel is a functional option that sets a level of
+// headings numbered as top level sections. For example, 2 means level 1
+// headings are titles and not numbered.
+func WithHeadingNumberingStartLevel(level int) HeadingNumberingOption {
+	return &withHeadingNumberingStartLevel{level}
+}
+
+type withHeadingNumberFormat struct {
+	value HeadingNumberFormat
+}
+
+func (o *withHeadingNumberFormat) SetHeadingNumberingOption(c *HeadingNumberingConfig) {
+	c.Format = o.value
+}
+
+// WithHeadingNumberFormat is a functional option that sets a function that
+// formats numbers of headings.
+func WithHeadingNumberFormat(format HeadingNumberFormat) HeadingNumberingOption {
+	return &withHeadingNumberFormat{format}
+}
+
+var unnumberedClass = []byte("unnumbered")
+
+type headingNumberingASTTransformer struct {
+	HeadingNumberingConfig
+}
+
+// NewHeadingNumberingASTTransformer returns a new parser.ASTTransformer that
+// prepends hierarchical numbers to top level headings.
+// Headings that have an 'unnumbered' class like '# Preface {.unnumbered}'
+// are not numbered.
+func NewHeadingNumberingASTTransformer(opts ...HeadingNumberingOption) parser.ASTTransformer {
+	a := &headingNumberingASTTransformer{
+		HeadingNumberingConfig: NewHeadingNumberingConfig(),
+	}
+	for _, o := range opts {
+		o.SetHeadingNumberingOption(&a.HeadingNumberingConfig)
+	}
+	if a.StartLevel < 1 {
+		a.StartLevel = 1
+	} else if a.StartLevel > 6 {
+		a.StartLevel = 6
+	}
+	if a.Format == nil {
+		a.Format = DefaultHeadingNumberFormat
+	}
+	return a
+}
+
+func isUnnumberedHeading(n gast.Node) bool {
+	class, ok := n.AttributeString("class")
+	if !ok {
+		return false
+	}
+	for _, c := range bytes.Fields(class) {
+		if bytes.Equal(c, unnumberedClass) {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *headingNumberingASTTransformer) Transform(node *gast.Document, reader text.Reader, pc parser.Context) {
+	counters := make([]int, 6-a.StartLevel+1)
+	for c := node.FirstChild(); c != nil; c = c.NextSibling() {
+		heading, ok := c.(*gast.Heading)
+		if !ok || heading.Level < a.StartLevel || isUnnumberedHeading(heading) {
+			continue
+		}
+		depth := heading.Level - a.StartLevel
+		counters[depth]++
+		for i := depth + 1; i < len(counters); i++ {
+			counters[i] = 0
+		}
+		numbers := counters[:depth+1]
+		heading.SetAttribute(HeadingNumberAttributeName, []byte(joinHeadingNumbers(numbers)))
+		label := a.Format(numbers)
+		if first := heading.FirstChild(); first != nil {
+			heading.InsertBefore(heading, first, gast.NewString([]byte(label+" ")))
+		} else {
+			heading.AppendChild(heading, gast.NewString([]byte(label)))
+		}
+	}
+}
+
+type headingNumbering struct {
+	options []HeadingNumberingOption
+}
+
+// HeadingNumbering is an extension that prepends hierarchical numbers like
+// '1.' and '1.1' to headings.
+var HeadingNumbering = &headingNumbering{}
+
+// NewHeadingNumbering returns a new Extender that numbers headings with the
+// given options.
+func NewHeadingNumbering(opts ...HeadingNumberingOption) goldmark.Extender {
+	return &headingNumbering{
+		options: opts,
+	}
+}
+
+func (e *headingNumbering) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithASTTransformers(
+			util.Prioritized(NewHeadingNumberingASTTransformer(e.options...), 500),
+		),
+	)
+}
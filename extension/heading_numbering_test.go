@@ -0,0 +1,55 @@
+package extension
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/parser"
+)
+
+func TestHeadingNumbering(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithParserOptions(
+			parser.WithAttribute(),
+		),
+		goldmark.WithExtensions(
+			HeadingNumbering,
+		),
+	)
+	goldmark.DoTestCaseFile(markdown, "_test/heading_numbering.txt", t)
+}
+
+func TestHeadingNumberingOptions(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			NewHeadingNumbering(
+				WithHeadingNumberingStartLevel(2),
+				WithHeadingNumberFormat(func(numbers []int) string {
+					s := make([]string, len(numbers))
+					for i, number := range numbers {
+						s[i] = fmt.Sprint(number)
+					}
+					return "§" + strings.Join(s, "-")
+				}),
+			),
+		),
+	)
+	goldmark.DoTestCases(markdown, []goldmark.MarkdownTestCase{
+		{
+			No: 1,
+			Markdown: `# Title
+
+## One
+
+### Sub
+
+## Two`,
+			Expected: `<h1>Title</h1>
+<h2 data-number="1">§1 One</h2>
+<h3 data-number="1.1">§1-1 Sub</h3>
+<h2 data-number="2">§2 Two</h2>`,
+		},
+	}, t)
+}
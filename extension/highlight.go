@@ -0,0 +1,246 @@
+package extension
+
+import (
+	"bytes"
+
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// A HighlightConfig struct is a data structure that holds configuration of the
+// Highlight extension.
+type HighlightConfig struct {
+	// Tag is an HTML tag name for marked texts.
+	Tag []byte
+
+	// Class is a class attribute for marked texts.
+	Class []byte
+
+	// Attribute is true if attribute lists like '==text=={.class key=value}'
+	// are enabled.
+	Attribute bool
+}
+
+// A HighlightOption interface sets options for the Highlight extension.
+type HighlightOption interface {
+	SetHighlightOption(*HighlightConfig)
+}
+
+type withHighlightTag struct {
+	value []byte
+}
+
+func (o *withHighlightTag) SetHighlightOption(c *HighlightConfig) {
+	c.Tag = o.value
+}
+
+// WithHighlightTag is a functional option that sets an HTML tag name for
+// marked texts.
+func WithHighlightTag(tag string) HighlightOption {
+	return &withHighlightTag{[]byte(tag)}
+}
+
+type withHighlightClass struct {
+	value []byte
+}
+
+func (o *withHighlightClass) SetHighlightOption(c *HighlightConfig) {
+	c.Class = o.value
+}
+
+// WithHighlightClass is a functional option that sets a class attribute for
+// marked texts.
+func WithHighlightClass(class string) HighlightOption {
+	return &withHighlightClass{[]byte(class)}
+}
+
+type withHighlightAttribute struct {
+}
+
+func (o *withHighlightAttribute) SetHighlightOption(c *HighlightConfig) {
+	c.Attribute = true
+}
+
+// WithHighlightAttribute is a functional option that enables attribute lists
+// like '==text=={.class key=value}' for marked texts.
+func WithHighlightAttribute() HighlightOption {
+	return &withHighlightAttribute{}
+}
+
+type highlightDelimiterProcessor struct {
+}
+
+func (p *highlightDelimiterProcessor) IsDelimiter(b byte) bool {
+	return b == '='
+}
+
+func (p *highlightDelimiterProcessor) CanOpenCloser(opener, closer *parser.Delimiter) bool {
+	// marked texts need pairs of '=' as in markdown-it, so remaining single
+	// '=' like '===text===' are not delimiters.
+	return opener.Char == closer.Char && opener.Length >= 2 && closer.Length >= 2
+}
+
+func (p *highlightDelimiterProcessor) OnMatch(consumes int) gast.Node {
+	return ast.NewHighlight()
+}
+
+var defaultHighlightDelimiterProcessor = &highlightDelimiterProcessor{}
+
+type highlightParser struct {
+}
+
+var defaultHighlightParser = &highlightParser{}
+
+// NewHighlightParser return a new InlineParser that parses
+// marked texts like '==text=='.
+func NewHighlightParser() parser.InlineParser {
+	return defaultHighlightParser
+}
+
+func (s *highlightParser) Trigger() []byte {
+	return []byte{'='}
+}
+
+func (s *highlightParser) Parse(parent gast.Node, block text.Reader, pc parser.Context) gast.Node {
+	before := block.PrecendingCharacter()
+	line, segment := block.PeekLine()
+	node := parser.ScanDelimiter(line, before, 2, defaultHighlightDelimiterProcessor)
+	if node == nil {
+		return nil
+	}
+	node.Segment = segment.WithStop(segment.Start + node.OriginalLength)
+	block.Advance(node.OriginalLength)
+	pc.PushDelimiter(node)
+	return node
+}
+
+func (s *highlightParser) CloseBlock(parent gast.Node, pc parser.Context) {
+	// nothing to do
+}
+
+type highlightAttributeTransformer struct {
+}
+
+var defaultHighlightAttributeTransformer = &highlightAttributeTransformer{}
+
+// NewHighlightAttributeTransformer returns a new ASTTransformer that
+// sets attribute lists following marked texts like '==text=={.class key=value}'
+// to Highlight nodes.
+func NewHighlightAttributeTransformer() parser.ASTTransformer {
+	return defaultHighlightAttributeTransformer
+}
+
+func (a *highlightAttributeTransformer) Transform(node *gast.Document, reader text.Reader, pc parser.Context) {
+	source := reader.Source()
+	var highlights []gast.Node
+	_ = gast.Walk(node, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+		if entering && n.Kind() == ast.KindHighlight {
+			highlights = append(highlights, n)
+		}
+		return gast.WalkContinue, nil
+	})
+	for _, n := range highlights {
+		parseTrailingAttributes(n, source)
+	}
+}
+
+// HighlightHTMLRenderer is a renderer.NodeRenderer implementation that
+// renders Highlight nodes.
+type HighlightHTMLRenderer struct {
+	html.Config
+	HighlightConfig
+}
+
+// NewHighlightHTMLRenderer returns a new HighlightHTMLRenderer.
+func NewHighlightHTMLRenderer(opts ...HighlightOption) renderer.NodeRenderer {
+	r := &HighlightHTMLRenderer{
+		Config: html.NewConfig(),
+		HighlightConfig: HighlightConfig{
+			Tag: []byte("mark"),
+		},
+	}
+	for _, opt := range opts {
+		opt.SetHighlightOption(&r.HighlightConfig)
+	}
+	return r
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *HighlightHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindHighlight, r.renderHighlight)
+}
+
+func (r *HighlightHTMLRenderer) renderHighlight(w util.BufWriter, source []byte, n gast.Node, entering bool) (gast.WalkStatus, error) {
+	if entering {
+		_ = w.WriteByte('<')
+		_, _ = w.Write(r.Tag)
+		class := r.Class
+		if v, ok := n.AttributeString("class"); ok {
+			if len(class) != 0 {
+				class = append(append(append([]byte{}, class...), ' '), v...)
+			} else {
+				class = v
+			}
+		}
+		if len(class) != 0 {
+			_, _ = w.WriteString(` class="`)
+			_, _ = w.Write(util.EscapeHTML(class))
+			_ = w.WriteByte('"')
+		}
+		for _, attr := range r.Attributes(w, n) {
+			if bytes.Equal(attr.Name, attrNameClass) || !r.AllowsAttribute(attr.Name) {
+				continue
+			}
+			_ = w.WriteByte(' ')
+			_, _ = w.Write(attr.Name)
+			_, _ = w.WriteString(`="`)
+			_, _ = w.Write(util.EscapeHTML(attr.Value))
+			_ = w.WriteByte('"')
+		}
+		_ = w.WriteByte('>')
+	} else {
+		_, _ = w.WriteString("</")
+		_, _ = w.Write(r.Tag)
+		_ = w.WriteByte('>')
+	}
+	return gast.WalkContinue, nil
+}
+
+type highlight struct {
+	options []HighlightOption
+}
+
+// Highlight is an extension that allow you to use marked texts like '==text==' .
+var Highlight = &highlight{}
+
+// NewHighlight returns a new Extender that allow you to use marked texts
+// with the given options.
+func NewHighlight(opts ...HighlightOption) goldmark.Extender {
+	return &highlight{
+		options: opts,
+	}
+}
+
+func (e *highlight) Extend(m goldmark.Markdown) {
+	config := HighlightConfig{}
+	for _, opt := range e.options {
+		opt.SetHighlightOption(&config)
+	}
+	m.Parser().AddOptions(parser.WithInlineParsers(
+		util.Prioritized(NewHighlightParser(), 500),
+	))
+	if config.Attribute {
+		m.Parser().AddOptions(parser.WithASTTransformers(
+			util.Prioritized(NewHighlightAttributeTransformer(), 500),
+		))
+	}
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(NewHighlightHTMLRenderer(e.options...), 500),
+	))
+}
@@ -0,0 +1,55 @@
+package extension
+
+import (
+	"testing"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/renderer/html"
+)
+
+func TestHighlight(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithRendererOptions(
+			html.WithUnsafe(),
+		),
+		goldmark.WithExtensions(
+			Highlight,
+		),
+	)
+	goldmark.DoTestCaseFile(markdown, "_test/highlight.txt", t)
+}
+
+func TestHighlightAttribute(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			NewHighlight(WithHighlightAttribute(), WithHighlightClass("hl")),
+		),
+	)
+	goldmark.DoTestCases(markdown, []goldmark.MarkdownTestCase{
+		{
+			No:       1,
+			Markdown: `==Hi=={.tag-red key=val} there`,
+			Expected: `<p><mark class="hl tag-red" key="val">Hi</mark> there</p>`,
+		},
+		{
+			No:       2,
+			Markdown: `==Hi== {.tag-red}`,
+			Expected: `<p><mark class="hl">Hi</mark> {.tag-red}</p>`,
+		},
+		{
+			No:       3,
+			Markdown: "==Hi=={#note .a .b data-x=\"1 & 2\"}\nnext",
+			Expected: "<p><mark class=\"hl a b\" data-x=\"1 &amp; 2\" id=\"note\">Hi</mark>\nnext</p>",
+		},
+		{
+			No:       4,
+			Markdown: `==Hi=={broken`,
+			Expected: `<p><mark class="hl">Hi</mark>{broken</p>`,
+		},
+		{
+			No:       5,
+			Markdown: "==a=={\n\n==a=={.x\nnext }",
+			Expected: "<p><mark class=\"hl\">a</mark>{</p>\n<p><mark class=\"hl\">a</mark>{.x\nnext }</p>",
+		},
+	}, t)
+}
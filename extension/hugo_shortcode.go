@@ -0,0 +1,466 @@
+package extension
+
+import (
+	"bytes"
+
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// A HugoShortcodeHandler interface expands Hugo shortcodes.
+type HugoShortcodeHandler interface {
+	// RenderHugoShortcode renders the given *ast.HugoShortcode or
+	// *ast.HugoShortcodeBlock node. For blocks that have Markdown inner
+	// contents, RenderHugoShortcode is called before and after their
+	// children are rendered. Raw inner contents of '{{< >}}' blocks are
+	// available as lines of the node.
+	// RenderHugoShortcode returns false if the shortcode should be passed
+	// through as is.
+	RenderHugoShortcode(w util.BufWriter, source []byte, node gast.Node, entering bool) (bool, error)
+}
+
+// HugoShortcodeHandlerFunc is a function that implements
+// HugoShortcodeHandler.
+type HugoShortcodeHandlerFunc func(w util.BufWriter, source []byte, node gast.Node, entering bool) (bool, error)
+
+// RenderHugoShortcode implements HugoShortcodeHandler.RenderHugoShortcode.
+func (f HugoShortcodeHandlerFunc) RenderHugoShortcode(w util.BufWriter, source []byte, node gast.Node, entering bool) (bool, error) {
+	return f(w, source, node, entering)
+}
+
+// A HugoShortcodeConfig struct is a data structure that holds configuration
+// of the HugoShortcode extension.
+type HugoShortcodeConfig struct {
+	// Handler expands shortcodes. Shortcodes are passed through if Handler
+	// is nil.
+	Handler HugoShortcodeHandler
+}
+
+// A HugoShortcodeOption interface sets options for the HugoShortcode
+// extension.
+type HugoShortcodeOption interface {
+	SetHugoShortcodeOption(*HugoShortcodeConfig)
+}
+
+type withHugoShortcodeHandler struct {
+	value HugoShortcodeHandler
+}
+
+func (o *withHugoShortcodeHandler) SetHugoShortcodeOption(c *HugoShortcodeConfig) {
+	c.Handler = o.value
+}
+
+// WithHugoShortcodeHandler is a functional option that sets a handler that
+// expands shortcodes.
+func WithHugoShortcodeHandler(handler HugoShortcodeHandler) HugoShortcodeOption {
+	return &withHugoShortcodeHandler{handler}
+}
+
+func isHugoShortcodeNameChar(c byte) bool {
+	return util.IsAlphaNumeric(c) || c == '_' || c == '-' || c == '/' || c == '.'
+}
+
+// parseHugoShortcodeValue parses a parameter value like '"a \"b\""',
+// '`raw`' and 'bare' at the beginning of the given bytes.
+func parseHugoShortcodeValue(b []byte) ([]byte, int, bool) {
+	if len(b) == 0 {
+		return nil, 0, false
+	}
+	switch b[0] {
+	case '`':
+		i := bytes.IndexByte(b[1:], '`')
+		if i < 0 {
+			return nil, 0, false
+		}
+		return b[1 : i+1], i + 2, true
+	case '"':
+		value := []byte{}
+		for i := 1; i < len(b); i++ {
+			c := b[i]
+			if c == '\\' && i+1 < len(b) && (b[i+1] == '"' || b[i+1] == '\\') {
+				value = append(value, b[i+1])
+				i++
+				continue
+			}
+			if c == '"' {
+				return value, i + 1, true
+			}
+			value = append(value, c)
+		}
+		return nil, 0, false
+	}
+	i := 0
+	for i < len(b) && !util.IsSpace(b[i]) {
+		if b[i] == '"' || b[i] == '`' {
+			return nil, 0, false
+		}
+		i++
+	}
+	return b[:i], i, true
+}
+
+// parseHugoShortcode parses a shortcode like '{{< name params >}}' at the
+// beginning of the given bytes, and returns the shortcode and its length.
+func parseHugoShortcode(b []byte) (*ast.HugoShortcodeCall, int) {
+	if len(b) < 7 || b[0] != '{' || b[1] != '{' {
+		return nil, 0
+	}
+	closer := byte('>')
+	switch b[2] {
+	case '<':
+	case '%':
+		closer = '%'
+	default:
+		return nil, 0
+	}
+	end := -1
+	var quote byte
+	for i := 3; i+2 < len(b); i++ {
+		c := b[i]
+		if c == '\n' {
+			break
+		}
+		if quote != 0 {
+			if c == '\\' && quote == '"' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+			continue
+		}
+		if c == '"' || c == '`' {
+			quote = c
+		} else if c == closer && b[i+1] == '}' && b[i+2] == '}' {
+			end = i
+			break
+		}
+	}
+	if end < 0 {
+		return nil, 0
+	}
+	call := &ast.HugoShortcodeCall{
+		IsMarkdown: closer == '%',
+	}
+	content := util.TrimRightSpace(util.TrimLeftSpace(b[3:end]))
+	if len(content) != 0 && content[0] == '/' {
+		call.IsClosing = true
+		content = util.TrimLeftSpace(content[1:])
+	} else if len(content) != 0 && content[len(content)-1] == '/' {
+		call.IsSelfClosing = true
+		content = util.TrimRightSpace(content[:len(content)-1])
+	}
+	i := 0
+	for i < len(content) && isHugoShortcodeNameChar(content[i]) {
+		i++
+	}
+	if i == 0 || (i < len(content) && !util.IsSpace(content[i])) {
+		return nil, 0
+	}
+	call.Name = content[:i]
+	rest := util.TrimLeftSpace(content[i:])
+	if call.IsClosing && len(rest) != 0 {
+		return nil, 0
+	}
+	for len(rest) != 0 {
+		var param ast.HugoShortcodeParam
+		k := 0
+		for k < len(rest) && (util.IsAlphaNumeric(rest[k]) || rest[k] == '_' || rest[k] == '-') {
+			k++
+		}
+		if k != 0 && k < len(rest) && rest[k] == '=' {
+			param.Name = rest[:k]
+			rest = rest[k+1:]
+		}
+		value, n, ok := parseHugoShortcodeValue(rest)
+		if !ok || (n < len(rest) && !util.IsSpace(rest[n])) {
+			return nil, 0
+		}
+		param.Value = value
+		call.Params = append(call.Params, param)
+		rest = util.TrimLeftSpace(rest[n:])
+	}
+	return call, end + 3
+}
+
+// parseHugoShortcodeLine returns a shortcode if the given line consists
+// solely of a shortcode. Leading spaces and blockquote markers are ignored.
+func parseHugoShortcodeLine(line []byte) *ast.HugoShortcodeCall {
+	i := 0
+	for i < len(line) && (util.IsSpace(line[i]) || line[i] == '>') {
+		i++
+	}
+	line = util.TrimRightSpace(line[i:])
+	call, length := parseHugoShortcode(line)
+	if call == nil || length != len(line) {
+		return nil
+	}
+	return call
+}
+
+// advanceHugoShortcodeLine advances the given reader to the end of the
+// current line.
+func advanceHugoShortcodeLine(reader text.Reader) {
+	line, segment := reader.PeekLine()
+	n := segment.Len()
+	if len(line) != 0 && line[len(line)-1] == '\n' {
+		n--
+	}
+	reader.Advance(n)
+}
+
+var hugoShortcodeRemainingKey = parser.NewContextKey()
+
+type hugoShortcodeBlockParser struct {
+}
+
+var defaultHugoShortcodeBlockParser = &hugoShortcodeBlockParser{}
+
+// NewHugoShortcodeBlockParser returns a new parser.BlockParser that parses
+// Hugo shortcodes written on their own lines like '{{% note %}}'.
+// Lines until a closing tag like '{{% /note %}}' are inner contents of the
+// shortcode.
+func NewHugoShortcodeBlockParser() parser.BlockParser {
+	return defaultHugoShortcodeBlockParser
+}
+
+func (b *hugoShortcodeBlockParser) Trigger() []byte {
+	return []byte{'{'}
+}
+
+// peekHugoShortcodeClosure returns a number of lines until a closing tag of
+// the given shortcode, or 0 if the shortcode is not closed.
+func peekHugoShortcodeClosure(reader text.Reader, call *ast.HugoShortcodeCall) int {
+	savedLine, savedPosition := reader.Position()
+	defer reader.SetPosition(savedLine, savedPosition)
+	depth := 0
+	for count := 1; ; count++ {
+		reader.AdvanceLine()
+		line, _ := reader.PeekLine()
+		if line == nil {
+			return 0
+		}
+		c := parseHugoShortcodeLine(line)
+		if c == nil || !bytes.Equal(c.Name, call.Name) || c.IsMarkdown != call.IsMarkdown {
+			continue
+		}
+		if c.IsClosing {
+			if depth == 0 {
+				return count
+			}
+			depth--
+		} else if !c.IsSelfClosing {
+			depth++
+		}
+	}
+}
+
+func (b *hugoShortcodeBlockParser) Open(parent gast.Node, reader text.Reader, pc parser.Context) (gast.Node, parser.State) {
+	line, segment := reader.PeekLine()
+	pos := pc.BlockOffset()
+	if pos < 0 {
+		return nil, parser.NoChildren
+	}
+	trimmed := util.TrimRightSpace(line[pos:])
+	call, length := parseHugoShortcode(trimmed)
+	if call == nil || length != len(trimmed) {
+		return nil, parser.NoChildren
+	}
+	call.Segment = text.NewSegment(segment.Start+pos, segment.Start+pos+length)
+	node := ast.NewHugoShortcodeBlock(*call)
+	if !call.IsClosing && !call.IsSelfClosing {
+		if count := peekHugoShortcodeClosure(reader, call); count != 0 {
+			remaining := parser.ContextState(pc, hugoShortcodeRemainingKey, func() interface{} {
+				return map[gast.Node]int{}
+			}).(map[gast.Node]int)
+			remaining[node] = count
+		}
+	}
+	advanceHugoShortcodeLine(reader)
+	if call.IsMarkdown {
+		return node, parser.HasChildren
+	}
+	return node, parser.NoChildren
+}
+
+func (b *hugoShortcodeBlockParser) Continue(node gast.Node, reader text.Reader, pc parser.Context) parser.State {
+	remaining, _ := pc.Get(hugoShortcodeRemainingKey).(map[gast.Node]int)
+	count, ok := remaining[node]
+	if !ok {
+		return parser.Close
+	}
+	line, segment := reader.PeekLine()
+	count--
+	remaining[node] = count
+	n := node.(*ast.HugoShortcodeBlock)
+	if count == 0 {
+		pos, _ := util.IndentWidth(line, reader.LineOffset())
+		trimmed := util.TrimRightSpace(line[pos:])
+		if call, length := parseHugoShortcode(trimmed); call != nil && length == len(trimmed) {
+			call.Segment = text.NewSegment(segment.Start+pos, segment.Start+pos+length)
+			n.Closure = call
+			delete(remaining, node)
+			advanceHugoShortcodeLine(reader)
+			return parser.Close
+		}
+		// the closing tag belongs to another container
+		delete(remaining, node)
+		return parser.Close
+	}
+	if n.IsMarkdown {
+		return parser.Continue | parser.HasChildren
+	}
+	n.Lines().Append(segment)
+	advanceHugoShortcodeLine(reader)
+	return parser.Continue | parser.NoChildren
+}
+
+func (b *hugoShortcodeBlockParser) Close(node gast.Node, reader text.Reader, pc parser.Context) {
+	if remaining, ok := pc.Get(hugoShortcodeRemainingKey).(map[gast.Node]int); ok {
+		delete(remaining, node)
+	}
+}
+
+func (b *hugoShortcodeBlockParser) CanInterruptParagraph() bool {
+	return false
+}
+
+func (b *hugoShortcodeBlockParser) CanAcceptIndentedLine() bool {
+	return false
+}
+
+type hugoShortcodeParser struct {
+}
+
+var defaultHugoShortcodeParser = &hugoShortcodeParser{}
+
+// NewHugoShortcodeParser returns a new parser.InlineParser that parses
+// Hugo shortcodes in paragraphs like '{{< ref "about" >}}'.
+func NewHugoShortcodeParser() parser.InlineParser {
+	return defaultHugoShortcodeParser
+}
+
+func (s *hugoShortcodeParser) Trigger() []byte {
+	return []byte{'{'}
+}
+
+func (s *hugoShortcodeParser) Parse(parent gast.Node, block text.Reader, pc parser.Context) gast.Node {
+	line, segment := block.PeekLine()
+	call, length := parseHugoShortcode(line)
+	if call == nil {
+		return nil
+	}
+	call.Segment = segment.WithStop(segment.Start + length)
+	block.Advance(length)
+	return ast.NewHugoShortcode(*call)
+}
+
+func (s *hugoShortcodeParser) CloseBlock(parent gast.Node, pc parser.Context) {
+	// nothing to do
+}
+
+// HugoShortcodeHTMLRenderer is a renderer.NodeRenderer implementation that
+// renders HugoShortcode and HugoShortcodeBlock nodes.
+// Shortcodes that are not expanded by the handler are written as they are,
+// so they are escaped unless html.WithUnsafe is set.
+type HugoShortcodeHTMLRenderer struct {
+	html.Config
+	HugoShortcodeConfig
+}
+
+// NewHugoShortcodeHTMLRenderer returns a new HugoShortcodeHTMLRenderer.
+func NewHugoShortcodeHTMLRenderer(opts ...HugoShortcodeOption) renderer.NodeRenderer {
+	r := &HugoShortcodeHTMLRenderer{
+		Config: html.NewConfig(),
+	}
+	for _, opt := range opts {
+		opt.SetHugoShortcodeOption(&r.HugoShortcodeConfig)
+	}
+	return r
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *HugoShortcodeHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindHugoShortcode, r.renderHugoShortcode)
+	reg.Register(ast.KindHugoShortcodeBlock, r.renderHugoShortcodeBlock)
+}
+
+func (r *HugoShortcodeHTMLRenderer) writeRaw(w util.BufWriter, value []byte) {
+	if r.Unsafe {
+		_, _ = w.Write(value)
+	} else {
+		_, _ = w.Write(util.EscapeHTML(value))
+	}
+}
+
+func (r *HugoShortcodeHTMLRenderer) renderHugoShortcode(w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	if !entering {
+		return gast.WalkContinue, nil
+	}
+	if r.Handler != nil {
+		if ok, err := r.Handler.RenderHugoShortcode(w, source, node, entering); ok || err != nil {
+			return gast.WalkContinue, err
+		}
+	}
+	n := node.(*ast.HugoShortcode)
+	r.writeRaw(w, n.Segment.Value(source))
+	return gast.WalkContinue, nil
+}
+
+func (r *HugoShortcodeHTMLRenderer) renderHugoShortcodeBlock(w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	n := node.(*ast.HugoShortcodeBlock)
+	if r.Handler != nil {
+		if ok, err := r.Handler.RenderHugoShortcode(w, source, node, entering); ok || err != nil {
+			return gast.WalkContinue, err
+		}
+	}
+	if entering {
+		r.writeRaw(w, n.Segment.Value(source))
+		_ = w.WriteByte('\n')
+		lines := n.Lines()
+		for i := 0; i < lines.Len(); i++ {
+			line := lines.At(i)
+			r.writeRaw(w, line.Value(source))
+		}
+	} else if n.Closure != nil {
+		r.writeRaw(w, n.Closure.Segment.Value(source))
+		_ = w.WriteByte('\n')
+	}
+	return gast.WalkContinue, nil
+}
+
+type hugoShortcode struct {
+	options []HugoShortcodeOption
+}
+
+// HugoShortcode is an extension that parses Hugo shortcodes like
+// '{{< figure src="a.png" >}}' and '{{% note %}}' into dedicated nodes
+// instead of texts, and passes them through.
+var HugoShortcode = &hugoShortcode{}
+
+// NewHugoShortcode returns a new Extender that parses Hugo shortcodes with
+// the given options.
+func NewHugoShortcode(opts ...HugoShortcodeOption) goldmark.Extender {
+	return &hugoShortcode{
+		options: opts,
+	}
+}
+
+func (e *hugoShortcode) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithBlockParsers(
+			util.Prioritized(NewHugoShortcodeBlockParser(), 150),
+		),
+		parser.WithInlineParsers(
+			util.Prioritized(NewHugoShortcodeParser(), 100),
+		),
+	)
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(NewHugoShortcodeHTMLRenderer(e.options...), 500),
+	))
+}
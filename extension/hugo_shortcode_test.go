@@ -0,0 +1,83 @@
+package extension
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/util"
+)
+
+func TestHugoShortcode(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithRendererOptions(
+			html.WithUnsafe(),
+		),
+		goldmark.WithExtensions(
+			HugoShortcode,
+		),
+	)
+	goldmark.DoTestCaseFile(markdown, "_test/hugo_shortcode.txt", t)
+}
+
+func TestHugoShortcodeHandler(t *testing.T) {
+	handler := HugoShortcodeHandlerFunc(func(w util.BufWriter, source []byte, node gast.Node, entering bool) (bool, error) {
+		switch n := node.(type) {
+		case *ast.HugoShortcode:
+			if string(n.Name) != "ref" {
+				return false, nil
+			}
+			page, _ := n.Positional(0)
+			_, _ = fmt.Fprintf(w, `<a href="/%s/">%s</a>`, page, page)
+		case *ast.HugoShortcodeBlock:
+			switch string(n.Name) {
+			case "note":
+				if entering {
+					title, _ := n.Param("title")
+					_, _ = fmt.Fprintf(w, "<aside title=\"%s\">\n", util.EscapeHTML(title))
+				} else {
+					_, _ = w.WriteString("</aside>\n")
+				}
+			case "highlight":
+				if entering {
+					lang, _ := n.Positional(0)
+					_, _ = fmt.Fprintf(w, "<pre lang=\"%s\">", lang)
+					lines := n.Lines()
+					for i := 0; i < lines.Len(); i++ {
+						line := lines.At(i)
+						_, _ = w.Write(util.EscapeHTML(line.Value(source)))
+					}
+					_, _ = w.WriteString("</pre>\n")
+				}
+			default:
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			NewHugoShortcode(WithHugoShortcodeHandler(handler)),
+		),
+	)
+	goldmark.DoTestCases(markdown, []goldmark.MarkdownTestCase{
+		{
+			No: 1,
+			Markdown: `{{% note title="Say \"hi\"" %}}
+Go to {{< ref about >}} or {{< relref "faq" >}}.
+{{% /note %}}
+
+{{< highlight go >}}
+a := <-ch
+{{< /highlight >}}`,
+			Expected: `<aside title="Say &quot;hi&quot;">
+<p>Go to <a href="/about/">about</a> or {{&lt; relref &quot;faq&quot; &gt;}}.</p>
+</aside>
+<pre lang="go">a := &lt;-ch
+</pre>`,
+		},
+	}, t)
+}
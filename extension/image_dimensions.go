@@ -0,0 +1,115 @@
+package extension
+
+import (
+	"regexp"
+
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+var imageDimensionsRegexp = regexp.MustCompile(`^\(\s*(<[^<>\n]*>|[^\s<>()]+)\s+=(\d+%?)?x(\d+%?)?(?:\s+("(?:\\.|[^"\\])*"|'(?:\\.|[^'\\])*'))?\s*\)`)
+
+type imageDimensionsParser struct {
+}
+
+var defaultImageDimensionsParser = &imageDimensionsParser{}
+
+// NewImageDimensionsParser returns a new InlineParser that parses images
+// with dimensions like '![alt](image.png =640x480)'.
+// A width or a height can be omitted like '=640x' and can be a percentage
+// like '=50%x'.
+// Alt texts of these images are not parsed as inline elements.
+func NewImageDimensionsParser() parser.InlineParser {
+	return defaultImageDimensionsParser
+}
+
+func (s *imageDimensionsParser) Trigger() []byte {
+	return []byte{'!'}
+}
+
+func (s *imageDimensionsParser) Parse(parent gast.Node, block text.Reader, pc parser.Context) gast.Node {
+	line, segment := block.PeekLine()
+	if len(line) < 2 || line[1] != '[' {
+		return nil
+	}
+	closer := util.FindClosure(line[2:], '[', ']', false, true)
+	if closer < 0 {
+		return nil
+	}
+	closer += 2
+	m := imageDimensionsRegexp.FindSubmatchIndex(line[closer+1:])
+	if m == nil || (m[4] < 0 && m[6] < 0) {
+		return nil
+	}
+	rest := line[closer+1:]
+	link := gast.NewLink()
+	destination := rest[m[2]:m[3]]
+	if destination[0] == '<' {
+		destination = destination[1 : len(destination)-1]
+	}
+	link.Destination = destination
+	if m[8] > -1 {
+		link.Title = rest[m[8]+1 : m[9]-1]
+	}
+	if closer > 2 {
+		link.AppendChild(link, gast.NewTextSegment(text.NewSegment(segment.Start+2, segment.Start+closer)))
+	}
+	image := gast.NewImage(link)
+	if m[4] > -1 {
+		image.SetAttribute(attrNameWidth, rest[m[4]:m[5]])
+	}
+	if m[6] > -1 {
+		image.SetAttribute(attrNameHeight, rest[m[6]:m[7]])
+	}
+	block.Advance(closer + 1 + m[1])
+	return image
+}
+
+type imageAttributeTransformer struct {
+}
+
+var defaultImageAttributeTransformer = &imageAttributeTransformer{}
+
+// NewImageAttributeTransformer returns a new ASTTransformer that
+// sets attribute lists following images like '![alt](image.png){width=50%}'
+// to images.
+func NewImageAttributeTransformer() parser.ASTTransformer {
+	return defaultImageAttributeTransformer
+}
+
+func (a *imageAttributeTransformer) Transform(node *gast.Document, reader text.Reader, pc parser.Context) {
+	source := reader.Source()
+	var images []gast.Node
+	_ = gast.Walk(node, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+		if entering && n.Kind() == gast.KindImage {
+			images = append(images, n)
+		}
+		return gast.WalkContinue, nil
+	})
+	for _, n := range images {
+		parseTrailingAttributes(n, source)
+	}
+}
+
+type imageDimensions struct {
+}
+
+// ImageDimensions is an extension that allow you to specify dimensions of
+// images like '![alt](image.png =640x480)' and '![alt](image.png){width=50%}'.
+// Dimensions are set to images as width and height attributes, so
+// extensions like the ImageSizer leave these images as they are.
+var ImageDimensions = &imageDimensions{}
+
+func (e *imageDimensions) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithInlineParsers(
+			util.Prioritized(NewImageDimensionsParser(), 150),
+		),
+		parser.WithASTTransformers(
+			util.Prioritized(NewImageAttributeTransformer(), 500),
+		),
+	)
+}
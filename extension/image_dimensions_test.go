@@ -0,0 +1,38 @@
+package extension
+
+import (
+	"testing"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/renderer/html"
+)
+
+func TestImageDimensions(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithRendererOptions(
+			html.WithXHTML(),
+		),
+		goldmark.WithExtensions(
+			ImageDimensions,
+		),
+	)
+	goldmark.DoTestCaseFile(markdown, "_test/image_dimensions.txt", t)
+}
+
+func TestDecorativeImage(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithRendererOptions(
+			html.WithMissingAltText(html.MissingAltTextFilename),
+		),
+		goldmark.WithExtensions(
+			ImageDimensions,
+		),
+	)
+	goldmark.DoTestCases(markdown, []goldmark.MarkdownTestCase{
+		{
+			No:       1,
+			Markdown: `![Border](border.png){.decorative} ![](rule.png){.decorative role=none}`,
+			Expected: `<p><img src="border.png" alt="" class="decorative" role="presentation"> <img src="rule.png" alt="" class="decorative" role="none"></p>`,
+		},
+	}, t)
+}
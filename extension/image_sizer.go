@@ -0,0 +1,360 @@
+package extension
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	// register decoders for image.DecodeConfig
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// An ImageSize struct is a size of an image in pixels.
+type ImageSize struct {
+	Width  int
+	Height int
+}
+
+// ErrUnsupportedImage is returned by ImageSizeProbers when the given
+// destination can not be handled by the prober.
+var ErrUnsupportedImage = errors.New("unsupported image")
+
+// An ImageSizeProber interface probes sizes of images.
+type ImageSizeProber interface {
+	// Probe returns a size of the image located at the given destination.
+	Probe(destination string) (ImageSize, error)
+}
+
+// ImageSizeProberFunc is a function that implements ImageSizeProber.
+type ImageSizeProberFunc func(destination string) (ImageSize, error)
+
+// Probe implements ImageSizeProber.Probe.
+func (f ImageSizeProberFunc) Probe(destination string) (ImageSize, error) {
+	return f(destination)
+}
+
+func isRemoteDestination(destination string) bool {
+	return strings.Contains(destination, "://") || strings.HasPrefix(destination, "//") ||
+		strings.HasPrefix(destination, "data:")
+}
+
+func decodeImageSize(r io.Reader) (ImageSize, error) {
+	c, _, err := image.DecodeConfig(r)
+	if err != nil {
+		return ImageSize{}, err
+	}
+	return ImageSize{Width: c.Width, Height: c.Height}, nil
+}
+
+// NewFileImageSizeProber returns a new ImageSizeProber that reads headers
+// of local image files. Destinations are resolved relative to the given
+// directory, and destinations outside of the directory are rejected.
+// GIF, JPEG and PNG images are supported.
+func NewFileImageSizeProber(dir string) ImageSizeProber {
+	return ImageSizeProberFunc(func(destination string) (ImageSize, error) {
+		if isRemoteDestination(destination) {
+			return ImageSize{}, ErrUnsupportedImage
+		}
+		if i := strings.IndexAny(destination, "?#"); i > -1 {
+			destination = destination[:i]
+		}
+		if v, err := url.PathUnescape(destination); err == nil {
+			destination = v
+		}
+		// documents must not probe arbitrary files like '../../secret.png'
+		name := filepath.Join(dir, filepath.FromSlash(destination))
+		rel, err := filepath.Rel(dir, name)
+		if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return ImageSize{}, fmt.Errorf("%s: image is outside of %s", destination, dir)
+		}
+		f, err := os.Open(name)
+		if err != nil {
+			return ImageSize{}, err
+		}
+		defer f.Close()
+		return decodeImageSize(f)
+	})
+}
+
+// NewHTTPImageSizeProber returns a new ImageSizeProber that fetches only
+// leading bytes of remote images and reads their headers.
+// If client is nil, http.DefaultClient will be used.
+func NewHTTPImageSizeProber(client *http.Client) ImageSizeProber {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return ImageSizeProberFunc(func(destination string) (ImageSize, error) {
+		if !strings.HasPrefix(destination, "http://") && !strings.HasPrefix(destination, "https://") {
+			return ImageSize{}, ErrUnsupportedImage
+		}
+		req, err := http.NewRequest(http.MethodGet, destination, nil)
+		if err != nil {
+			return ImageSize{}, err
+		}
+		req.Header.Set("Range", "bytes=0-65535")
+		res, err := client.Do(req)
+		if err != nil {
+			return ImageSize{}, err
+		}
+		defer res.Body.Close()
+		if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusPartialContent {
+			return ImageSize{}, fmt.Errorf("%s: unexpected status: %s", destination, res.Status)
+		}
+		return decodeImageSize(io.LimitReader(res.Body, 65536))
+	})
+}
+
+// NewManifestImageSizeProber returns a new ImageSizeProber that looks up
+// sizes in the given asset manifest.
+func NewManifestImageSizeProber(manifest map[string]ImageSize) ImageSizeProber {
+	return ImageSizeProberFunc(func(destination string) (ImageSize, error) {
+		if size, ok := manifest[destination]; ok {
+			return size, nil
+		}
+		return ImageSize{}, ErrUnsupportedImage
+	})
+}
+
+// NewImageSizeProbers returns a new ImageSizeProber that tries the given
+// probers in order and returns the first successful result.
+func NewImageSizeProbers(probers ...ImageSizeProber) ImageSizeProber {
+	return ImageSizeProberFunc(func(destination string) (ImageSize, error) {
+		err := ErrUnsupportedImage
+		for _, p := range probers {
+			var size ImageSize
+			size, err = p.Probe(destination)
+			if err == nil {
+				return size, nil
+			}
+		}
+		return ImageSize{}, err
+	})
+}
+
+// An ImageSizeCache interface caches probed image sizes.
+// Implementations must be safe for concurrent use.
+type ImageSizeCache interface {
+	// Get returns a cached size of the given destination.
+	Get(destination string) (ImageSize, bool)
+
+	// Set caches a size of the given destination.
+	Set(destination string, size ImageSize)
+}
+
+type imageSizeCache struct {
+	values sync.Map
+}
+
+// NewImageSizeCache returns a new in-memory ImageSizeCache.
+func NewImageSizeCache() ImageSizeCache {
+	return &imageSizeCache{}
+}
+
+func (c *imageSizeCache) Get(destination string) (ImageSize, bool) {
+	v, ok := c.values.Load(destination)
+	if !ok {
+		return ImageSize{}, false
+	}
+	return v.(ImageSize), true
+}
+
+func (c *imageSizeCache) Set(destination string, size ImageSize) {
+	c.values.Store(destination, size)
+}
+
+// An ImageSizerConfig struct is a data structure that holds configuration of the
+// ImageSizer extension.
+type ImageSizerConfig struct {
+	// Prober probes sizes of images.
+	Prober ImageSizeProber
+
+	// Cache caches probed sizes across documents. nil means no caching.
+	Cache ImageSizeCache
+
+	// Concurrency is a maximum number of images probed at the same time.
+	Concurrency int
+}
+
+// An ImageSizerOption interface sets options for the ImageSizer extension.
+type ImageSizerOption interface {
+	SetImageSizerOption(*ImageSizerConfig)
+}
+
+type withImageSizeProber struct {
+	value ImageSizeProber
+}
+
+func (o *withImageSizeProber) SetImageSizerOption(c *ImageSizerConfig) {
+	c.Prober = o.value
+}
+
+// WithImageSizeProber is a functional option that sets a prober for image sizes.
+func WithImageSizeProber(prober ImageSizeProber) ImageSizerOption {
+	return &withImageSizeProber{prober}
+}
+
+type withImageSizeCache struct {
+	value ImageSizeCache
+}
+
+func (o *withImageSizeCache) SetImageSizerOption(c *ImageSizerConfig) {
+	c.Cache = o.value
+}
+
+// WithImageSizeCache is a functional option that caches probed sizes in
+// the given cache.
+func WithImageSizeCache(cache ImageSizeCache) ImageSizerOption {
+	return &withImageSizeCache{cache}
+}
+
+type withImageSizeConcurrency struct {
+	value int
+}
+
+func (o *withImageSizeConcurrency) SetImageSizerOption(c *ImageSizerConfig) {
+	c.Concurrency = o.value
+}
+
+// WithImageSizeConcurrency is a functional option that limits a number of
+// images probed at the same time.
+func WithImageSizeConcurrency(n int) ImageSizerOption {
+	return &withImageSizeConcurrency{n}
+}
+
+var attrNameWidth = []byte("width")
+var attrNameHeight = []byte("height")
+
+type imageSizerASTTransformer struct {
+	ImageSizerConfig
+}
+
+// NewImageSizerASTTransformer returns a new parser.ASTTransformer that
+// sets width and height attributes to images.
+// Images that already have width or height attributes and images that
+// can not be probed are left as they are.
+func NewImageSizerASTTransformer(opts ...ImageSizerOption) parser.ASTTransformer {
+	a := &imageSizerASTTransformer{
+		ImageSizerConfig: ImageSizerConfig{
+			Prober:      NewFileImageSizeProber("."),
+			Concurrency: 4,
+		},
+	}
+	for _, o := range opts {
+		o.SetImageSizerOption(&a.ImageSizerConfig)
+	}
+	return a
+}
+
+func (a *imageSizerASTTransformer) Transform(node *gast.Document, reader text.Reader, pc parser.Context) {
+	var images []*gast.Image
+	destinations := []string{}
+	sizes := map[string]*ImageSize{}
+	_ = gast.Walk(node, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+		if !entering || n.Kind() != gast.KindImage {
+			return gast.WalkContinue, nil
+		}
+		img := n.(*gast.Image)
+		if _, ok := img.Attribute(attrNameWidth); ok {
+			return gast.WalkContinue, nil
+		}
+		if _, ok := img.Attribute(attrNameHeight); ok {
+			return gast.WalkContinue, nil
+		}
+		images = append(images, img)
+		destination := string(img.Destination)
+		if _, ok := sizes[destination]; !ok {
+			sizes[destination] = nil
+			destinations = append(destinations, destination)
+		}
+		return gast.WalkContinue, nil
+	})
+	if len(images) == 0 {
+		return
+	}
+
+	var mutex sync.Mutex
+	probe := func(destination string) {
+		if a.Cache != nil {
+			if size, ok := a.Cache.Get(destination); ok {
+				mutex.Lock()
+				sizes[destination] = &size
+				mutex.Unlock()
+				return
+			}
+		}
+		size, err := a.Prober.Probe(destination)
+		if err != nil {
+			return
+		}
+		if a.Cache != nil {
+			a.Cache.Set(destination, size)
+		}
+		mutex.Lock()
+		sizes[destination] = &size
+		mutex.Unlock()
+	}
+	if a.Concurrency <= 1 {
+		for _, destination := range destinations {
+			probe(destination)
+		}
+	} else {
+		var wg sync.WaitGroup
+		semaphore := make(chan struct{}, a.Concurrency)
+		for _, destination := range destinations {
+			wg.Add(1)
+			semaphore <- struct{}{}
+			go func(destination string) {
+				defer func() {
+					<-semaphore
+					wg.Done()
+				}()
+				probe(destination)
+			}(destination)
+		}
+		wg.Wait()
+	}
+
+	for _, img := range images {
+		size := sizes[string(img.Destination)]
+		if size == nil {
+			continue
+		}
+		img.SetAttribute(attrNameWidth, []byte(strconv.Itoa(size.Width)))
+		img.SetAttribute(attrNameHeight, []byte(strconv.Itoa(size.Height)))
+	}
+}
+
+type imageSizer struct {
+	options []ImageSizerOption
+}
+
+// NewImageSizer returns a new Extender that sets width and height
+// attributes to images to prevent layout shifts.
+func NewImageSizer(opts ...ImageSizerOption) goldmark.Extender {
+	return &imageSizer{
+		options: opts,
+	}
+}
+
+func (e *imageSizer) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithASTTransformers(
+			util.Prioritized(NewImageSizerASTTransformer(e.options...), 999),
+		),
+	)
+}
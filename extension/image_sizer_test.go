@@ -0,0 +1,108 @@
+package extension
+
+import (
+	"image"
+	"image/png"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/renderer/html"
+)
+
+func TestImageSizer(t *testing.T) {
+	var probed int32
+	manifest := NewManifestImageSizeProber(map[string]ImageSize{
+		"/images/logo.png": {Width: 120, Height: 40},
+	})
+	markdown := goldmark.New(
+		goldmark.WithRendererOptions(
+			html.WithXHTML(),
+			html.WithUnsafe(),
+		),
+		goldmark.WithExtensions(
+			NewImageSizer(
+				WithImageSizeProber(ImageSizeProberFunc(func(destination string) (ImageSize, error) {
+					atomic.AddInt32(&probed, 1)
+					return manifest.Probe(destination)
+				})),
+				WithImageSizeCache(NewImageSizeCache()),
+			),
+		),
+	)
+	goldmark.DoTestCaseFile(markdown, "_test/image_sizer.txt", t)
+	// logo.png should be probed only once thanks to the cache
+	if probed != 2 {
+		t.Errorf("expected 2 probes, but got %d", probed)
+	}
+}
+
+func TestFileImageSizeProber(t *testing.T) {
+	dir, err := ioutil.TempDir("", "goldmark")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	f, err := os.Create(filepath.Join(dir, "a b.png"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := png.Encode(f, image.NewRGBA(image.Rect(0, 0, 3, 2))); err != nil {
+		t.Fatal(err)
+	}
+	_ = f.Close()
+
+	prober := NewFileImageSizeProber(dir)
+	size, err := prober.Probe("a%20b.png?v=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if size.Width != 3 || size.Height != 2 {
+		t.Errorf("unexpected size: %v", size)
+	}
+	if _, err := prober.Probe("http://example.com/a.png"); err != ErrUnsupportedImage {
+		t.Errorf("remote images should not be supported: %v", err)
+	}
+}
+
+func TestFileImageSizeProberOutsideDir(t *testing.T) {
+	root, err := ioutil.TempDir("", "goldmark")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	dir := filepath.Join(root, "site")
+	if err := os.Mkdir(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{filepath.Join(root, "secret.png"), filepath.Join(dir, "a.png")} {
+		f, err := os.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := png.Encode(f, image.NewRGBA(image.Rect(0, 0, 3, 2))); err != nil {
+			t.Fatal(err)
+		}
+		_ = f.Close()
+	}
+
+	prober := NewFileImageSizeProber(dir)
+	for _, destination := range []string{
+		"../secret.png",
+		"%2e%2e/secret.png",
+		"/../secret.png",
+		"images/../../secret.png",
+	} {
+		if size, err := prober.Probe(destination); err == nil {
+			t.Errorf("%s: images outside of the directory should be rejected: %v", destination, size)
+		}
+	}
+	for _, destination := range []string{"a.png", "/a.png", "images/../a.png"} {
+		if _, err := prober.Probe(destination); err != nil {
+			t.Errorf("%s: %v", destination, err)
+		}
+	}
+}
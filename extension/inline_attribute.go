@@ -0,0 +1,55 @@
+package extension
+
+import (
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+type inlineAttributeTransformer struct {
+}
+
+var defaultInlineAttributeTransformer = &inlineAttributeTransformer{}
+
+// NewInlineAttributeTransformer returns a new ASTTransformer that sets
+// attribute lists following emphasis, links, images and code spans like
+// '*text*{.class #id key=value}' to these nodes.
+func NewInlineAttributeTransformer() parser.ASTTransformer {
+	return defaultInlineAttributeTransformer
+}
+
+func (a *inlineAttributeTransformer) Transform(node *gast.Document, reader text.Reader, pc parser.Context) {
+	source := reader.Source()
+	var targets []gast.Node
+	_ = gast.Walk(node, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+		if !entering {
+			return gast.WalkContinue, nil
+		}
+		switch n.Kind() {
+		case gast.KindEmphasis, gast.KindLink, gast.KindImage, gast.KindCodeSpan:
+			targets = append(targets, n)
+		}
+		return gast.WalkContinue, nil
+	})
+	for _, n := range targets {
+		parseTrailingAttributes(n, source)
+	}
+}
+
+type inlineAttribute struct {
+}
+
+// InlineAttribute is an extension that allows you to define attributes on
+// emphasis, links, images and code spans like
+// '[link](/url){.external rel=nofollow}'.
+var InlineAttribute = &inlineAttribute{}
+
+func (e *inlineAttribute) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithASTTransformers(
+			util.Prioritized(NewInlineAttributeTransformer(), 500),
+		),
+	)
+}
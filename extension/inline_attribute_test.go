@@ -0,0 +1,16 @@
+package extension
+
+import (
+	"testing"
+
+	"github.com/yuin/goldmark"
+)
+
+func TestInlineAttribute(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			InlineAttribute,
+		),
+	)
+	goldmark.DoTestCaseFile(markdown, "_test/inline_attribute.txt", t)
+}
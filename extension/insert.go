@@ -0,0 +1,175 @@
+package extension
+
+import (
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// An InsertConfig struct is a data structure that holds configuration of the
+// Insert extension.
+type InsertConfig struct {
+	// Tag is an HTML tag name for inserted texts.
+	Tag []byte
+
+	// Class is a class attribute for inserted texts.
+	Class []byte
+}
+
+// An InsertOption interface sets options for the Insert extension.
+type InsertOption interface {
+	SetInsertOption(*InsertConfig)
+}
+
+type withInsertTag struct {
+	value []byte
+}
+
+func (o *withInsertTag) SetInsertOption(c *InsertConfig) {
+	c.Tag = o.value
+}
+
+// WithInsertTag is a functional option that sets an HTML tag name for
+// inserted texts.
+func WithInsertTag(tag string) InsertOption {
+	return &withInsertTag{[]byte(tag)}
+}
+
+type withInsertClass struct {
+	value []byte
+}
+
+func (o *withInsertClass) SetInsertOption(c *InsertConfig) {
+	c.Class = o.value
+}
+
+// WithInsertClass is a functional option that sets a class attribute for
+// inserted texts.
+func WithInsertClass(class string) InsertOption {
+	return &withInsertClass{[]byte(class)}
+}
+
+type insertDelimiterProcessor struct {
+}
+
+func (p *insertDelimiterProcessor) IsDelimiter(b byte) bool {
+	return b == '+'
+}
+
+func (p *insertDelimiterProcessor) CanOpenCloser(opener, closer *parser.Delimiter) bool {
+	return opener.Char == closer.Char
+}
+
+func (p *insertDelimiterProcessor) OnMatch(consumes int) gast.Node {
+	return ast.NewInsert()
+}
+
+var defaultInsertDelimiterProcessor = &insertDelimiterProcessor{}
+
+type insertParser struct {
+}
+
+var defaultInsertParser = &insertParser{}
+
+// NewInsertParser return a new InlineParser that parses
+// inserted texts like '++text++'.
+func NewInsertParser() parser.InlineParser {
+	return defaultInsertParser
+}
+
+func (s *insertParser) Trigger() []byte {
+	return []byte{'+'}
+}
+
+func (s *insertParser) Parse(parent gast.Node, block text.Reader, pc parser.Context) gast.Node {
+	before := block.PrecendingCharacter()
+	line, segment := block.PeekLine()
+	node := parser.ScanDelimiter(line, before, 2, defaultInsertDelimiterProcessor)
+	if node == nil {
+		return nil
+	}
+	node.Segment = segment.WithStop(segment.Start + node.OriginalLength)
+	block.Advance(node.OriginalLength)
+	pc.PushDelimiter(node)
+	return node
+}
+
+func (s *insertParser) CloseBlock(parent gast.Node, pc parser.Context) {
+	// nothing to do
+}
+
+// InsertHTMLRenderer is a renderer.NodeRenderer implementation that
+// renders Insert nodes.
+type InsertHTMLRenderer struct {
+	html.Config
+	InsertConfig
+}
+
+// NewInsertHTMLRenderer returns a new InsertHTMLRenderer.
+func NewInsertHTMLRenderer(opts ...InsertOption) renderer.NodeRenderer {
+	r := &InsertHTMLRenderer{
+		Config: html.NewConfig(),
+		InsertConfig: InsertConfig{
+			Tag: []byte("ins"),
+		},
+	}
+	for _, opt := range opts {
+		opt.SetInsertOption(&r.InsertConfig)
+	}
+	return r
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *InsertHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindInsert, r.renderInsert)
+}
+
+func (r *InsertHTMLRenderer) renderInsert(w util.BufWriter, source []byte, n gast.Node, entering bool) (gast.WalkStatus, error) {
+	if entering {
+		_ = w.WriteByte('<')
+		_, _ = w.Write(r.Tag)
+		if len(r.Class) != 0 {
+			_, _ = w.WriteString(` class="`)
+			_, _ = w.Write(util.EscapeHTML(r.Class))
+			_ = w.WriteByte('"')
+		}
+		if n.Attributes() != nil {
+			r.RenderAttributes(w, n)
+		}
+		_ = w.WriteByte('>')
+	} else {
+		_, _ = w.WriteString("</")
+		_, _ = w.Write(r.Tag)
+		_ = w.WriteByte('>')
+	}
+	return gast.WalkContinue, nil
+}
+
+type insert struct {
+	options []InsertOption
+}
+
+// Insert is an extension that allow you to use inserted texts like '++text++' .
+var Insert = &insert{}
+
+// NewInsert returns a new Extender that allow you to use inserted texts
+// with the given options.
+func NewInsert(opts ...InsertOption) goldmark.Extender {
+	return &insert{
+		options: opts,
+	}
+}
+
+func (e *insert) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(parser.WithInlineParsers(
+		util.Prioritized(NewInsertParser(), 500),
+	))
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(NewInsertHTMLRenderer(e.options...), 500),
+	))
+}
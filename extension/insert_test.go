@@ -0,0 +1,34 @@
+package extension
+
+import (
+	"testing"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/renderer/html"
+)
+
+func TestInsert(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithRendererOptions(
+			html.WithUnsafe(),
+		),
+		goldmark.WithExtensions(
+			Insert,
+			Highlight,
+		),
+	)
+	goldmark.DoTestCaseFile(markdown, "_test/insert.txt", t)
+}
+
+func TestInsertOptions(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			NewInsert(WithInsertTag("span"), WithInsertClass("added")),
+		),
+	)
+	goldmark.DoTestCases(markdown, []goldmark.MarkdownTestCase{{
+		No:       1,
+		Markdown: "++Hi++",
+		Expected: `<p><span class="added">Hi</span></p>`,
+	}}, t)
+}
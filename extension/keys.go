@@ -0,0 +1,301 @@
+package extension
+
+import (
+	"bytes"
+	"strconv"
+
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// A KeyDefinition struct is a normalized key of the Keys extension.
+type KeyDefinition struct {
+	// Name is a normalized name of the key like 'control'. Name is used
+	// as a class name like 'key-control'.
+	Name string
+
+	// Label is a text of the key like 'Ctrl'.
+	Label string
+}
+
+// DefaultKeyMap is a default map from key names and aliases written in
+// key combinations like 'ctrl' to KeyDefinitions.
+var DefaultKeyMap = func() map[string]KeyDefinition {
+	table := []string{
+		// aliases, name, label
+		"alt", "alt", "Alt",
+		"altgr alt-graph", "alt-graph", "AltGr",
+		"backspace", "backspace", "Backspace",
+		"caps caps-lock capslock", "caps-lock", "Caps Lock",
+		"cmd command", "command", "Cmd",
+		"ctrl control", "control", "Ctrl",
+		"del delete", "delete", "Del",
+		"down arrow-down", "arrow-down", "Down",
+		"end", "end", "End",
+		"enter return", "enter", "Enter",
+		"esc escape", "escape", "Esc",
+		"fn", "fn", "Fn",
+		"home", "home", "Home",
+		"ins insert", "insert", "Ins",
+		"left arrow-left", "arrow-left", "Left",
+		"menu", "menu", "Menu",
+		"meta", "meta", "Meta",
+		"num-lock numlock", "num-lock", "Num Lock",
+		"opt option", "option", "Option",
+		"pause", "pause", "Pause",
+		"pgdn page-down pagedown", "page-down", "Page Down",
+		"pgup page-up pageup", "page-up", "Page Up",
+		"plus", "plus", "+",
+		"prtsc print-screen printscreen", "print-screen", "Print Screen",
+		"right arrow-right", "arrow-right", "Right",
+		"scroll-lock scrolllock", "scroll-lock", "Scroll Lock",
+		"shift", "shift", "Shift",
+		"space spacebar", "space", "Space",
+		"tab", "tab", "Tab",
+		"up arrow-up", "arrow-up", "Up",
+		"win windows", "windows", "Win",
+	}
+	m := map[string]KeyDefinition{}
+	for i := 0; i < len(table); i += 3 {
+		for _, alias := range bytes.Fields([]byte(table[i])) {
+			m[string(alias)] = KeyDefinition{Name: table[i+1], Label: table[i+2]}
+		}
+	}
+	for i := 1; i <= 24; i++ {
+		name := "f" + strconv.Itoa(i)
+		m[name] = KeyDefinition{Name: name, Label: "F" + strconv.Itoa(i)}
+	}
+	return m
+}()
+
+// A KeysConfig struct is a data structure that holds configuration of the
+// Keys extension.
+type KeysConfig struct {
+	// KeyMap is a map from key names and aliases to KeyDefinitions.
+	// Keys of the map must be in lower case.
+	KeyMap map[string]KeyDefinition
+}
+
+// NewKeysConfig returns a new KeysConfig with defaults.
+func NewKeysConfig() KeysConfig {
+	m := make(map[string]KeyDefinition, len(DefaultKeyMap))
+	for k, v := range DefaultKeyMap {
+		m[k] = v
+	}
+	return KeysConfig{
+		KeyMap: m,
+	}
+}
+
+// A KeysOption interface sets options for the Keys extension.
+type KeysOption interface {
+	SetKeysOption(*KeysConfig)
+}
+
+type withKeyMap struct {
+	value map[string]KeyDefinition
+}
+
+func (o *withKeyMap) SetKeysOption(c *KeysConfig) {
+	for k, v := range o.value {
+		c.KeyMap[string(bytes.ToLower([]byte(k)))] = v
+	}
+}
+
+// WithKeyMap is a functional option that adds the given KeyDefinitions to
+// the default key map.
+func WithKeyMap(m map[string]KeyDefinition) KeysOption {
+	return &withKeyMap{m}
+}
+
+type keysParser struct {
+	KeysConfig
+}
+
+// NewKeysParser returns a new InlineParser that parses key combinations like
+// '++ctrl+alt+delete++'.
+// A single key like '++word++' is parsed only if it is defined in the key
+// map, so that inserted texts of the Insert extension can coexist.
+func NewKeysParser(opts ...KeysOption) parser.InlineParser {
+	p := &keysParser{
+		KeysConfig: NewKeysConfig(),
+	}
+	for _, o := range opts {
+		o.SetKeysOption(&p.KeysConfig)
+	}
+	return p
+}
+
+func (s *keysParser) Trigger() []byte {
+	return []byte{'+'}
+}
+
+func isKeyNameChar(c byte) bool {
+	return util.IsAlphaNumeric(c) || c == '-' || c == '_'
+}
+
+// key returns a Key node of the given name written in a key combination.
+func (s *keysParser) key(name []byte) (*ast.Key, bool) {
+	normalized := bytes.ToLower(bytes.Replace(name, []byte{'_'}, []byte{'-'}, -1))
+	if def, ok := s.KeyMap[string(normalized)]; ok {
+		return ast.NewKey([]byte(def.Name), []byte(def.Label)), true
+	}
+	label := name
+	if len(name) == 1 {
+		label = bytes.ToUpper(name)
+	}
+	return ast.NewKey(normalized, label), false
+}
+
+func (s *keysParser) Parse(parent gast.Node, block text.Reader, pc parser.Context) gast.Node {
+	if block.PrecendingCharacter() == '+' {
+		return nil
+	}
+	line, _ := block.PeekLine()
+	if len(line) < 5 || line[1] != '+' {
+		return nil
+	}
+	var keys []*ast.Key
+	known := false
+	pos := 2
+	for {
+		if pos >= len(line) {
+			return nil
+		}
+		c := line[pos]
+		switch {
+		case c == '"':
+			i := bytes.IndexByte(line[pos+1:], '"')
+			if i < 1 {
+				return nil
+			}
+			keys = append(keys, ast.NewKey(nil, line[pos+1:pos+1+i]))
+			known = true
+			pos += i + 2
+		case isKeyNameChar(c):
+			start := pos
+			for pos < len(line) && isKeyNameChar(line[pos]) {
+				pos++
+			}
+			key, ok := s.key(line[start:pos])
+			keys = append(keys, key)
+			known = ok
+		case c != '+' && !util.IsSpace(c) && util.IsPunct(c):
+			key, ok := s.key(line[pos : pos+1])
+			if !ok {
+				// punctuations are not valid class names
+				key.Name = nil
+			}
+			keys = append(keys, key)
+			known = ok
+			pos++
+		default:
+			return nil
+		}
+		if pos+1 < len(line) && line[pos] == '+' && line[pos+1] == '+' &&
+			(pos+2 >= len(line) || line[pos+2] != '+') {
+			pos += 2
+			break
+		}
+		if pos >= len(line) || line[pos] != '+' {
+			return nil
+		}
+		pos++
+	}
+	if len(keys) == 1 && !known {
+		return nil
+	}
+	node := ast.NewKeys()
+	for _, key := range keys {
+		node.AppendChild(node, key)
+	}
+	block.Advance(pos)
+	return node
+}
+
+// KeysHTMLRenderer is a renderer.NodeRenderer implementation that
+// renders Keys nodes.
+type KeysHTMLRenderer struct {
+	html.Config
+}
+
+// NewKeysHTMLRenderer returns a new KeysHTMLRenderer.
+func NewKeysHTMLRenderer(opts ...html.Option) renderer.NodeRenderer {
+	r := &KeysHTMLRenderer{
+		Config: html.NewConfig(),
+	}
+	for _, opt := range opts {
+		opt.SetHTMLOption(&r.Config)
+	}
+	return r
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *KeysHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindKeys, r.renderKeys)
+	reg.Register(ast.KindKey, r.renderKey)
+}
+
+func (r *KeysHTMLRenderer) renderKeys(w util.BufWriter, source []byte, n gast.Node, entering bool) (gast.WalkStatus, error) {
+	if entering {
+		_, _ = w.WriteString(`<kbd class="keys"`)
+		if n.Attributes() != nil {
+			r.RenderAttributes(w, n)
+		}
+		_ = w.WriteByte('>')
+	} else {
+		_, _ = w.WriteString("</kbd>")
+	}
+	return gast.WalkContinue, nil
+}
+
+func (r *KeysHTMLRenderer) renderKey(w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	if !entering {
+		return gast.WalkContinue, nil
+	}
+	n := node.(*ast.Key)
+	if n.PreviousSibling() != nil {
+		_ = w.WriteByte('+')
+	}
+	_, _ = w.WriteString("<kbd")
+	if len(n.Name) != 0 {
+		_, _ = w.WriteString(` class="key-`)
+		_, _ = w.Write(util.EscapeHTML(n.Name))
+		_ = w.WriteByte('"')
+	}
+	_ = w.WriteByte('>')
+	_, _ = w.Write(util.EscapeHTML(n.Label))
+	_, _ = w.WriteString("</kbd>")
+	return gast.WalkContinue, nil
+}
+
+type keys struct {
+	options []KeysOption
+}
+
+// Keys is an extension that allows you to use key combinations like
+// '++ctrl+alt+delete++', rendered as nested '<kbd>' elements.
+var Keys = &keys{}
+
+// NewKeys returns a new Extender that allows you to use key combinations
+// with the given options.
+func NewKeys(opts ...KeysOption) goldmark.Extender {
+	return &keys{
+		options: opts,
+	}
+}
+
+func (e *keys) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(parser.WithInlineParsers(
+		util.Prioritized(NewKeysParser(e.options...), 400),
+	))
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(NewKeysHTMLRenderer(), 500),
+	))
+}
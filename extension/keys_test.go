@@ -0,0 +1,33 @@
+package extension
+
+import (
+	"testing"
+
+	"github.com/yuin/goldmark"
+)
+
+func TestKeys(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			Keys,
+			Insert,
+		),
+	)
+	goldmark.DoTestCaseFile(markdown, "_test/keys.txt", t)
+}
+
+func TestKeysOptions(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			NewKeys(WithKeyMap(map[string]KeyDefinition{
+				"cmd":   {Name: "command", Label: "⌘"},
+				"hyper": {Name: "hyper", Label: "Hyper"},
+			})),
+		),
+	)
+	goldmark.DoTestCases(markdown, []goldmark.MarkdownTestCase{{
+		No:       1,
+		Markdown: "++cmd+c++ ++hyper++",
+		Expected: `<p><kbd class="keys"><kbd class="key-command">⌘</kbd>+<kbd class="key-c">C</kbd></kbd> <kbd class="keys"><kbd class="key-hyper">Hyper</kbd></kbd></p>`,
+	}}, t)
+}
@@ -0,0 +1,241 @@
+package extension
+
+import (
+	"bytes"
+
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// parseIAL parses a kramdown style inline attribute list like
+// '{: .class #id key=value}' at the head of the given bytes.
+// parseIAL returns parsed attributes and a length of the list.
+func parseIAL(line []byte) (map[string]interface{}, int, bool) {
+	if len(line) < 3 || line[0] != '{' || line[1] != ':' {
+		return nil, 0, false
+	}
+	rest := line[2:]
+	spaces := util.TrimLeftSpaceLength(rest)
+	// parser.ParseAttributes requires a closing '}'
+	if spaces == len(rest) || bytes.IndexByte(rest, '}') < 0 {
+		return nil, 0, false
+	}
+	buf := make([]byte, 0, len(rest)-spaces+1)
+	buf = append(buf, '{')
+	buf = append(buf, rest[spaces:]...)
+	r := text.NewReader(buf)
+	attrs, ok := parser.ParseAttributes(r)
+	if !ok {
+		return nil, 0, false
+	}
+	_, pos := r.Position()
+	return attrs, pos.Start + 1 + spaces, true
+}
+
+// parseBlockIAL parses a line that consists of an inline attribute list.
+func parseBlockIAL(line []byte) (map[string]interface{}, bool) {
+	line = util.TrimRightSpace(line)
+	attrs, length, ok := parseIAL(line)
+	if !ok || length != len(line) {
+		return nil, false
+	}
+	return attrs, true
+}
+
+type kramdownIALParagraphTransformer struct {
+}
+
+var defaultKramdownIALParagraphTransformer = &kramdownIALParagraphTransformer{}
+
+// NewKramdownIALParagraphTransformer returns a new ParagraphTransformer
+// that extracts block inline attribute lists like '{: .class}' from
+// paragraphs.
+//
+// Lists at the head or the tail of a paragraph are set to the paragraph.
+// A list at the head of a list item followed by texts like
+// '- {: .class} item' is set to the list item.
+// Lists at the tail of a paragraph in a tight list item are set to the list
+// item, and lists in lazy continuation lines after a list item like
+// '- item\n{: .class}' are set to the list.
+// A paragraph that consists of lists only is set to the previous block
+// if there are no blank lines between them, otherwise to the next block.
+func NewKramdownIALParagraphTransformer() parser.ParagraphTransformer {
+	return defaultKramdownIALParagraphTransformer
+}
+
+func (t *kramdownIALParagraphTransformer) Transform(node *gast.Paragraph, reader text.Reader, pc parser.Context) {
+	source := reader.Source()
+	lines := node.Lines()
+	var attrs []map[string]interface{}
+	start, stop := 0, lines.Len()
+	for ; start < stop; start++ {
+		line := lines.At(start)
+		a, ok := parseBlockIAL(line.Value(source))
+		if !ok {
+			break
+		}
+		attrs = append(attrs, a)
+	}
+	var trailings []map[string]interface{}
+	for ; stop > start; stop-- {
+		line := lines.At(stop - 1)
+		a, ok := parseBlockIAL(line.Value(source))
+		if !ok {
+			break
+		}
+		if stop-1 > start && isLazyListItemLine(node, lines.At(0), line, source) {
+			// lazy continuation lines after list items are for the list
+			setAttributes(node.Parent().Parent(), a)
+			continue
+		}
+		trailings = append([]map[string]interface{}{a}, trailings...)
+	}
+	attrs = append(attrs, trailings...)
+
+	if start == stop {
+		// clear lines like link reference definitions, so that
+		// following setext heading underlines are left as texts.
+		lines.Clear()
+		var target gast.Node
+		if prev := node.PreviousSibling(); prev != nil && !node.HasBlankPreviousLines() {
+			target = prev
+			node.Parent().RemoveChild(node.Parent(), node)
+		} else {
+			// the next block has not been parsed yet, so the
+			// KramdownIALTransformer sets attributes to it later.
+			target = gast.NewTextBlock()
+			target.SetBlankPreviousLines(node.HasBlankPreviousLines())
+			node.Parent().ReplaceChild(node.Parent(), node, target)
+		}
+		for _, a := range attrs {
+			setAttributes(target, a)
+		}
+		return
+	}
+
+	if start == 0 && node.Parent() != nil && node.Parent().Kind() == gast.KindListItem &&
+		node.Parent().FirstChild() == node {
+		first := lines.At(0)
+		if a, length, ok := parseIAL(first.Value(source)); ok {
+			setAttributes(node.Parent(), a)
+			first = first.WithStart(first.Start + length)
+			lines.Set(0, first.TrimLeftSpace(source))
+		}
+	}
+	if start != 0 || stop != lines.Len() {
+		lines.SetSliced(start, stop)
+		last := lines.At(lines.Len() - 1)
+		lines.Set(lines.Len()-1, last.TrimRightSpace(source))
+	}
+	for _, a := range attrs {
+		setAttributes(node, a)
+	}
+}
+
+// isLazyListItemLine returns true if the given line of the given paragraph
+// is a lazy continuation line of a list item, that is indented less than
+// the first line of the paragraph.
+func isLazyListItemLine(node *gast.Paragraph, first, line text.Segment, source []byte) bool {
+	if node.Parent() == nil || node.Parent().Kind() != gast.KindListItem {
+		return false
+	}
+	column := func(s text.Segment) int {
+		return s.Start - (bytes.LastIndexByte(source[:s.Start], '\n') + 1) + s.Padding
+	}
+	return column(line) < column(first)
+}
+
+type kramdownIALTransformer struct {
+}
+
+var defaultKramdownIALTransformer = &kramdownIALTransformer{}
+
+// NewKramdownIALTransformer returns a new ASTTransformer that sets
+// span inline attribute lists like '*text*{: .class}' to the preceding
+// inline elements, and sets block inline attribute lists that precede
+// blocks to the blocks.
+func NewKramdownIALTransformer() parser.ASTTransformer {
+	return defaultKramdownIALTransformer
+}
+
+func (a *kramdownIALTransformer) Transform(node *gast.Document, reader text.Reader, pc parser.Context) {
+	source := reader.Source()
+	var pendings []gast.Node
+	var spans []gast.Node
+	_ = gast.Walk(node, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+		if !entering {
+			return gast.WalkContinue, nil
+		}
+		if n.Kind() == gast.KindTextBlock && n.Attributes() != nil {
+			if n.Lines().Len() == 0 {
+				pendings = append(pendings, n)
+			} else if n.Parent() != nil && n.Parent().Kind() == gast.KindListItem {
+				// paragraphs in tight list items are not rendered as
+				// elements, so attributes are set to the list items.
+				for _, attr := range n.Attributes() {
+					n.Parent().SetAttribute(attr.Name, attr.Value)
+				}
+				n.RemoveAttributes()
+			}
+		} else if n.Type() == gast.TypeInline && n.Kind() != gast.KindText {
+			spans = append(spans, n)
+		}
+		return gast.WalkContinue, nil
+	})
+	for _, n := range pendings {
+		if next := n.NextSibling(); next != nil {
+			for _, attr := range n.Attributes() {
+				next.SetAttribute(attr.Name, attr.Value)
+			}
+		}
+		n.Parent().RemoveChild(n.Parent(), n)
+	}
+	for _, n := range spans {
+		parseTrailingIAL(n, source)
+	}
+}
+
+// parseTrailingIAL parses an inline attribute list just after the given
+// inline node and sets it to the node.
+func parseTrailingIAL(n gast.Node, source []byte) bool {
+	t, ok := n.NextSibling().(*gast.Text)
+	if !ok || t.Segment.Padding != 0 || t.Segment.IsEmpty() || source[t.Segment.Start] != '{' {
+		return false
+	}
+	stop := bytes.IndexByte(source[t.Segment.Start:], '\n')
+	if stop < 0 {
+		stop = len(source)
+	} else {
+		stop += t.Segment.Start
+	}
+	attrs, length, ok := parseIAL(source[t.Segment.Start:stop])
+	if !ok {
+		return false
+	}
+	setAttributes(n, attrs)
+	consumeText(t, length)
+	return true
+}
+
+type kramdownIAL struct {
+}
+
+// KramdownIAL is an extension that allow you to use kramdown style inline
+// attribute lists like '{: .class #id}' after blocks and
+// '*text*{: .class}' after inline elements.
+// Attribute list definitions(ALDs) are not supported.
+var KramdownIAL = &kramdownIAL{}
+
+func (e *kramdownIAL) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithParagraphTransformers(
+			util.Prioritized(NewKramdownIALParagraphTransformer(), 150),
+		),
+		parser.WithASTTransformers(
+			util.Prioritized(NewKramdownIALTransformer(), 400),
+		),
+	)
+}
@@ -0,0 +1,17 @@
+package extension
+
+import (
+	"testing"
+
+	"github.com/yuin/goldmark"
+)
+
+func TestKramdownIAL(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			KramdownIAL,
+			Table,
+		),
+	)
+	goldmark.DoTestCaseFile(markdown, "_test/kramdown_ial.txt", t)
+}
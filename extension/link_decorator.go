@@ -0,0 +1,251 @@
+package extension
+
+import (
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// LinkKind is a kind of links classified by the LinkDecorator extension.
+type LinkKind int
+
+const (
+	// LinkInternal indicates a link to a page in the same site.
+	LinkInternal LinkKind = iota + 1
+
+	// LinkExternal indicates a link to another site.
+	LinkExternal
+
+	// LinkMailto indicates a mailto link.
+	LinkMailto
+
+	// LinkDownload indicates a link to a downloadable file.
+	LinkDownload
+)
+
+func (k LinkKind) String() string {
+	switch k {
+	case LinkInternal:
+		return "internal"
+	case LinkExternal:
+		return "external"
+	case LinkMailto:
+		return "mailto"
+	case LinkDownload:
+		return "download"
+	}
+	return ""
+}
+
+// A LinkDecoration struct defines how links are decorated.
+type LinkDecoration struct {
+	// Class is added to class attributes of links.
+	Class string
+
+	// Attributes are set to links.
+	Attributes map[string]string
+
+	// Icon is an HTML snippet appended to texts of links
+	// (i.e. `<span class="icon-external"></span>`).
+	// Icons are not appended to autolinks.
+	Icon string
+}
+
+// A LinkDecoratorConfig struct is a data structure that holds configuration of the
+// LinkDecorator extension.
+type LinkDecoratorConfig struct {
+	// InternalHosts are hosts that are considered as the same site.
+	InternalHosts []string
+
+	// DownloadExtensions are file extensions of downloadable files like '.zip'.
+	DownloadExtensions []string
+
+	// Decorations are decorations for each kind of links.
+	Decorations map[LinkKind]LinkDecoration
+}
+
+// NewLinkDecoratorConfig returns a new LinkDecoratorConfig with defaults.
+func NewLinkDecoratorConfig() LinkDecoratorConfig {
+	return LinkDecoratorConfig{
+		DownloadExtensions: []string{".zip", ".tar", ".gz", ".tgz", ".pdf", ".dmg", ".exe", ".msi"},
+		Decorations: map[LinkKind]LinkDecoration{
+			LinkExternal: {
+				Class:      "external",
+				Attributes: map[string]string{"rel": "noopener noreferrer"},
+			},
+			LinkMailto: {
+				Class: "mailto",
+			},
+			LinkDownload: {
+				Class:      "download",
+				Attributes: map[string]string{"download": ""},
+			},
+		},
+	}
+}
+
+// A LinkDecoratorOption interface sets options for the LinkDecorator extension.
+type LinkDecoratorOption interface {
+	SetLinkDecoratorOption(*LinkDecoratorConfig)
+}
+
+type withInternalHosts struct {
+	value []string
+}
+
+func (o *withInternalHosts) SetLinkDecoratorOption(c *LinkDecoratorConfig) {
+	c.InternalHosts = o.value
+}
+
+// WithInternalHosts is a functional option that sets hosts that are
+// considered as the same site.
+func WithInternalHosts(hosts ...string) LinkDecoratorOption {
+	return &withInternalHosts{hosts}
+}
+
+type withDownloadExtensions struct {
+	value []string
+}
+
+func (o *withDownloadExtensions) SetLinkDecoratorOption(c *LinkDecoratorConfig) {
+	c.DownloadExtensions = o.value
+}
+
+// WithDownloadExtensions is a functional option that sets file extensions
+// of downloadable files.
+func WithDownloadExtensions(exts ...string) LinkDecoratorOption {
+	return &withDownloadExtensions{exts}
+}
+
+type withLinkDecoration struct {
+	kind  LinkKind
+	value LinkDecoration
+}
+
+func (o *withLinkDecoration) SetLinkDecoratorOption(c *LinkDecoratorConfig) {
+	decorations := make(map[LinkKind]LinkDecoration, len(c.Decorations)+1)
+	for k, v := range c.Decorations {
+		decorations[k] = v
+	}
+	decorations[o.kind] = o.value
+	c.Decorations = decorations
+}
+
+// WithLinkDecoration is a functional option that sets a decoration for
+// the given kind of links.
+func WithLinkDecoration(kind LinkKind, decoration LinkDecoration) LinkDecoratorOption {
+	return &withLinkDecoration{kind, decoration}
+}
+
+// ClassifyLink returns a kind of the given link destination.
+func (c *LinkDecoratorConfig) ClassifyLink(destination string) LinkKind {
+	if strings.HasPrefix(strings.ToLower(destination), "mailto:") {
+		return LinkMailto
+	}
+	u, err := url.Parse(destination)
+	if err != nil {
+		return LinkInternal
+	}
+	ext := strings.ToLower(path.Ext(u.Path))
+	for _, e := range c.DownloadExtensions {
+		if len(ext) != 0 && ext == strings.ToLower(e) {
+			return LinkDownload
+		}
+	}
+	if len(u.Host) == 0 {
+		return LinkInternal
+	}
+	for _, host := range c.InternalHosts {
+		if strings.EqualFold(u.Host, host) {
+			return LinkInternal
+		}
+	}
+	return LinkExternal
+}
+
+var attrNameClass = []byte("class")
+
+type linkDecoratorASTTransformer struct {
+	LinkDecoratorConfig
+}
+
+// NewLinkDecoratorASTTransformer returns a new parser.ASTTransformer that
+// classifies links and decorates them with classes, attributes and icons.
+func NewLinkDecoratorASTTransformer(opts ...LinkDecoratorOption) parser.ASTTransformer {
+	a := &linkDecoratorASTTransformer{
+		LinkDecoratorConfig: NewLinkDecoratorConfig(),
+	}
+	for _, o := range opts {
+		o.SetLinkDecoratorOption(&a.LinkDecoratorConfig)
+	}
+	return a
+}
+
+func (a *linkDecoratorASTTransformer) Transform(node *gast.Document, reader text.Reader, pc parser.Context) {
+	source := reader.Source()
+	var links []gast.Node
+	_ = gast.Walk(node, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+		if entering && (n.Kind() == gast.KindLink || n.Kind() == gast.KindAutoLink) {
+			links = append(links, n)
+		}
+		return gast.WalkContinue, nil
+	})
+	for _, link := range links {
+		destination, _ := linkDestination(link, source)
+		decoration, ok := a.Decorations[a.ClassifyLink(destination)]
+		if !ok {
+			continue
+		}
+		if len(decoration.Class) != 0 {
+			if class, ok := link.Attribute(attrNameClass); ok {
+				link.SetAttribute(attrNameClass, []byte(string(class)+" "+decoration.Class))
+			} else {
+				link.SetAttribute(attrNameClass, []byte(decoration.Class))
+			}
+		}
+		names := make([]string, 0, len(decoration.Attributes))
+		for name := range decoration.Attributes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			link.SetAttribute([]byte(name), []byte(decoration.Attributes[name]))
+		}
+		if len(decoration.Icon) != 0 && link.Kind() == gast.KindLink {
+			icon := gast.NewString([]byte(decoration.Icon))
+			icon.SetCode(true)
+			link.AppendChild(link, icon)
+		}
+	}
+}
+
+type linkDecorator struct {
+	options []LinkDecoratorOption
+}
+
+// LinkDecorator is an extension that decorates external links, mailto links
+// and download links.
+var LinkDecorator = &linkDecorator{}
+
+// NewLinkDecorator returns a new Extender that decorates links with
+// the given options.
+func NewLinkDecorator(opts ...LinkDecoratorOption) goldmark.Extender {
+	return &linkDecorator{
+		options: opts,
+	}
+}
+
+func (e *linkDecorator) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithASTTransformers(
+			util.Prioritized(NewLinkDecoratorASTTransformer(e.options...), 999),
+		),
+	)
+}
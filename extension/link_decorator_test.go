@@ -0,0 +1,27 @@
+package extension
+
+import (
+	"testing"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/renderer/html"
+)
+
+func TestLinkDecorator(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithRendererOptions(
+			html.WithUnsafe(),
+		),
+		goldmark.WithExtensions(
+			NewLinkDecorator(
+				WithInternalHosts("example.com"),
+				WithLinkDecoration(LinkExternal, LinkDecoration{
+					Class:      "external",
+					Attributes: map[string]string{"rel": "noopener noreferrer"},
+					Icon:       `<span class="icon-external"></span>`,
+				}),
+			),
+		),
+	)
+	goldmark.DoTestCaseFile(markdown, "_test/link_decorator.txt", t)
+}
@@ -0,0 +1,393 @@
+package extension
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/lint"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// ErrUnsupportedLink is returned by LinkResolvers when the given
+// destination can not be checked by the resolver.
+var ErrUnsupportedLink = errors.New("unsupported link")
+
+// A LinkResolver interface checks whether link destinations exist.
+type LinkResolver interface {
+	// Resolve returns an error if the given destination does not exist.
+	Resolve(destination string) error
+}
+
+// LinkResolverFunc is a function that implements LinkResolver.
+type LinkResolverFunc func(destination string) error
+
+// Resolve implements LinkResolver.Resolve.
+func (f LinkResolverFunc) Resolve(destination string) error {
+	return f(destination)
+}
+
+func linkPath(destination string) string {
+	if i := strings.IndexAny(destination, "?#"); i > -1 {
+		destination = destination[:i]
+	}
+	if v, err := url.PathUnescape(destination); err == nil {
+		destination = v
+	}
+	return destination
+}
+
+// NewFileLinkResolver returns a new LinkResolver that checks whether local
+// files exist. Destinations are resolved relative to the given directory.
+func NewFileLinkResolver(dir string) LinkResolver {
+	return LinkResolverFunc(func(destination string) error {
+		if isRemoteDestination(destination) || strings.HasPrefix(destination, "mailto:") {
+			return ErrUnsupportedLink
+		}
+		path := linkPath(destination)
+		if len(path) == 0 {
+			return ErrUnsupportedLink
+		}
+		_, err := os.Stat(filepath.Join(dir, filepath.FromSlash(path)))
+		return err
+	})
+}
+
+// NewSitemapLinkResolver returns a new LinkResolver that checks whether
+// destinations are listed in the given sitemap.
+// Query strings and fragments of destinations are ignored.
+func NewSitemapLinkResolver(urls ...string) LinkResolver {
+	sitemap := map[string]bool{}
+	for _, u := range urls {
+		sitemap[linkPath(u)] = true
+	}
+	return LinkResolverFunc(func(destination string) error {
+		path := linkPath(destination)
+		if len(path) == 0 {
+			return ErrUnsupportedLink
+		}
+		if !sitemap[path] {
+			return fmt.Errorf("%s is not in the sitemap", path)
+		}
+		return nil
+	})
+}
+
+// NewHTTPLinkResolver returns a new LinkResolver that sends HEAD requests
+// to remote destinations.
+// If client is nil, http.DefaultClient will be used.
+func NewHTTPLinkResolver(client *http.Client) LinkResolver {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return LinkResolverFunc(func(destination string) error {
+		if !strings.HasPrefix(destination, "http://") && !strings.HasPrefix(destination, "https://") {
+			return ErrUnsupportedLink
+		}
+		res, err := client.Head(destination)
+		if err != nil {
+			return err
+		}
+		_ = res.Body.Close()
+		if res.StatusCode == http.StatusMethodNotAllowed {
+			// some servers do not support HEAD requests
+			res, err = client.Get(destination)
+			if err != nil {
+				return err
+			}
+			_ = res.Body.Close()
+		}
+		if res.StatusCode >= 400 {
+			return fmt.Errorf("%s: %s", destination, res.Status)
+		}
+		return nil
+	})
+}
+
+// NewLinkResolvers returns a new LinkResolver that tries the given
+// resolvers in order until a resolver supports a destination.
+func NewLinkResolvers(resolvers ...LinkResolver) LinkResolver {
+	return LinkResolverFunc(func(destination string) error {
+		for _, r := range resolvers {
+			if err := r.Resolve(destination); err != ErrUnsupportedLink {
+				return err
+			}
+		}
+		return ErrUnsupportedLink
+	})
+}
+
+// A BrokenLink struct represents a link whose destination does not exist.
+type BrokenLink struct {
+	// Node is a Link, Image or AutoLink node.
+	Node gast.Node
+
+	// Destination is a destination of the link.
+	Destination string
+
+	// Err is an error returned by the LinkResolver.
+	Err error
+
+	// Segment is a position of the link in the source.
+	Segment text.Segment
+
+	// Position is a line and column of the link in the source.
+	Position lint.Position
+}
+
+func (b BrokenLink) String() string {
+	return fmt.Sprintf("%s: broken link: %s", b.Position, b.Err)
+}
+
+var brokenLinksKey = parser.NewContextKey()
+
+// BrokenLinks returns broken links found while parsing a document
+// with the given context.
+func BrokenLinks(pc parser.Context) []BrokenLink {
+	v := pc.Get(brokenLinksKey)
+	if v == nil {
+		return nil
+	}
+	return v.([]BrokenLink)
+}
+
+// A LinkValidatorConfig struct is a data structure that holds configuration of the
+// LinkValidator extension.
+type LinkValidatorConfig struct {
+	// Resolver checks link destinations.
+	Resolver LinkResolver
+
+	// Handler is called for each broken link.
+	Handler func(BrokenLink)
+
+	// AttributeName and AttributeValue are set to broken links if
+	// AttributeName is not empty.
+	AttributeName  []byte
+	AttributeValue []byte
+
+	// Concurrency is a maximum number of destinations checked at the same time.
+	Concurrency int
+}
+
+// A LinkValidatorOption interface sets options for the LinkValidator extension.
+type LinkValidatorOption interface {
+	SetLinkValidatorOption(*LinkValidatorConfig)
+}
+
+type withLinkResolver struct {
+	value LinkResolver
+}
+
+func (o *withLinkResolver) SetLinkValidatorOption(c *LinkValidatorConfig) {
+	c.Resolver = o.value
+}
+
+// WithLinkResolver is a functional option that sets a resolver that checks
+// link destinations.
+func WithLinkResolver(resolver LinkResolver) LinkValidatorOption {
+	return &withLinkResolver{resolver}
+}
+
+type withBrokenLinkHandler struct {
+	value func(BrokenLink)
+}
+
+func (o *withBrokenLinkHandler) SetLinkValidatorOption(c *LinkValidatorConfig) {
+	c.Handler = o.value
+}
+
+// WithBrokenLinkHandler is a functional option that sets a function
+// called for each broken link.
+func WithBrokenLinkHandler(handler func(BrokenLink)) LinkValidatorOption {
+	return &withBrokenLinkHandler{handler}
+}
+
+type withBrokenLinkAttribute struct {
+	name  []byte
+	value []byte
+}
+
+func (o *withBrokenLinkAttribute) SetLinkValidatorOption(c *LinkValidatorConfig) {
+	c.AttributeName = o.name
+	c.AttributeValue = o.value
+}
+
+// WithBrokenLinkAttribute is a functional option that annotates broken links
+// with the given attribute(i.e. class="broken-link").
+func WithBrokenLinkAttribute(name, value string) LinkValidatorOption {
+	return &withBrokenLinkAttribute{[]byte(name), []byte(value)}
+}
+
+type withLinkValidatorConcurrency struct {
+	value int
+}
+
+func (o *withLinkValidatorConcurrency) SetLinkValidatorOption(c *LinkValidatorConfig) {
+	c.Concurrency = o.value
+}
+
+// WithLinkValidatorConcurrency is a functional option that limits a number of
+// destinations checked at the same time.
+func WithLinkValidatorConcurrency(n int) LinkValidatorOption {
+	return &withLinkValidatorConcurrency{n}
+}
+
+type linkValidatorASTTransformer struct {
+	LinkValidatorConfig
+}
+
+// NewLinkValidatorASTTransformer returns a new parser.ASTTransformer that
+// checks destinations of links and images.
+// Fragment only destinations like '#section' are checked against
+// ids in the document.
+func NewLinkValidatorASTTransformer(opts ...LinkValidatorOption) parser.ASTTransformer {
+	a := &linkValidatorASTTransformer{
+		LinkValidatorConfig: LinkValidatorConfig{
+			Resolver:    NewFileLinkResolver("."),
+			Concurrency: 4,
+		},
+	}
+	for _, o := range opts {
+		o.SetLinkValidatorOption(&a.LinkValidatorConfig)
+	}
+	return a
+}
+
+func linkDestination(n gast.Node, source []byte) (string, bool) {
+	switch v := n.(type) {
+	case *gast.Link:
+		return string(v.Destination), true
+	case *gast.Image:
+		return string(v.Destination), true
+	case *gast.AutoLink:
+		url := v.URL(source)
+		if v.AutoLinkType == gast.AutoLinkEmail && !strings.HasPrefix(string(url), "mailto:") {
+			return "mailto:" + string(url), true
+		}
+		return string(url), true
+	}
+	return "", false
+}
+
+func (a *linkValidatorASTTransformer) Transform(node *gast.Document, reader text.Reader, pc parser.Context) {
+	source := reader.Source()
+	var links []gast.Node
+	destinations := []string{}
+	results := map[string]error{}
+	ids := map[string]bool{}
+	_ = gast.Walk(node, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+		if !entering {
+			return gast.WalkContinue, nil
+		}
+		if id, ok := n.AttributeString("id"); ok {
+			ids[string(id)] = true
+		}
+		destination, ok := linkDestination(n, source)
+		if !ok {
+			return gast.WalkContinue, nil
+		}
+		links = append(links, n)
+		if _, ok := results[destination]; !ok && !strings.HasPrefix(destination, "#") {
+			results[destination] = nil
+			destinations = append(destinations, destination)
+		}
+		return gast.WalkContinue, nil
+	})
+	if len(links) == 0 {
+		return
+	}
+
+	var mutex sync.Mutex
+	resolve := func(destination string) {
+		err := a.Resolver.Resolve(destination)
+		if err == ErrUnsupportedLink {
+			err = nil
+		}
+		mutex.Lock()
+		results[destination] = err
+		mutex.Unlock()
+	}
+	if a.Concurrency <= 1 {
+		for _, destination := range destinations {
+			resolve(destination)
+		}
+	} else {
+		var wg sync.WaitGroup
+		semaphore := make(chan struct{}, a.Concurrency)
+		for _, destination := range destinations {
+			wg.Add(1)
+			semaphore <- struct{}{}
+			go func(destination string) {
+				defer func() {
+					<-semaphore
+					wg.Done()
+				}()
+				resolve(destination)
+			}(destination)
+		}
+		wg.Wait()
+	}
+
+	var brokenLinks []BrokenLink
+	for _, link := range links {
+		destination, _ := linkDestination(link, source)
+		var err error
+		if strings.HasPrefix(destination, "#") {
+			if len(destination) > 1 && !ids[destination[1:]] {
+				err = fmt.Errorf("%s is not found in the document", destination)
+			}
+		} else {
+			err = results[destination]
+		}
+		if err == nil {
+			continue
+		}
+		segment := lint.NodeSegment(link, source)
+		brokenLink := BrokenLink{
+			Node:        link,
+			Destination: destination,
+			Err:         err,
+			Segment:     segment,
+			Position:    lint.NewPosition(source, segment.Start),
+		}
+		brokenLinks = append(brokenLinks, brokenLink)
+		if len(a.AttributeName) != 0 {
+			link.SetAttribute(a.AttributeName, a.AttributeValue)
+		}
+		if a.Handler != nil {
+			a.Handler(brokenLink)
+		}
+	}
+	if len(brokenLinks) != 0 {
+		pc.Set(brokenLinksKey, brokenLinks)
+	}
+}
+
+type linkValidator struct {
+	options []LinkValidatorOption
+}
+
+// NewLinkValidator returns a new Extender that checks destinations of
+// links and reports broken links.
+func NewLinkValidator(opts ...LinkValidatorOption) goldmark.Extender {
+	return &linkValidator{
+		options: opts,
+	}
+}
+
+func (e *linkValidator) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithASTTransformers(
+			util.Prioritized(NewLinkValidatorASTTransformer(e.options...), 999),
+		),
+	)
+}
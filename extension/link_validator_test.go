@@ -0,0 +1,55 @@
+package extension
+
+import (
+	"testing"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+)
+
+func TestLinkValidator(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithParserOptions(
+			parser.WithAutoHeadingID(),
+		),
+		goldmark.WithRendererOptions(
+			html.WithXHTML(),
+			html.WithUnsafe(),
+		),
+		goldmark.WithExtensions(
+			NewLinkValidator(
+				WithLinkResolver(NewSitemapLinkResolver("/docs/install")),
+				WithBrokenLinkAttribute("class", "broken-link"),
+			),
+		),
+	)
+	goldmark.DoTestCaseFile(markdown, "_test/link_validator.txt", t)
+}
+
+func TestBrokenLinks(t *testing.T) {
+	var handled []BrokenLink
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			NewLinkValidator(
+				WithLinkResolver(NewLinkResolvers(
+					NewSitemapLinkResolver("/ok"),
+				)),
+				WithBrokenLinkHandler(func(b BrokenLink) {
+					handled = append(handled, b)
+				}),
+			),
+		),
+	)
+	source := []byte("[ok](/ok)\n\n- [ng](/ng)\n")
+	pc := parser.NewContext()
+	markdown.Parser().Parse(text.NewReader(source), parser.WithContext(pc))
+	brokenLinks := BrokenLinks(pc)
+	if len(brokenLinks) != 1 || len(handled) != 1 {
+		t.Fatalf("expected 1 broken link, but got %v", brokenLinks)
+	}
+	if brokenLinks[0].Destination != "/ng" || brokenLinks[0].Position.String() != "3:4" {
+		t.Errorf("unexpected broken link: %s", brokenLinks[0])
+	}
+}
@@ -2,27 +2,149 @@ package extension
 
 import (
 	"bytes"
+	"regexp"
+	"strings"
+
 	"github.com/yuin/goldmark"
 	"github.com/yuin/goldmark/ast"
 	"github.com/yuin/goldmark/parser"
 	"github.com/yuin/goldmark/text"
 	"github.com/yuin/goldmark/util"
-	"regexp"
 )
 
 var wwwURLRegxp = regexp.MustCompile(`^www\.[-a-zA-Z0-9@:%._\+~#=]{2,256}\.[a-z]{2,6}\b(?:[-a-zA-Z0-9@:%_\+.~#?&//=\(\);]*)`)
 
-var urlRegexp = regexp.MustCompile(`^(?:http|https|ftp):\/\/(?:www\.)?[-a-zA-Z0-9@:%._\+~#=]{2,256}\.[a-z]{2,6}\b([-a-zA-Z0-9@:%_\+.~#?&//=\(\);]*)`)
+var urlRegexp = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9+.\-]*:\/\/(?:www\.)?[-a-zA-Z0-9@:%._\+~#=]{2,256}\.[a-z]{2,6}\b([-a-zA-Z0-9@:%_\+.~#?&//=\(\);]*)`)
+
+// A LinkifyConfig struct is a data structure that holds configuration of
+// the Linkify extension.
+type LinkifyConfig struct {
+	// AllowedProtocols is a list of protocols of URLs that are linked like
+	// 'https'.
+	AllowedProtocols [][]byte
+
+	// WWW is true if URLs without protocols like 'www.example.com' are
+	// linked.
+	WWW bool
+
+	// TLDs is a set of lower-cased top level domains like 'com'. URLs and
+	// email addresses are linked only if their domains end with one of
+	// them. All top level domains are allowed if TLDs is nil.
+	TLDs map[string]bool
+
+	// Rewriter vetoes or rewrites URLs found by the Linkify extension.
+	Rewriter LinkifyRewriter
+}
+
+// LinkifyRewriter is a function that vetoes or rewrites a URL found by the
+// Linkify extension. The given URL is a URL with a protocol like
+// 'http://www.example.com' or an email address like 'john@example.com'.
+// LinkifyRewriter returns a destination of the link and false if the URL
+// should not be linked. If the destination differs from the given URL, the
+// URL is parsed as an ast.Link instead of an ast.AutoLink.
+type LinkifyRewriter func(url []byte, typ ast.AutoLinkType) ([]byte, bool)
+
+// NewLinkifyConfig returns a new LinkifyConfig with defaults.
+func NewLinkifyConfig() LinkifyConfig {
+	return LinkifyConfig{
+		AllowedProtocols: [][]byte{[]byte("http"), []byte("https"), []byte("ftp")},
+		WWW:              true,
+	}
+}
+
+// A LinkifyOption interface sets options for the Linkify extension.
+type LinkifyOption interface {
+	SetLinkifyOption(*LinkifyConfig)
+}
+
+type withLinkifyAllowedProtocols struct {
+	value [][]byte
+}
+
+func (o *withLinkifyAllowedProtocols) SetLinkifyOption(c *LinkifyConfig) {
+	c.AllowedProtocols = o.value
+}
+
+// WithLinkifyAllowedProtocols is a functional option that sets protocols
+// of URLs that are linked like 'https' and 'ssh'. The defaults are
+// 'http', 'https' and 'ftp'.
+func WithLinkifyAllowedProtocols(protocols ...string) LinkifyOption {
+	value := make([][]byte, 0, len(protocols))
+	for _, protocol := range protocols {
+		value = append(value, []byte(strings.ToLower(protocol)))
+	}
+	return &withLinkifyAllowedProtocols{value}
+}
+
+type withLinkifyWWW struct {
+	value bool
+}
+
+func (o *withLinkifyWWW) SetLinkifyOption(c *LinkifyConfig) {
+	c.WWW = o.value
+}
+
+// WithLinkifyWWW is a functional option that indicates whether URLs
+// without protocols like 'www.example.com' are linked. The default is
+// true.
+func WithLinkifyWWW(enabled bool) LinkifyOption {
+	return &withLinkifyWWW{enabled}
+}
+
+type withLinkifyTLDs struct {
+	value []string
+}
+
+func (o *withLinkifyTLDs) SetLinkifyOption(c *LinkifyConfig) {
+	c.TLDs = map[string]bool{}
+	for _, tld := range o.value {
+		c.TLDs[strings.ToLower(strings.TrimPrefix(tld, "."))] = true
+	}
+}
+
+// WithLinkifyTLDs is a functional option that allows only URLs and email
+// addresses whose domains end with the given top level domains like 'com'
+// and 'org' to reduce false positives like 'john@example.sh' in prose.
+func WithLinkifyTLDs(tlds ...string) LinkifyOption {
+	return &withLinkifyTLDs{tlds}
+}
+
+type withLinkifyRewriter struct {
+	value LinkifyRewriter
+}
+
+func (o *withLinkifyRewriter) SetLinkifyOption(c *LinkifyConfig) {
+	c.Rewriter = o.value
+}
+
+// WithLinkifyRewriter is a functional option that sets a function that
+// vetoes or rewrites URLs found by the Linkify extension like adding query
+// parameters and routing through redirectors.
+func WithLinkifyRewriter(rewriter LinkifyRewriter) LinkifyOption {
+	return &withLinkifyRewriter{rewriter}
+}
 
 type linkifyParser struct {
+	LinkifyConfig
 }
 
-var defaultLinkifyParser = &linkifyParser{}
+var defaultLinkifyParser = &linkifyParser{
+	LinkifyConfig: NewLinkifyConfig(),
+}
 
 // NewLinkifyParser return a new InlineParser can parse
 // text that seems like a URL.
-func NewLinkifyParser() parser.InlineParser {
-	return defaultLinkifyParser
+func NewLinkifyParser(opts ...LinkifyOption) parser.InlineParser {
+	if len(opts) == 0 {
+		return defaultLinkifyParser
+	}
+	p := &linkifyParser{
+		LinkifyConfig: NewLinkifyConfig(),
+	}
+	for _, o := range opts {
+		o.SetLinkifyOption(&p.LinkifyConfig)
+	}
+	return p
 }
 
 func (s *linkifyParser) Trigger() []byte {
@@ -30,11 +152,50 @@ func (s *linkifyParser) Trigger() []byte {
 	return []byte{' ', '*', '_', '~', '('}
 }
 
-var protoHTTP = []byte("http:")
-var protoHTTPS = []byte("https:")
-var protoFTP = []byte("ftp:")
 var domainWWW = []byte("www.")
 
+// isAllowedProtocol returns true if the given line starts with a URL with
+// an allowed protocol like 'https://'.
+func (s *linkifyParser) isAllowedProtocol(line []byte) bool {
+	for _, protocol := range s.AllowedProtocols {
+		if len(line) > len(protocol)+3 && bytes.EqualFold(line[:len(protocol)], protocol) &&
+			bytes.HasPrefix(line[len(protocol):], []byte("://")) {
+			return true
+		}
+	}
+	return false
+}
+
+// isAllowedDomain returns true if the given domain ends with an allowed
+// top level domain.
+func (s *linkifyParser) isAllowedDomain(domain []byte) bool {
+	if s.TLDs == nil {
+		return true
+	}
+	if i := bytes.LastIndexByte(domain, '.'); i > -1 {
+		domain = domain[i+1:]
+	}
+	return s.TLDs[string(bytes.ToLower(domain))]
+}
+
+// linkifyURLDomain returns a domain of the given URL like
+// 'https://user@example.com:8080/path'.
+func linkifyURLDomain(url []byte) []byte {
+	if i := bytes.Index(url, []byte("://")); i > -1 {
+		url = url[i+3:]
+	}
+	if i := bytes.IndexAny(url, "/?#"); i > -1 {
+		url = url[:i]
+	}
+	if i := bytes.LastIndexByte(url, '@'); i > -1 {
+		url = url[i+1:]
+	}
+	if i := bytes.IndexByte(url, ':'); i > -1 {
+		url = url[:i]
+	}
+	return url
+}
+
 func (s *linkifyParser) Parse(parent ast.Node, block text.Reader, pc parser.Context) ast.Node {
 	line, segment := block.PeekLine()
 	consumes := 0
@@ -50,10 +211,10 @@ func (s *linkifyParser) Parse(parent ast.Node, block text.Reader, pc parser.Cont
 	var m []int
 	var protocol []byte
 	var typ ast.AutoLinkType = ast.AutoLinkURL
-	if bytes.HasPrefix(line, protoHTTP) || bytes.HasPrefix(line, protoHTTPS) || bytes.HasPrefix(line, protoFTP) {
+	if s.isAllowedProtocol(line) {
 		m = urlRegexp.FindSubmatchIndex(line)
 	}
-	if m == nil && bytes.HasPrefix(line, domainWWW) {
+	if m == nil && s.WWW && bytes.HasPrefix(line, domainWWW) {
 		m = wwwURLRegxp.FindSubmatchIndex(line)
 		protocol = []byte("http")
 	}
@@ -113,6 +274,27 @@ func (s *linkifyParser) Parse(parent ast.Node, block text.Reader, pc parser.Cont
 	if m == nil {
 		return nil
 	}
+	if typ == ast.AutoLinkEmail {
+		if !s.isAllowedDomain(line[m[2]+1 : m[1]]) {
+			return nil
+		}
+	} else if !s.isAllowedDomain(linkifyURLDomain(line[:m[1]])) {
+		return nil
+	}
+	var destination []byte
+	if s.Rewriter != nil {
+		url := line[:m[1]]
+		if protocol != nil {
+			url = append(append(append([]byte{}, protocol...), ':', '/', '/'), url...)
+		}
+		v, ok := s.Rewriter(url, typ)
+		if !ok {
+			return nil
+		}
+		if !bytes.Equal(v, url) {
+			destination = v
+		}
+	}
 	if consumes != 0 {
 		s := segment.WithStop(segment.Start + 1)
 		ast.MergeOrAppendTextSegment(parent, s)
@@ -120,6 +302,12 @@ func (s *linkifyParser) Parse(parent ast.Node, block text.Reader, pc parser.Cont
 	consumes += m[1]
 	block.Advance(consumes)
 	n := ast.NewTextSegment(text.NewSegment(start, start+m[1]))
+	if destination != nil {
+		link := ast.NewLink()
+		link.Destination = destination
+		link.AppendChild(link, n)
+		return link
+	}
 	link := ast.NewAutoLink(typ, n)
 	link.Protocol = protocol
 	return link
@@ -130,13 +318,22 @@ func (s *linkifyParser) CloseBlock(parent ast.Node, pc parser.Context) {
 }
 
 type linkify struct {
+	options []LinkifyOption
 }
 
 // Linkify is an extension that allow you to parse text that seems like a URL.
 var Linkify = &linkify{}
 
+// NewLinkify returns a new Extender that allow you to parse text that
+// seems like a URL with the given options.
+func NewLinkify(opts ...LinkifyOption) goldmark.Extender {
+	return &linkify{
+		options: opts,
+	}
+}
+
 func (e *linkify) Extend(m goldmark.Markdown) {
 	m.Parser().AddOptions(parser.WithInlineParsers(
-		util.Prioritized(NewLinkifyParser(), 999),
+		util.Prioritized(NewLinkifyParser(e.options...), 999),
 	))
 }
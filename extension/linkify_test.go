@@ -1,9 +1,12 @@
 package extension
 
 import (
+	"bytes"
+	"testing"
+
 	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
 	"github.com/yuin/goldmark/renderer/html"
-	"testing"
 )
 
 func TestLinkify(t *testing.T) {
@@ -17,3 +20,52 @@ func TestLinkify(t *testing.T) {
 	)
 	goldmark.DoTestCaseFile(markdown, "_test/linkify.txt", t)
 }
+
+func TestLinkifyOptions(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			NewLinkify(
+				WithLinkifyAllowedProtocols("https", "ssh"),
+				WithLinkifyWWW(false),
+				WithLinkifyTLDs("com", ".org"),
+			),
+		),
+	)
+	goldmark.DoTestCases(markdown, []goldmark.MarkdownTestCase{
+		{
+			No:       1,
+			Markdown: "https://example.com ssh://git@example.org:22/repo http://example.com ftp://example.com",
+			Expected: `<p><a href="https://example.com">https://example.com</a> <a href="ssh://git@example.org:22/repo">ssh://git@example.org:22/repo</a> http://example.com ftp://example.com</p>`,
+		},
+		{
+			No:       2,
+			Markdown: "www.example.com https://example.sh john@example.sh john@example.COM",
+			Expected: `<p>www.example.com https://example.sh john@example.sh <a href="mailto:john@example.COM">john@example.COM</a></p>`,
+		},
+	}, t)
+}
+
+func TestLinkifyRewriter(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			NewLinkify(
+				WithLinkifyRewriter(func(url []byte, typ ast.AutoLinkType) ([]byte, bool) {
+					if typ == ast.AutoLinkEmail {
+						return nil, false
+					}
+					if bytes.Contains(url, []byte("example.org")) {
+						return url, true
+					}
+					return append(url, "?utm_source=docs"...), true
+				}),
+			),
+		),
+	)
+	goldmark.DoTestCases(markdown, []goldmark.MarkdownTestCase{
+		{
+			No:       1,
+			Markdown: "www.example.com https://example.org john@example.com",
+			Expected: `<p><a href="http://www.example.com?utm_source=docs">www.example.com</a> <a href="https://example.org">https://example.org</a> john@example.com</p>`,
+		},
+	}, t)
+}
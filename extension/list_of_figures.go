@@ -0,0 +1,302 @@
+package extension
+
+import (
+	"bytes"
+	"strconv"
+
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// CaptionFunc returns a caption of the given node and true if the node
+// is a captioned item, otherwise nil and false.
+type CaptionFunc func(n gast.Node, source []byte) ([]byte, bool)
+
+// FigureCaption is a default CaptionFunc for figures.
+// A Figure node created by the Figure extension is a figure, and its caption
+// is used as a caption.
+// An image that is the sole content of a paragraph is also a figure, and its
+// title or alt text is used as a caption.
+func FigureCaption(n gast.Node, source []byte) ([]byte, bool) {
+	if n.Kind() == ast.KindFigure {
+		for c := n.FirstChild(); c != nil; c = c.NextSibling() {
+			if c.Kind() == ast.KindFigureCaption {
+				return c.Text(source), true
+			}
+		}
+		return nil, true
+	}
+	image, ok := n.(*gast.Image)
+	if !ok {
+		return nil, false
+	}
+	parent := n.Parent()
+	if parent == nil || parent.Kind() != gast.KindParagraph {
+		return nil, false
+	}
+	for c := parent.FirstChild(); c != nil; c = c.NextSibling() {
+		if c == n {
+			continue
+		}
+		if t, ok := c.(*gast.Text); !ok || !util.IsBlank(t.Segment.Value(source)) {
+			return nil, false
+		}
+	}
+	if len(image.Title) != 0 {
+		return image.Title, true
+	}
+	return image.Text(source), true
+}
+
+var tableCaptionPrefix = []byte("Table:")
+
+// TableCaption is a default CaptionFunc for tables.
+// A paragraph starts with 'Table:' just after a table is used as
+// a caption of the table.
+func TableCaption(n gast.Node, source []byte) ([]byte, bool) {
+	if n.Kind() != ast.KindTable {
+		return nil, false
+	}
+	if next := n.NextSibling(); next != nil && next.Kind() == gast.KindParagraph {
+		lines := next.Lines()
+		if lines.Len() != 0 {
+			value := lines.Value(source)
+			if bytes.HasPrefix(value, tableCaptionPrefix) {
+				return util.TrimLeftSpace(util.TrimRightSpace(value[len(tableCaptionPrefix):])), true
+			}
+		}
+	}
+	return nil, true
+}
+
+// A ListOfFiguresConfig struct is a data structure that holds configuration of the
+// ListOfFigures extension.
+type ListOfFiguresConfig struct {
+	// FigureMarker is a paragraph that is replaced with a list of figures.
+	FigureMarker []byte
+
+	// TableMarker is a paragraph that is replaced with a list of tables.
+	TableMarker []byte
+
+	// FigureLabel is a label of figures like 'Figure'.
+	FigureLabel []byte
+
+	// TableLabel is a label of tables like 'Table'.
+	TableLabel []byte
+
+	// FigureCaption finds figures and their captions.
+	FigureCaption CaptionFunc
+
+	// TableCaption finds tables and their captions.
+	TableCaption CaptionFunc
+}
+
+// NewListOfFiguresConfig returns a new ListOfFiguresConfig with defaults.
+func NewListOfFiguresConfig() ListOfFiguresConfig {
+	return ListOfFiguresConfig{
+		FigureMarker:  []byte("[LOF]"),
+		TableMarker:   []byte("[LOT]"),
+		FigureLabel:   []byte("Figure"),
+		TableLabel:    []byte("Table"),
+		FigureCaption: FigureCaption,
+		TableCaption:  TableCaption,
+	}
+}
+
+// A ListOfFiguresOption interface sets options for the ListOfFigures extension.
+type ListOfFiguresOption interface {
+	SetListOfFiguresOption(*ListOfFiguresConfig)
+}
+
+type withListOfFiguresMarkers struct {
+	figure []byte
+	table  []byte
+}
+
+func (o *withListOfFiguresMarkers) SetListOfFiguresOption(c *ListOfFiguresConfig) {
+	c.FigureMarker = o.figure
+	c.TableMarker = o.table
+}
+
+// WithListOfFiguresMarkers is a functional option that sets placeholder
+// markers of a list of figures and a list of tables.
+func WithListOfFiguresMarkers(figure, table string) ListOfFiguresOption {
+	return &withListOfFiguresMarkers{[]byte(figure), []byte(table)}
+}
+
+type withListOfFiguresLabels struct {
+	figure []byte
+	table  []byte
+}
+
+func (o *withListOfFiguresLabels) SetListOfFiguresOption(c *ListOfFiguresConfig) {
+	c.FigureLabel = o.figure
+	c.TableLabel = o.table
+}
+
+// WithListOfFiguresLabels is a functional option that sets labels of
+// figures and tables.
+func WithListOfFiguresLabels(figure, table string) ListOfFiguresOption {
+	return &withListOfFiguresLabels{[]byte(figure), []byte(table)}
+}
+
+type withCaptionFuncs struct {
+	figure CaptionFunc
+	table  CaptionFunc
+}
+
+func (o *withCaptionFuncs) SetListOfFiguresOption(c *ListOfFiguresConfig) {
+	if o.figure != nil {
+		c.FigureCaption = o.figure
+	}
+	if o.table != nil {
+		c.TableCaption = o.table
+	}
+}
+
+// WithCaptionFuncs is a functional option that sets functions that find
+// figures and tables. nil means the default function.
+func WithCaptionFuncs(figure, table CaptionFunc) ListOfFiguresOption {
+	return &withCaptionFuncs{figure, table}
+}
+
+var attrNameListOfFiguresID = []byte("id")
+
+type captionedItem struct {
+	node    gast.Node
+	caption []byte
+}
+
+type listOfFiguresASTTransformer struct {
+	ListOfFiguresConfig
+}
+
+// NewListOfFiguresASTTransformer returns a new parser.ASTTransformer that
+// replaces placeholder markers with lists of figures and tables.
+// Figures and tables are numbered and given ids like 'figure-1' if they
+// do not have ids.
+func NewListOfFiguresASTTransformer(opts ...ListOfFiguresOption) parser.ASTTransformer {
+	a := &listOfFiguresASTTransformer{
+		ListOfFiguresConfig: NewListOfFiguresConfig(),
+	}
+	for _, o := range opts {
+		o.SetListOfFiguresOption(&a.ListOfFiguresConfig)
+	}
+	return a
+}
+
+func isMarkerParagraph(n gast.Node, marker []byte, source []byte) bool {
+	if n.Kind() != gast.KindParagraph || len(marker) == 0 {
+		return false
+	}
+	return bytes.Equal(util.TrimRightSpace(util.TrimLeftSpace(n.Lines().Value(source))), marker)
+}
+
+func (a *listOfFiguresASTTransformer) Transform(node *gast.Document, reader text.Reader, pc parser.Context) {
+	source := reader.Source()
+	var figures, tables []captionedItem
+	var figureMarkers, tableMarkers []gast.Node
+	_ = gast.Walk(node, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+		if !entering {
+			return gast.WalkContinue, nil
+		}
+		if isMarkerParagraph(n, a.FigureMarker, source) {
+			figureMarkers = append(figureMarkers, n)
+			return gast.WalkSkipChildren, nil
+		}
+		if isMarkerParagraph(n, a.TableMarker, source) {
+			tableMarkers = append(tableMarkers, n)
+			return gast.WalkSkipChildren, nil
+		}
+		if caption, ok := a.FigureCaption(n, source); ok {
+			figures = append(figures, captionedItem{n, caption})
+		} else if caption, ok := a.TableCaption(n, source); ok {
+			tables = append(tables, captionedItem{n, caption})
+		}
+		return gast.WalkContinue, nil
+	})
+	if len(figureMarkers) != 0 {
+		entries := numberCaptionedItems(figures, a.FigureLabel, "figure-")
+		for _, marker := range figureMarkers {
+			marker.Parent().ReplaceChild(marker.Parent(), marker, newListOfFigures(entries, "list-of-figures"))
+		}
+	}
+	if len(tableMarkers) != 0 {
+		entries := numberCaptionedItems(tables, a.TableLabel, "table-")
+		for _, marker := range tableMarkers {
+			marker.Parent().ReplaceChild(marker.Parent(), marker, newListOfFigures(entries, "list-of-tables"))
+		}
+	}
+}
+
+type listOfFiguresEntry struct {
+	id    []byte
+	title []byte
+}
+
+// numberCaptionedItems numbers the given items and gives them ids.
+func numberCaptionedItems(items []captionedItem, label []byte, prefix string) []listOfFiguresEntry {
+	entries := make([]listOfFiguresEntry, 0, len(items))
+	for i, item := range items {
+		number := strconv.Itoa(i + 1)
+		id, ok := item.node.AttributeString("id")
+		if !ok {
+			id = []byte(prefix + number)
+			item.node.SetAttribute(attrNameListOfFiguresID, id)
+		}
+		title := make([]byte, 0, len(label)+len(number)+len(item.caption)+3)
+		title = append(title, label...)
+		title = append(title, ' ')
+		title = append(title, number...)
+		if len(item.caption) != 0 {
+			title = append(title, ": "...)
+			title = append(title, item.caption...)
+		}
+		entries = append(entries, listOfFiguresEntry{id, title})
+	}
+	return entries
+}
+
+func newListOfFigures(entries []listOfFiguresEntry, class string) gast.Node {
+	list := gast.NewList('-')
+	list.SetAttribute(attrNameClass, []byte(class))
+	for _, entry := range entries {
+		item := gast.NewListItem(2)
+		block := gast.NewTextBlock()
+		link := gast.NewLink()
+		link.Destination = append([]byte{'#'}, entry.id...)
+		link.AppendChild(link, gast.NewString(entry.title))
+		block.AppendChild(block, link)
+		item.AppendChild(item, block)
+		list.AppendChild(list, item)
+	}
+	return list
+}
+
+type listOfFigures struct {
+	options []ListOfFiguresOption
+}
+
+// ListOfFigures is an extension that replaces '[LOF]' and '[LOT]' with
+// a list of figures and a list of tables.
+var ListOfFigures = &listOfFigures{}
+
+// NewListOfFigures returns a new Extender that generates lists of
+// figures and tables with the given options.
+func NewListOfFigures(opts ...ListOfFiguresOption) goldmark.Extender {
+	return &listOfFigures{
+		options: opts,
+	}
+}
+
+func (e *listOfFigures) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithASTTransformers(
+			util.Prioritized(NewListOfFiguresASTTransformer(e.options...), 1000),
+		),
+	)
+}
@@ -0,0 +1,22 @@
+package extension
+
+import (
+	"testing"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/renderer/html"
+)
+
+func TestListOfFigures(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithRendererOptions(
+			html.WithXHTML(),
+			html.WithUnsafe(),
+		),
+		goldmark.WithExtensions(
+			Table,
+			ListOfFigures,
+		),
+	)
+	goldmark.DoTestCaseFile(markdown, "_test/list_of_figures.txt", t)
+}
@@ -0,0 +1,302 @@
+package extension
+
+import (
+	"bytes"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// A MediaConfig struct is a data structure that holds configuration of the
+// Media extension.
+type MediaConfig struct {
+	// VideoExtensions is a list of file extensions like '.mp4' that
+	// are rendered as videos.
+	VideoExtensions []string
+
+	// AudioExtensions is a list of file extensions like '.mp3' that
+	// are rendered as audios.
+	AudioExtensions []string
+
+	// VideoAttributes is a set of attributes of video elements.
+	// An empty value means a boolean attribute like 'controls'.
+	VideoAttributes map[string]string
+
+	// AudioAttributes is a set of attributes of audio elements.
+	// An empty value means a boolean attribute like 'controls'.
+	AudioAttributes map[string]string
+}
+
+// NewMediaConfig returns a new MediaConfig with defaults.
+func NewMediaConfig() MediaConfig {
+	return MediaConfig{
+		VideoExtensions: []string{".mp4", ".m4v", ".mov", ".ogv", ".webm"},
+		AudioExtensions: []string{".flac", ".m4a", ".mp3", ".oga", ".ogg", ".opus", ".wav"},
+		VideoAttributes: map[string]string{"controls": ""},
+		AudioAttributes: map[string]string{"controls": ""},
+	}
+}
+
+// MediaType returns a type of the media that the given destination points to.
+// MediaType returns 0 if the destination is not a media.
+func (c *MediaConfig) MediaType(destination []byte) ast.MediaType {
+	p := string(destination)
+	if i := strings.IndexAny(p, "?#"); i > -1 {
+		p = p[:i]
+	}
+	ext := strings.ToLower(path.Ext(p))
+	if len(ext) == 0 {
+		return 0
+	}
+	for _, e := range c.VideoExtensions {
+		if ext == e {
+			return ast.MediaVideo
+		}
+	}
+	for _, e := range c.AudioExtensions {
+		if ext == e {
+			return ast.MediaAudio
+		}
+	}
+	return 0
+}
+
+// A MediaOption interface sets options for the Media extension.
+type MediaOption interface {
+	SetMediaOption(*MediaConfig)
+}
+
+type withMediaExtensions struct {
+	video []string
+	audio []string
+}
+
+func (o *withMediaExtensions) SetMediaOption(c *MediaConfig) {
+	if o.video != nil {
+		c.VideoExtensions = o.video
+	}
+	if o.audio != nil {
+		c.AudioExtensions = o.audio
+	}
+}
+
+// WithMediaExtensions is a functional option that sets file extensions like
+// '.mp4' of videos and audios. nil means the default extensions.
+func WithMediaExtensions(video, audio []string) MediaOption {
+	return &withMediaExtensions{video, audio}
+}
+
+type withMediaAttributes struct {
+	video map[string]string
+	audio map[string]string
+}
+
+func (o *withMediaAttributes) SetMediaOption(c *MediaConfig) {
+	if o.video != nil {
+		c.VideoAttributes = o.video
+	}
+	if o.audio != nil {
+		c.AudioAttributes = o.audio
+	}
+}
+
+// WithMediaAttributes is a functional option that sets attributes of
+// video elements and audio elements like 'controls' and 'preload'.
+// nil means the default attributes.
+func WithMediaAttributes(video, audio map[string]string) MediaOption {
+	return &withMediaAttributes{video, audio}
+}
+
+type mediaASTTransformer struct {
+	MediaConfig
+}
+
+// NewMediaASTTransformer returns a new parser.ASTTransformer that
+// replaces images pointing at videos and audios and links written like
+// '!video[alt](movie)' and '!audio[alt](song)' with Media nodes.
+func NewMediaASTTransformer(opts ...MediaOption) parser.ASTTransformer {
+	a := &mediaASTTransformer{
+		MediaConfig: NewMediaConfig(),
+	}
+	for _, o := range opts {
+		o.SetMediaOption(&a.MediaConfig)
+	}
+	return a
+}
+
+// mediaMarkers are prefixes of links like '!video[alt](movie)' that are
+// rendered as media regardless of their file extensions.
+var mediaMarkers = []struct {
+	marker    []byte
+	mediaType ast.MediaType
+}{
+	{[]byte("!video"), ast.MediaVideo},
+	{[]byte("!audio"), ast.MediaAudio},
+}
+
+// explicitMediaType returns a type of the media if the given link is written
+// like '!video[alt](movie)', and trims the marker from the preceding text.
+func explicitMediaType(link *gast.Link, source []byte) ast.MediaType {
+	t, ok := link.PreviousSibling().(*gast.Text)
+	if !ok || t.SoftLineBreak() || t.HardLineBreak() || t.IsRaw() {
+		return 0
+	}
+	value := t.Segment.Value(source)
+	for _, m := range mediaMarkers {
+		if !bytes.HasSuffix(value, m.marker) {
+			continue
+		}
+		if l := len(value) - len(m.marker); l > 0 && value[l-1] == '\\' {
+			return 0
+		}
+		t.Segment = t.Segment.WithStop(t.Segment.Stop - len(m.marker))
+		if t.Segment.IsEmpty() {
+			t.Parent().RemoveChild(t.Parent(), t)
+		}
+		return m.mediaType
+	}
+	return 0
+}
+
+func (a *mediaASTTransformer) Transform(node *gast.Document, reader text.Reader, pc parser.Context) {
+	source := reader.Source()
+	var nodes []gast.Node
+	_ = gast.Walk(node, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+		if entering && (n.Kind() == gast.KindImage || n.Kind() == gast.KindLink) {
+			nodes = append(nodes, n)
+		}
+		return gast.WalkContinue, nil
+	})
+	for _, n := range nodes {
+		var media *ast.Media
+		switch v := n.(type) {
+		case *gast.Image:
+			typ := a.MediaType(v.Destination)
+			if typ == 0 {
+				continue
+			}
+			media = ast.NewMedia(typ, v.Destination, v.Title)
+		case *gast.Link:
+			typ := explicitMediaType(v, source)
+			if typ == 0 {
+				continue
+			}
+			media = ast.NewMedia(typ, v.Destination, v.Title)
+		}
+		for _, attr := range n.Attributes() {
+			media.SetAttribute(attr.Name, attr.Value)
+		}
+		moveChildren(media, n.FirstChild())
+		n.Parent().ReplaceChild(n.Parent(), n, media)
+	}
+}
+
+// MediaHTMLRenderer is a renderer.NodeRenderer implementation that
+// renders Media nodes.
+type MediaHTMLRenderer struct {
+	html.Config
+	MediaConfig
+}
+
+// NewMediaHTMLRenderer returns a new MediaHTMLRenderer.
+func NewMediaHTMLRenderer(opts ...MediaOption) renderer.NodeRenderer {
+	r := &MediaHTMLRenderer{
+		Config:      html.NewConfig(),
+		MediaConfig: NewMediaConfig(),
+	}
+	for _, opt := range opts {
+		opt.SetMediaOption(&r.MediaConfig)
+	}
+	return r
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *MediaHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindMedia, r.renderMedia)
+}
+
+func (r *MediaHTMLRenderer) renderMedia(w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	n := node.(*ast.Media)
+	tag := n.MediaType.String()
+	if !entering {
+		_, _ = w.WriteString("</")
+		_, _ = w.WriteString(tag)
+		_ = w.WriteByte('>')
+		return gast.WalkContinue, nil
+	}
+	_ = w.WriteByte('<')
+	_, _ = w.WriteString(tag)
+	_, _ = w.WriteString(` src="`)
+	if r.Unsafe || !html.IsDangerousURL(n.Destination) {
+		_, _ = w.Write(util.EscapeHTML(util.URLEscape(n.Destination, true)))
+	}
+	_ = w.WriteByte('"')
+	if n.Title != nil {
+		_, _ = w.WriteString(` title="`)
+		r.Writer.Write(w, n.Title)
+		_ = w.WriteByte('"')
+	}
+	if n.Attributes() != nil {
+		r.RenderAttributes(w, n)
+	}
+	attrs := r.VideoAttributes
+	if n.MediaType == ast.MediaAudio {
+		attrs = r.AudioAttributes
+	}
+	names := make([]string, 0, len(attrs))
+	for name := range attrs {
+		if _, ok := n.AttributeString(name); !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		_ = w.WriteByte(' ')
+		_, _ = w.WriteString(name)
+		if value := attrs[name]; len(value) != 0 || r.XHTML {
+			if len(value) == 0 {
+				value = name
+			}
+			_, _ = w.WriteString(`="`)
+			_, _ = w.Write(util.EscapeHTML([]byte(value)))
+			_ = w.WriteByte('"')
+		}
+	}
+	_ = w.WriteByte('>')
+	return gast.WalkContinue, nil
+}
+
+type media struct {
+	options []MediaOption
+}
+
+// Media is an extension that renders images pointing at videos and audios
+// like '![alt](movie.mp4)' and links like '!video[alt](movie)' as video
+// elements and audio elements.
+var Media = &media{}
+
+// NewMedia returns a new Extender that renders videos and audios
+// with the given options.
+func NewMedia(opts ...MediaOption) goldmark.Extender {
+	return &media{
+		options: opts,
+	}
+}
+
+func (e *media) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(parser.WithASTTransformers(
+		util.Prioritized(NewMediaASTTransformer(e.options...), 600),
+	))
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(NewMediaHTMLRenderer(e.options...), 500),
+	))
+}
@@ -0,0 +1,37 @@
+package extension
+
+import (
+	"testing"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/renderer/html"
+)
+
+func TestMedia(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			Media,
+		),
+	)
+	goldmark.DoTestCaseFile(markdown, "_test/media.txt", t)
+}
+
+func TestMediaOptions(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithRendererOptions(
+			html.WithXHTML(),
+		),
+		goldmark.WithExtensions(
+			NewMedia(
+				WithMediaExtensions([]string{".mkv"}, nil),
+				WithMediaAttributes(map[string]string{"controls": "", "preload": "none"}, nil),
+			),
+			ImageDimensions,
+		),
+	)
+	goldmark.DoTestCases(markdown, []goldmark.MarkdownTestCase{{
+		No:       1,
+		Markdown: `![Movie](movie.mkv =640x) ![Other](movie.mp4)`,
+		Expected: `<p><video src="movie.mkv" width="640" controls="controls" preload="none">Movie</video> <img src="movie.mp4" alt="Other" /></p>`,
+	}}, t)
+}
@@ -0,0 +1,222 @@
+package extension
+
+import (
+	"unicode"
+
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// A MentionResolver interface validates and resolves mentioned users.
+type MentionResolver interface {
+	// Resolve returns a destination URL of the given user like a profile
+	// page, and whether the user exists. An empty destination means the
+	// mention is not linked. Mentions of users that do not exist are
+	// rendered as plain texts.
+	Resolve(username string) (destination string, exists bool)
+}
+
+// MentionResolverFunc is a function that implements MentionResolver.
+type MentionResolverFunc func(username string) (string, bool)
+
+// Resolve implements MentionResolver.Resolve.
+func (f MentionResolverFunc) Resolve(username string) (string, bool) {
+	return f(username)
+}
+
+// A MentionConfig struct is a data structure that holds configuration of
+// the Mention extension.
+type MentionConfig struct {
+	// Resolver validates and resolves mentioned users. All users are
+	// treated as existing users and mentions are not linked if Resolver
+	// is nil.
+	Resolver MentionResolver
+}
+
+// NewMentionConfig returns a new MentionConfig with defaults.
+func NewMentionConfig() MentionConfig {
+	return MentionConfig{}
+}
+
+// A MentionOption interface sets options for the Mention extension.
+type MentionOption interface {
+	SetMentionOption(*MentionConfig)
+}
+
+type withMentionResolver struct {
+	value MentionResolver
+}
+
+func (o *withMentionResolver) SetMentionOption(c *MentionConfig) {
+	c.Resolver = o.value
+}
+
+// WithMentionResolver is a functional option that sets a resolver that
+// validates and resolves mentioned users.
+func WithMentionResolver(resolver MentionResolver) MentionOption {
+	return &withMentionResolver{resolver}
+}
+
+var mentionsKey = parser.NewContextKey()
+
+type mentionList struct {
+	usernames []string
+	found     map[string]bool
+}
+
+// Mentions returns names of existing users mentioned in the document in
+// order of first appearance.
+func Mentions(pc parser.Context) []string {
+	if v, ok := pc.Get(mentionsKey).(*mentionList); ok {
+		return v.usernames
+	}
+	return nil
+}
+
+type mentionParser struct {
+	MentionConfig
+}
+
+// NewMentionParser returns a new parser.InlineParser that parses mentions
+// like '@username'. Usernames consist of alphanumeric characters, '_',
+// '-' and '.', and must not end with '-' and '.'.
+func NewMentionParser(opts ...MentionOption) parser.InlineParser {
+	p := &mentionParser{
+		MentionConfig: NewMentionConfig(),
+	}
+	for _, o := range opts {
+		o.SetMentionOption(&p.MentionConfig)
+	}
+	return p
+}
+
+func (s *mentionParser) Trigger() []byte {
+	return []byte{'@'}
+}
+
+// scanUsername returns a length of a username at the beginning of the
+// given bytes.
+func scanUsername(b []byte) int {
+	length := 0
+	for i := 0; i < len(b); i++ {
+		c := b[i]
+		if util.IsAlphaNumeric(c) || c == '_' {
+			length = i + 1
+		} else if i == 0 || (c != '-' && c != '.') {
+			break
+		}
+	}
+	return length
+}
+
+func (s *mentionParser) Parse(parent gast.Node, block text.Reader, pc parser.Context) gast.Node {
+	// mentions must not follow words like 'mail@example.com'
+	if c := block.PrecendingCharacter(); unicode.IsLetter(c) || unicode.IsDigit(c) || c == '_' || c == '@' || c == '/' {
+		return nil
+	}
+	line, segment := block.PeekLine()
+	length := scanUsername(line[1:])
+	if length == 0 {
+		return nil
+	}
+	username := line[1 : 1+length]
+	n := ast.NewMention(username)
+	if s.Resolver != nil {
+		destination, exists := s.Resolver.Resolve(string(username))
+		if !exists {
+			return nil
+		}
+		n.Destination = []byte(destination)
+	}
+	list := parser.ContextState(pc, mentionsKey, func() interface{} {
+		return &mentionList{found: map[string]bool{}}
+	}).(*mentionList)
+	if !list.found[string(username)] {
+		list.found[string(username)] = true
+		list.usernames = append(list.usernames, string(username))
+	}
+	n.AppendChild(n, gast.NewTextSegment(text.NewSegment(segment.Start, segment.Start+1+length)))
+	block.Advance(1 + length)
+	return n
+}
+
+// MentionHTMLRenderer is a renderer.NodeRenderer implementation that
+// renders Mention nodes.
+type MentionHTMLRenderer struct {
+	html.Config
+}
+
+// NewMentionHTMLRenderer returns a new MentionHTMLRenderer.
+func NewMentionHTMLRenderer(opts ...html.Option) renderer.NodeRenderer {
+	r := &MentionHTMLRenderer{
+		Config: html.NewConfig(),
+	}
+	for _, opt := range opts {
+		opt.SetHTMLOption(&r.Config)
+	}
+	return r
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *MentionHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindMention, r.renderMention)
+}
+
+func (r *MentionHTMLRenderer) renderMention(w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	n := node.(*ast.Mention)
+	if !entering {
+		if len(n.Destination) != 0 {
+			_, _ = w.WriteString("</a>")
+		} else {
+			_, _ = w.WriteString("</span>")
+		}
+		return gast.WalkContinue, nil
+	}
+	if len(n.Destination) != 0 {
+		_, _ = w.WriteString(`<a href="`)
+		if r.Unsafe || !html.IsDangerousURL(n.Destination) {
+			_, _ = w.Write(util.EscapeHTML(util.URLEscape(n.Destination, false)))
+		}
+		_, _ = w.WriteString(`" class="mention"`)
+	} else {
+		_, _ = w.WriteString(`<span class="mention"`)
+	}
+	if n.Attributes() != nil {
+		r.RenderAttributes(w, n)
+	}
+	_ = w.WriteByte('>')
+	return gast.WalkContinue, nil
+}
+
+type mention struct {
+	options []MentionOption
+}
+
+// Mention is an extension that allows you to use mentions like
+// '@username'. Users mentioned in a document are available via Mentions.
+// This extension can not be used with the Citation extension that uses
+// '@' for in-text citations.
+var Mention = &mention{}
+
+// NewMention returns a new Extender that resolves mentions with the given
+// options.
+func NewMention(opts ...MentionOption) goldmark.Extender {
+	return &mention{
+		options: opts,
+	}
+}
+
+func (e *mention) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(parser.WithInlineParsers(
+		util.Prioritized(NewMentionParser(e.options...), 500),
+	))
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(NewMentionHTMLRenderer(), 500),
+	))
+}
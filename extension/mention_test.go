@@ -0,0 +1,47 @@
+package extension
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+)
+
+var testMentionUsers = map[string]bool{
+	"yuin":     true,
+	"john.doe": true,
+	"bot":      false,
+}
+
+func TestMention(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			NewMention(
+				WithMentionResolver(MentionResolverFunc(func(username string) (string, bool) {
+					linked, ok := testMentionUsers[username]
+					if !ok {
+						return "", false
+					}
+					if !linked {
+						return "", true
+					}
+					return "/users/" + username, true
+				})),
+			),
+		),
+	)
+	goldmark.DoTestCaseFile(markdown, "_test/mention.txt", t)
+}
+
+func TestMentions(t *testing.T) {
+	markdown := goldmark.New(goldmark.WithExtensions(Mention))
+	source := []byte("cc @alice, @bob-smith and @alice.\n\n> @carol_1: mail@example.com\n")
+	pc := parser.NewContext()
+	markdown.Parser().Parse(text.NewReader(source), parser.WithContext(pc))
+	expected := []string{"alice", "bob-smith", "carol_1"}
+	if usernames := Mentions(pc); !reflect.DeepEqual(usernames, expected) {
+		t.Errorf("unexpected usernames: %v", usernames)
+	}
+}
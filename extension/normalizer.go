@@ -0,0 +1,117 @@
+package extension
+
+import (
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+type normalizerASTTransformer struct {
+}
+
+var defaultNormalizerASTTransformer = &normalizerASTTransformer{}
+
+// NewNormalizerASTTransformer returns a new parser.ASTTransformer that
+// cleans up an AST:
+//
+//    - removes empty paragraphs
+//    - collapses consecutive blank text nodes
+//    - merges adjacent text nodes
+//    - trims trailing whitespaces of lines
+func NewNormalizerASTTransformer() parser.ASTTransformer {
+	return defaultNormalizerASTTransformer
+}
+
+func (a *normalizerASTTransformer) Transform(node *gast.Document, reader text.Reader, pc parser.Context) {
+	source := reader.Source()
+	var empties []gast.Node
+	_ = gast.Walk(node, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+		if entering {
+			if n.Kind() == gast.KindCodeSpan {
+				return gast.WalkSkipChildren, nil
+			}
+			return gast.WalkContinue, nil
+		}
+		normalizeTexts(n, source)
+		if (n.Kind() == gast.KindParagraph || n.Kind() == gast.KindTextBlock) && !n.HasChildren() {
+			empties = append(empties, n)
+		}
+		return gast.WalkContinue, nil
+	})
+	for _, n := range empties {
+		if parent := n.Parent(); parent != nil {
+			parent.RemoveChild(parent, n)
+		}
+	}
+}
+
+func isBlankText(n *gast.Text, source []byte) bool {
+	return util.IsBlank(n.Segment.Value(source))
+}
+
+func normalizeTexts(parent gast.Node, source []byte) {
+	if parent.Kind() == gast.KindCodeSpan {
+		return
+	}
+	for c := parent.FirstChild(); c != nil; {
+		next := c.NextSibling()
+		t, ok := c.(*gast.Text)
+		if !ok || t.IsRaw() {
+			c = next
+			continue
+		}
+		if prev, ok := t.PreviousSibling().(*gast.Text); ok && !prev.IsRaw() && prev.Segment.Stop != 0 {
+			if prev.Merge(t, source) {
+				parent.RemoveChild(parent, t)
+				c = next
+				continue
+			}
+			if isBlankText(prev, source) && isBlankText(t, source) && !prev.SoftLineBreak() {
+				prev.SetSoftLineBreak(t.SoftLineBreak())
+				prev.SetHardLineBreak(t.HardLineBreak())
+				parent.RemoveChild(parent, t)
+				c = next
+				continue
+			}
+		}
+		c = next
+	}
+	lineEnd := parent.Type() == gast.TypeBlock
+	for c := parent.LastChild(); c != nil; {
+		prev := c.PreviousSibling()
+		t, ok := c.(*gast.Text)
+		if !ok || t.IsRaw() {
+			lineEnd = false
+			c = prev
+			continue
+		}
+		if t.SoftLineBreak() || t.HardLineBreak() {
+			lineEnd = true
+		}
+		if lineEnd {
+			t.Segment = t.Segment.TrimRightSpace(source)
+			lineEnd = t.Segment.IsEmpty()
+		}
+		if t.Segment.IsEmpty() && !t.SoftLineBreak() && !t.HardLineBreak() {
+			parent.RemoveChild(parent, t)
+		}
+		c = prev
+	}
+}
+
+type normalizer struct {
+}
+
+// Normalizer is an extension that cleans up ASTs produced by
+// other extensions before rendering.
+var Normalizer = &normalizer{}
+
+func (e *normalizer) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithASTTransformers(
+			util.Prioritized(NewNormalizerASTTransformer(), 10000),
+		),
+	)
+}
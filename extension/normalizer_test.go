@@ -0,0 +1,22 @@
+package extension
+
+import (
+	"testing"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/renderer/html"
+)
+
+func TestNormalizer(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithRendererOptions(
+			html.WithXHTML(),
+			html.WithUnsafe(),
+		),
+		goldmark.WithExtensions(
+			GFM,
+			Normalizer,
+		),
+	)
+	goldmark.DoTestCaseFile(markdown, "_test/normalizer.txt", t)
+}
@@ -0,0 +1,189 @@
+package extension
+
+import (
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// A ReadingStats struct holds a word count and an estimated reading time of
+// the document.
+type ReadingStats struct {
+	// Words is a number of words in the document. Each Han, Hiragana and
+	// Katakana character is counted as a word.
+	Words int
+
+	// ReadingTime is an estimated time to read the document.
+	ReadingTime time.Duration
+}
+
+// Minutes returns the reading time in minutes rounded up. Minutes returns 0
+// only if the document has no words.
+func (s *ReadingStats) Minutes() int {
+	return int((s.ReadingTime + time.Minute - 1) / time.Minute)
+}
+
+var readingStatsKey = parser.NewContextKey()
+
+// GetReadingStats returns a word count and a reading time of the document
+// parsed with the given context, or nil if the ReadingTime extension is not
+// enabled.
+func GetReadingStats(pc parser.Context) *ReadingStats {
+	if v, ok := pc.Get(readingStatsKey).(*ReadingStats); ok {
+		return v
+	}
+	return nil
+}
+
+// A ReadingTimeConfig struct is a data structure that holds configuration of
+// the ReadingTime extension.
+type ReadingTimeConfig struct {
+	// WordsPerMinute is a reading speed.
+	WordsPerMinute int
+
+	// ExcludeCode is true if code blocks are not counted.
+	ExcludeCode bool
+}
+
+// NewReadingTimeConfig returns a new ReadingTimeConfig with defaults.
+func NewReadingTimeConfig() ReadingTimeConfig {
+	return ReadingTimeConfig{
+		WordsPerMinute: 200,
+	}
+}
+
+// A ReadingTimeOption interface sets options for the ReadingTime extension.
+type ReadingTimeOption interface {
+	SetReadingTimeOption(*ReadingTimeConfig)
+}
+
+type withReadingTimeWordsPerMinute struct {
+	value int
+}
+
+func (o *withReadingTimeWordsPerMinute) SetReadingTimeOption(c *ReadingTimeConfig) {
+	c.WordsPerMinute = o.value
+}
+
+// WithReadingTimeWordsPerMinute is a functional option that sets a reading
+// speed in words per minute.
+func WithReadingTimeWordsPerMinute(n int) ReadingTimeOption {
+	return &withReadingTimeWordsPerMinute{n}
+}
+
+type withReadingTimeExcludeCode struct {
+}
+
+func (o *withReadingTimeExcludeCode) SetReadingTimeOption(c *ReadingTimeConfig) {
+	c.ExcludeCode = true
+}
+
+// WithReadingTimeExcludeCode is a functional option that excludes code
+// blocks from word counts.
+func WithReadingTimeExcludeCode() ReadingTimeOption {
+	return &withReadingTimeExcludeCode{}
+}
+
+// countWords counts words in the given value. Words are runs of letters and
+// numbers, and each Han, Hiragana and Katakana character is a word.
+func countWords(value []byte) int {
+	count := 0
+	inWord := false
+	for len(value) != 0 {
+		r, size := utf8.DecodeRune(value)
+		value = value[size:]
+		switch {
+		case unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana):
+			count++
+			inWord = false
+		case unicode.IsLetter(r) || unicode.IsNumber(r) || unicode.IsMark(r):
+			if !inWord {
+				count++
+			}
+			inWord = true
+		case (r == '\'' || r == '’' || r == '-') && inWord:
+			// words like "don't" and "e-mail"
+		default:
+			inWord = false
+		}
+	}
+	return count
+}
+
+type readingTimeASTTransformer struct {
+	ReadingTimeConfig
+}
+
+// NewReadingTimeASTTransformer returns a new parser.ASTTransformer that
+// counts words and estimates a reading time of the document. The result can
+// be retrieved by GetReadingStats.
+func NewReadingTimeASTTransformer(opts ...ReadingTimeOption) parser.ASTTransformer {
+	a := &readingTimeASTTransformer{
+		ReadingTimeConfig: NewReadingTimeConfig(),
+	}
+	for _, o := range opts {
+		o.SetReadingTimeOption(&a.ReadingTimeConfig)
+	}
+	if a.WordsPerMinute <= 0 {
+		a.WordsPerMinute = 200
+	}
+	return a
+}
+
+func (a *readingTimeASTTransformer) Transform(node *gast.Document, reader text.Reader, pc parser.Context) {
+	source := reader.Source()
+	stats := &ReadingStats{}
+	_ = gast.Walk(node, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+		if !entering {
+			return gast.WalkContinue, nil
+		}
+		switch v := n.(type) {
+		case *gast.HTMLBlock, *gast.RawHTML:
+			return gast.WalkSkipChildren, nil
+		case *gast.CodeBlock, *gast.FencedCodeBlock:
+			if !a.ExcludeCode {
+				stats.Words += countWords(v.Lines().Value(source))
+			}
+			return gast.WalkSkipChildren, nil
+		case *gast.Text:
+			stats.Words += countWords(v.Segment.Value(source))
+		case *gast.String:
+			stats.Words += countWords(v.Value)
+		case *gast.AutoLink:
+			stats.Words += countWords(v.Label(source))
+		}
+		return gast.WalkContinue, nil
+	})
+	stats.ReadingTime = time.Duration(stats.Words) * time.Minute / time.Duration(a.WordsPerMinute)
+	pc.Set(readingStatsKey, stats)
+}
+
+type readingTime struct {
+	options []ReadingTimeOption
+}
+
+// ReadingTime is an extension that counts words and estimates a reading
+// time of the document. The result can be retrieved by GetReadingStats.
+var ReadingTime = &readingTime{}
+
+// NewReadingTime returns a new Extender that counts words with the given
+// options.
+func NewReadingTime(opts ...ReadingTimeOption) goldmark.Extender {
+	return &readingTime{
+		options: opts,
+	}
+}
+
+func (e *readingTime) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithASTTransformers(
+			util.Prioritized(NewReadingTimeASTTransformer(e.options...), 1000),
+		),
+	)
+}
@@ -0,0 +1,51 @@
+package extension
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/parser"
+)
+
+func TestReadingTime(t *testing.T) {
+	source := []byte(`# Don't panic
+
+Hello, *world*! An e-mail to <https://example.com>.
+日本語のテキスト
+
+<div>raw html is not counted</div>
+
+` + "```" + `
+fmt.Println("code")
+` + "```")
+	for i, c := range []struct {
+		opts     []ReadingTimeOption
+		words    int
+		duration time.Duration
+		minutes  int
+	}{
+		{nil, 21, 6300 * time.Millisecond, 1},
+		{[]ReadingTimeOption{WithReadingTimeExcludeCode(), WithReadingTimeWordsPerMinute(3)}, 18, 6 * time.Minute, 6},
+	} {
+		markdown := goldmark.New(
+			goldmark.WithExtensions(
+				NewReadingTime(c.opts...),
+			),
+		)
+		pc := parser.NewContext()
+		var buf bytes.Buffer
+		if err := markdown.Convert(source, &buf, parser.WithContext(pc)); err != nil {
+			t.Fatal(err)
+		}
+		stats := GetReadingStats(pc)
+		if stats == nil {
+			t.Fatalf("%d: stats should be set", i+1)
+		}
+		if stats.Words != c.words || stats.ReadingTime != c.duration || stats.Minutes() != c.minutes {
+			t.Errorf("%d: expected %d words, %s and %d minutes, but got %d words, %s and %d minutes",
+				i+1, c.words, c.duration, c.minutes, stats.Words, stats.ReadingTime, stats.Minutes())
+		}
+	}
+}
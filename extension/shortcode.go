@@ -0,0 +1,325 @@
+package extension
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// A ShortcodeProvider struct is a configuration of a media provider that
+// can be embedded by shortcodes like '{{youtube dQw4w9WgXcQ}}' or bare URLs.
+// ShortcodeProviders generate embed markup locally without any network access.
+type ShortcodeProvider struct {
+	// Name is a name of the shortcode like 'youtube'.
+	Name string
+
+	// ProviderName is a display name of the provider like 'YouTube'.
+	ProviderName string
+
+	// IDPattern is a pattern of valid ids.
+	IDPattern *regexp.Regexp
+
+	// URLPattern is a pattern of URLs of the provider.
+	// The first submatch must be an id of a content.
+	URLPattern *regexp.Regexp
+
+	// URL returns a canonical URL of the given id.
+	URL func(id string) string
+
+	// HTML returns an embed markup(i.e. iframes) of the given id.
+	// The given id is already validated by IDPattern.
+	HTML func(id string) string
+
+	// EmbedType is a type of contents like 'video' and 'rich'.
+	// An empty value means 'video'.
+	EmbedType string
+}
+
+var shortcodeIDRegexp = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// YouTubeShortcodeProvider is a ShortcodeProvider for YouTube videos.
+// Videos are embedded in the privacy-enhanced mode.
+var YouTubeShortcodeProvider = ShortcodeProvider{
+	Name:         "youtube",
+	ProviderName: "YouTube",
+	IDPattern:    shortcodeIDRegexp,
+	URLPattern:   regexp.MustCompile(`^https?://(?:www\.|m\.)?(?:youtube\.com/watch\?(?:.*&)?v=|youtu\.be/)([A-Za-z0-9_-]+)`),
+	URL: func(id string) string {
+		return "https://www.youtube.com/watch?v=" + id
+	},
+	HTML: func(id string) string {
+		return fmt.Sprintf(`<iframe src="https://www.youtube-nocookie.com/embed/%s" title="YouTube video" loading="lazy" referrerpolicy="strict-origin-when-cross-origin" allowfullscreen></iframe>`, url.PathEscape(id))
+	},
+}
+
+// VimeoShortcodeProvider is a ShortcodeProvider for Vimeo videos.
+// Videos are embedded with the 'Do Not Track' parameter.
+var VimeoShortcodeProvider = ShortcodeProvider{
+	Name:         "vimeo",
+	ProviderName: "Vimeo",
+	IDPattern:    regexp.MustCompile(`^[0-9]+$`),
+	URLPattern:   regexp.MustCompile(`^https?://(?:www\.)?vimeo\.com/([0-9]+)`),
+	URL: func(id string) string {
+		return "https://vimeo.com/" + id
+	},
+	HTML: func(id string) string {
+		return fmt.Sprintf(`<iframe src="https://player.vimeo.com/video/%s?dnt=1" title="Vimeo video" loading="lazy" allowfullscreen></iframe>`, url.PathEscape(id))
+	},
+}
+
+// TwitterShortcodeProvider is a ShortcodeProvider for posts on Twitter(X).
+// Posts are embedded with the 'Do Not Track' parameter, so that no scripts
+// are loaded into the page.
+var TwitterShortcodeProvider = ShortcodeProvider{
+	Name:         "twitter",
+	ProviderName: "Twitter",
+	IDPattern:    regexp.MustCompile(`^[0-9]+$`),
+	URLPattern:   regexp.MustCompile(`^https?://(?:www\.|mobile\.)?(?:twitter|x)\.com/[A-Za-z0-9_]+/status(?:es)?/([0-9]+)`),
+	URL: func(id string) string {
+		return "https://twitter.com/i/status/" + id
+	},
+	HTML: func(id string) string {
+		return fmt.Sprintf(`<iframe src="https://platform.twitter.com/embed/Tweet.html?id=%s&amp;dnt=true" title="Twitter post" loading="lazy"></iframe>`, url.QueryEscape(id))
+	},
+	EmbedType: "rich",
+}
+
+// A ShortcodeRegistry struct is a set of ShortcodeProviders.
+// ShortcodeRegistry implements EmbedFetcher, so it can be used with
+// the Embed extension for bare URLs.
+type ShortcodeRegistry struct {
+	providers []ShortcodeProvider
+}
+
+// NewShortcodeRegistry returns a new ShortcodeRegistry with the given providers.
+func NewShortcodeRegistry(providers ...ShortcodeProvider) *ShortcodeRegistry {
+	r := &ShortcodeRegistry{}
+	for _, p := range providers {
+		r.Register(p)
+	}
+	return r
+}
+
+// Register adds the given provider to this registry.
+// A provider that has the same name as the given provider is replaced.
+func (r *ShortcodeRegistry) Register(provider ShortcodeProvider) {
+	for i, p := range r.providers {
+		if p.Name == provider.Name {
+			r.providers[i] = provider
+			return
+		}
+	}
+	r.providers = append(r.providers, provider)
+}
+
+// Lookup returns a provider that has the given name.
+func (r *ShortcodeRegistry) Lookup(name string) (ShortcodeProvider, bool) {
+	for _, p := range r.providers {
+		if p.Name == name {
+			return p, true
+		}
+	}
+	return ShortcodeProvider{}, false
+}
+
+func (r *ShortcodeRegistry) embedData(p ShortcodeProvider, id string) *EmbedData {
+	data := &EmbedData{
+		Type:         p.EmbedType,
+		ProviderName: p.ProviderName,
+		HTML:         p.HTML(id),
+	}
+	if len(data.Type) == 0 {
+		data.Type = "video"
+	}
+	if p.URL != nil {
+		data.URL = p.URL(id)
+	}
+	return data
+}
+
+// Shortcode returns metadata of the given shortcode.
+func (r *ShortcodeRegistry) Shortcode(name, id string) (*EmbedData, error) {
+	p, ok := r.Lookup(name)
+	if !ok || (p.IDPattern != nil && !p.IDPattern.MatchString(id)) {
+		return nil, ErrUnsupportedEmbed
+	}
+	return r.embedData(p, id), nil
+}
+
+// Fetch implements EmbedFetcher.Fetch.
+func (r *ShortcodeRegistry) Fetch(u string) (*EmbedData, error) {
+	for _, p := range r.providers {
+		if p.URLPattern == nil {
+			continue
+		}
+		m := p.URLPattern.FindStringSubmatch(u)
+		if m == nil || len(m) < 2 {
+			continue
+		}
+		if p.IDPattern != nil && !p.IDPattern.MatchString(m[1]) {
+			continue
+		}
+		data := r.embedData(p, m[1])
+		data.URL = u
+		return data, nil
+	}
+	return nil, ErrUnsupportedEmbed
+}
+
+// A ShortcodeConfig struct is a data structure that holds configuration of the
+// Shortcode extension.
+type ShortcodeConfig struct {
+	// Registry is a set of providers.
+	Registry *ShortcodeRegistry
+
+	// Fetcher fetches metadata of bare URLs that are not supported by
+	// the providers, i.e. through oEmbed. Fetcher is nil by default.
+	Fetcher EmbedFetcher
+}
+
+// A ShortcodeOption interface sets options for the Shortcode extension.
+type ShortcodeOption interface {
+	SetShortcodeOption(*ShortcodeConfig)
+}
+
+type withShortcodeProviders struct {
+	value []ShortcodeProvider
+}
+
+func (o *withShortcodeProviders) SetShortcodeOption(c *ShortcodeConfig) {
+	for _, p := range o.value {
+		c.Registry.Register(p)
+	}
+}
+
+// WithShortcodeProviders is a functional option that adds the given providers
+// to the default providers.
+func WithShortcodeProviders(providers ...ShortcodeProvider) ShortcodeOption {
+	return &withShortcodeProviders{providers}
+}
+
+type withShortcodeFetcher struct {
+	value EmbedFetcher
+}
+
+func (o *withShortcodeFetcher) SetShortcodeOption(c *ShortcodeConfig) {
+	c.Fetcher = o.value
+}
+
+// WithShortcodeFetcher is a functional option that sets a fetcher for bare
+// URLs that are not supported by the providers like NewOEmbedFetcher.
+func WithShortcodeFetcher(fetcher EmbedFetcher) ShortcodeOption {
+	return &withShortcodeFetcher{fetcher}
+}
+
+var shortcodeRegexp = regexp.MustCompile(`^\{\{\s*([A-Za-z][A-Za-z0-9_-]*)\s+([^\s{}]+)\s*\}\}$`)
+
+type shortcodeASTTransformer struct {
+	registry *ShortcodeRegistry
+	fetcher  EmbedFetcher
+}
+
+// NewShortcodeASTTransformer returns a new parser.ASTTransformer that
+// replaces paragraphs consisting solely of a shortcode like
+// '{{youtube dQw4w9WgXcQ}}' or a bare URL of the given providers
+// with Embed nodes.
+// Bare URLs that are not supported by the providers are fetched by the
+// given fallback fetchers.
+func NewShortcodeASTTransformer(registry *ShortcodeRegistry, fallbacks ...EmbedFetcher) parser.ASTTransformer {
+	var fetcher EmbedFetcher = registry
+	if len(fallbacks) != 0 {
+		fetcher = NewEmbedFetchers(append([]EmbedFetcher{registry}, fallbacks...)...)
+	}
+	return &shortcodeASTTransformer{
+		registry: registry,
+		fetcher:  fetcher,
+	}
+}
+
+func (a *shortcodeASTTransformer) Transform(node *gast.Document, reader text.Reader, pc parser.Context) {
+	source := reader.Source()
+	var paragraphs []gast.Node
+	_ = gast.Walk(node, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+		if !entering {
+			return gast.WalkContinue, nil
+		}
+		if n.Kind() == gast.KindParagraph {
+			paragraphs = append(paragraphs, n)
+			return gast.WalkSkipChildren, nil
+		}
+		return gast.WalkContinue, nil
+	})
+	for _, paragraph := range paragraphs {
+		var data *EmbedData
+		var err error
+		var u []byte
+		lines := paragraph.Lines()
+		if lines.Len() != 1 {
+			continue
+		}
+		line := util.TrimRightSpace(util.TrimLeftSpace(lines.Value(source)))
+		if m := shortcodeRegexp.FindSubmatch(line); m != nil {
+			data, err = a.registry.Shortcode(string(m[1]), string(m[2]))
+			if data != nil {
+				u = []byte(data.URL)
+			}
+		} else if v, ok := bareURL(paragraph, source); ok {
+			u = v
+			data, err = a.fetcher.Fetch(string(u))
+		} else {
+			continue
+		}
+		if err != nil || data == nil {
+			continue
+		}
+		embed := newEmbed(u, data)
+		embed.SetLines(paragraph.Lines())
+		embed.SetBlankPreviousLines(paragraph.HasBlankPreviousLines())
+		paragraph.Parent().ReplaceChild(paragraph.Parent(), paragraph, embed)
+	}
+}
+
+type shortcode struct {
+	options []ShortcodeOption
+}
+
+// Shortcode is an extension that replaces shortcodes like
+// '{{youtube dQw4w9WgXcQ}}' and bare URLs of known providers with
+// privacy-aware embed markup.
+var Shortcode = &shortcode{}
+
+// NewShortcode returns a new Extender that replaces shortcodes with
+// embed markup with the given options.
+func NewShortcode(opts ...ShortcodeOption) goldmark.Extender {
+	return &shortcode{
+		options: opts,
+	}
+}
+
+func (e *shortcode) Extend(m goldmark.Markdown) {
+	config := ShortcodeConfig{
+		Registry: NewShortcodeRegistry(YouTubeShortcodeProvider, VimeoShortcodeProvider, TwitterShortcodeProvider),
+	}
+	for _, opt := range e.options {
+		opt.SetShortcodeOption(&config)
+	}
+	var fallbacks []EmbedFetcher
+	if config.Fetcher != nil {
+		fallbacks = append(fallbacks, config.Fetcher)
+	}
+	m.Parser().AddOptions(
+		parser.WithASTTransformers(
+			util.Prioritized(NewShortcodeASTTransformer(config.Registry, fallbacks...), 998),
+		),
+	)
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(NewEmbedHTMLRenderer(), 500),
+	))
+}
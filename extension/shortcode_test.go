@@ -0,0 +1,67 @@
+package extension
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/renderer/html"
+)
+
+func TestShortcode(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithRendererOptions(
+			html.WithUnsafe(),
+		),
+		goldmark.WithExtensions(
+			Shortcode,
+		),
+	)
+	goldmark.DoTestCaseFile(markdown, "_test/shortcode.txt", t)
+}
+
+func TestShortcodeProviders(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			NewShortcode(WithShortcodeProviders(ShortcodeProvider{
+				Name:         "peertube",
+				ProviderName: "PeerTube",
+				IDPattern:    regexp.MustCompile(`^[a-z0-9-]+$`),
+				URLPattern:   regexp.MustCompile(`^https://videos\.example\.com/w/([a-z0-9-]+)$`),
+				HTML: func(id string) string {
+					return fmt.Sprintf(`<iframe src="https://videos.example.com/videos/embed/%s"></iframe>`, id)
+				},
+			})),
+		),
+	)
+	goldmark.DoTestCases(markdown, []goldmark.MarkdownTestCase{{
+		No:       1,
+		Markdown: "{{peertube abc-123}}\n\nhttps://videos.example.com/w/def-456",
+		Expected: `<div class="embed embed-video"><iframe src="https://videos.example.com/videos/embed/abc-123"></iframe></div>
+<div class="embed embed-video"><iframe src="https://videos.example.com/videos/embed/def-456"></iframe></div>`,
+	}}, t)
+}
+
+func TestShortcodeFetcher(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			NewShortcode(WithShortcodeFetcher(EmbedFetcherFunc(func(u string) (*EmbedData, error) {
+				if u != "https://example.com/post" {
+					return nil, ErrUnsupportedEmbed
+				}
+				return &EmbedData{
+					Type:  "link",
+					Title: "Post",
+				}, nil
+			}))),
+		),
+	)
+	goldmark.DoTestCases(markdown, []goldmark.MarkdownTestCase{{
+		No:       1,
+		Markdown: "https://example.com/post\n\nhttps://vimeo.com/76979871\n\nhttps://example.com/other",
+		Expected: `<div class="embed embed-link"><a class="embed-card" href="https://example.com/post"><span class="embed-title">Post</span></a></div>
+<div class="embed embed-video"><iframe src="https://player.vimeo.com/video/76979871?dnt=1" title="Vimeo video" loading="lazy" allowfullscreen></iframe></div>
+<p>https://example.com/other</p>`,
+	}}, t)
+}
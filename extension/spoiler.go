@@ -0,0 +1,190 @@
+package extension
+
+import (
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// A SpoilerConfig struct is a data structure that holds configuration of the
+// Spoiler extension.
+type SpoilerConfig struct {
+	// Class is a class attribute for spoiler texts.
+	Class []byte
+
+	// Details is true if spoiler texts are rendered as details elements.
+	Details bool
+
+	// Summary is a summary of details elements.
+	Summary []byte
+}
+
+// A SpoilerOption interface sets options for the Spoiler extension.
+type SpoilerOption interface {
+	SetSpoilerOption(*SpoilerConfig)
+}
+
+type withSpoilerClass struct {
+	value []byte
+}
+
+func (o *withSpoilerClass) SetSpoilerOption(c *SpoilerConfig) {
+	c.Class = o.value
+}
+
+// WithSpoilerClass is a functional option that sets a class attribute for
+// spoiler texts. The default class is 'spoiler'.
+func WithSpoilerClass(class string) SpoilerOption {
+	return &withSpoilerClass{[]byte(class)}
+}
+
+type withSpoilerDetails struct {
+	summary []byte
+}
+
+func (o *withSpoilerDetails) SetSpoilerOption(c *SpoilerConfig) {
+	c.Details = true
+	c.Summary = o.summary
+}
+
+// WithSpoilerDetails is a functional option that renders spoiler texts as
+// '<details>' elements with the given summary instead of '<span>'
+// elements.
+func WithSpoilerDetails(summary string) SpoilerOption {
+	return &withSpoilerDetails{[]byte(summary)}
+}
+
+type spoilerDelimiterProcessor struct {
+}
+
+func (p *spoilerDelimiterProcessor) IsDelimiter(b byte) bool {
+	return b == '|'
+}
+
+func (p *spoilerDelimiterProcessor) CanOpenCloser(opener, closer *parser.Delimiter) bool {
+	return opener.Char == closer.Char
+}
+
+func (p *spoilerDelimiterProcessor) OnMatch(consumes int) gast.Node {
+	return ast.NewSpoiler()
+}
+
+var defaultSpoilerDelimiterProcessor = &spoilerDelimiterProcessor{}
+
+type spoilerParser struct {
+}
+
+var defaultSpoilerParser = &spoilerParser{}
+
+// NewSpoilerParser return a new InlineParser that parses
+// spoiler texts like '||text||'.
+func NewSpoilerParser() parser.InlineParser {
+	return defaultSpoilerParser
+}
+
+func (s *spoilerParser) Trigger() []byte {
+	return []byte{'|'}
+}
+
+func (s *spoilerParser) Parse(parent gast.Node, block text.Reader, pc parser.Context) gast.Node {
+	before := block.PrecendingCharacter()
+	line, segment := block.PeekLine()
+	node := parser.ScanDelimiter(line, before, 2, defaultSpoilerDelimiterProcessor)
+	if node == nil {
+		return nil
+	}
+	node.Segment = segment.WithStop(segment.Start + node.OriginalLength)
+	block.Advance(node.OriginalLength)
+	pc.PushDelimiter(node)
+	return node
+}
+
+func (s *spoilerParser) CloseBlock(parent gast.Node, pc parser.Context) {
+	// nothing to do
+}
+
+// SpoilerHTMLRenderer is a renderer.NodeRenderer implementation that
+// renders Spoiler nodes.
+type SpoilerHTMLRenderer struct {
+	html.Config
+	SpoilerConfig
+}
+
+// NewSpoilerHTMLRenderer returns a new SpoilerHTMLRenderer.
+func NewSpoilerHTMLRenderer(opts ...SpoilerOption) renderer.NodeRenderer {
+	r := &SpoilerHTMLRenderer{
+		Config: html.NewConfig(),
+		SpoilerConfig: SpoilerConfig{
+			Class: []byte("spoiler"),
+		},
+	}
+	for _, opt := range opts {
+		opt.SetSpoilerOption(&r.SpoilerConfig)
+	}
+	return r
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *SpoilerHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindSpoiler, r.renderSpoiler)
+}
+
+func (r *SpoilerHTMLRenderer) renderSpoiler(w util.BufWriter, source []byte, n gast.Node, entering bool) (gast.WalkStatus, error) {
+	tag := "span"
+	if r.Details {
+		tag = "details"
+	}
+	if entering {
+		_ = w.WriteByte('<')
+		_, _ = w.WriteString(tag)
+		if len(r.Class) != 0 {
+			_, _ = w.WriteString(` class="`)
+			_, _ = w.Write(util.EscapeHTML(r.Class))
+			_ = w.WriteByte('"')
+		}
+		if n.Attributes() != nil {
+			r.RenderAttributes(w, n)
+		}
+		_ = w.WriteByte('>')
+		if r.Details {
+			_, _ = w.WriteString("<summary>")
+			_, _ = w.Write(util.EscapeHTML(r.Summary))
+			_, _ = w.WriteString("</summary>")
+		}
+	} else {
+		_, _ = w.WriteString("</")
+		_, _ = w.WriteString(tag)
+		_ = w.WriteByte('>')
+	}
+	return gast.WalkContinue, nil
+}
+
+type spoiler struct {
+	options []SpoilerOption
+}
+
+// Spoiler is an extension that allow you to use Discord style spoiler texts
+// like '||text||'.
+var Spoiler = &spoiler{}
+
+// NewSpoiler returns a new Extender that allow you to use spoiler texts
+// with the given options.
+func NewSpoiler(opts ...SpoilerOption) goldmark.Extender {
+	return &spoiler{
+		options: opts,
+	}
+}
+
+func (e *spoiler) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(parser.WithInlineParsers(
+		util.Prioritized(NewSpoilerParser(), 500),
+	))
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(NewSpoilerHTMLRenderer(e.options...), 500),
+	))
+}
@@ -0,0 +1,33 @@
+package extension
+
+import (
+	"testing"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/renderer/html"
+)
+
+func TestSpoiler(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithRendererOptions(
+			html.WithUnsafe(),
+		),
+		goldmark.WithExtensions(
+			Spoiler,
+		),
+	)
+	goldmark.DoTestCaseFile(markdown, "_test/spoiler.txt", t)
+}
+
+func TestSpoilerOptions(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			NewSpoiler(WithSpoilerClass("hidden"), WithSpoilerDetails("Show <spoiler>")),
+		),
+	)
+	goldmark.DoTestCases(markdown, []goldmark.MarkdownTestCase{{
+		No:       1,
+		Markdown: "||Hi||",
+		Expected: `<p><details class="hidden"><summary>Show &lt;spoiler&gt;</summary>Hi</details></p>`,
+	}}, t)
+}
@@ -11,7 +11,37 @@ import (
 	"github.com/yuin/goldmark/util"
 )
 
+// A StrikethroughConfig struct is a data structure that holds configuration
+// of the Strikethrough extension.
+type StrikethroughConfig struct {
+	// SingleTilde is true if strikethroughs like '~text~' are allowed.
+	SingleTilde bool
+}
+
+// A StrikethroughOption interface sets options for the Strikethrough
+// extension.
+type StrikethroughOption interface {
+	SetStrikethroughOption(*StrikethroughConfig)
+}
+
+type withStrikethroughSingleTilde struct {
+}
+
+func (o *withStrikethroughSingleTilde) SetStrikethroughOption(c *StrikethroughConfig) {
+	c.SingleTilde = true
+}
+
+// WithStrikethroughSingleTilde is a functional option that allows
+// strikethroughs with a single tilde like '~text~' as GitHub does.
+// As in GFM, opening and closing tildes must have the same length, and
+// runs of more than two tildes are not strikethroughs.
+// This option can not be used with the Subscript extension.
+func WithStrikethroughSingleTilde() StrikethroughOption {
+	return &withStrikethroughSingleTilde{}
+}
+
 type strikethroughDelimiterProcessor struct {
+	singleTilde bool
 }
 
 func (p *strikethroughDelimiterProcessor) IsDelimiter(b byte) bool {
@@ -19,7 +49,11 @@ func (p *strikethroughDelimiterProcessor) IsDelimiter(b byte) bool {
 }
 
 func (p *strikethroughDelimiterProcessor) CanOpenCloser(opener, closer *parser.Delimiter) bool {
-	return opener.Char == closer.Char
+	// '~' is also used for subscripts like '~text~'
+	if opener.Char != closer.Char || opener.Processor != closer.Processor {
+		return false
+	}
+	return !p.singleTilde || opener.Length == closer.Length
 }
 
 func (p *strikethroughDelimiterProcessor) OnMatch(consumes int) gast.Node {
@@ -28,15 +62,27 @@ func (p *strikethroughDelimiterProcessor) OnMatch(consumes int) gast.Node {
 
 var defaultStrikethroughDelimiterProcessor = &strikethroughDelimiterProcessor{}
 
+var singleTildeStrikethroughDelimiterProcessor = &strikethroughDelimiterProcessor{
+	singleTilde: true,
+}
+
 type strikethroughParser struct {
+	StrikethroughConfig
 }
 
 var defaultStrikethroughParser = &strikethroughParser{}
 
 // NewStrikethroughParser return a new InlineParser that parses
 // strikethrough expressions.
-func NewStrikethroughParser() parser.InlineParser {
-	return defaultStrikethroughParser
+func NewStrikethroughParser(opts ...StrikethroughOption) parser.InlineParser {
+	if len(opts) == 0 {
+		return defaultStrikethroughParser
+	}
+	p := &strikethroughParser{}
+	for _, o := range opts {
+		o.SetStrikethroughOption(&p.StrikethroughConfig)
+	}
+	return p
 }
 
 func (s *strikethroughParser) Trigger() []byte {
@@ -46,7 +92,15 @@ func (s *strikethroughParser) Trigger() []byte {
 func (s *strikethroughParser) Parse(parent gast.Node, block text.Reader, pc parser.Context) gast.Node {
 	before := block.PrecendingCharacter()
 	line, segment := block.PeekLine()
-	node := parser.ScanDelimiter(line, before, 2, defaultStrikethroughDelimiterProcessor)
+	var node *parser.Delimiter
+	if s.SingleTilde {
+		node = parser.ScanDelimiter(line, before, 1, singleTildeStrikethroughDelimiterProcessor)
+		if node != nil && node.Length > 2 {
+			return nil
+		}
+	} else {
+		node = parser.ScanDelimiter(line, before, 2, defaultStrikethroughDelimiterProcessor)
+	}
 	if node == nil {
 		return nil
 	}
@@ -92,14 +146,23 @@ func (r *StrikethroughHTMLRenderer) renderStrikethrough(w util.BufWriter, source
 }
 
 type strikethrough struct {
+	options []StrikethroughOption
 }
 
 // Strikethrough is an extension that allow you to use strikethrough expression like '~~text~~' .
 var Strikethrough = &strikethrough{}
 
+// NewStrikethrough returns a new Extender that allow you to use
+// strikethrough expressions with the given options.
+func NewStrikethrough(opts ...StrikethroughOption) goldmark.Extender {
+	return &strikethrough{
+		options: opts,
+	}
+}
+
 func (e *strikethrough) Extend(m goldmark.Markdown) {
 	m.Parser().AddOptions(parser.WithInlineParsers(
-		util.Prioritized(NewStrikethroughParser(), 500),
+		util.Prioritized(NewStrikethroughParser(e.options...), 500),
 	))
 	m.Renderer().AddOptions(renderer.WithNodeRenderers(
 		util.Prioritized(NewStrikethroughHTMLRenderer(), 500),
@@ -17,3 +17,28 @@ func TestStrikethrough(t *testing.T) {
 	)
 	goldmark.DoTestCaseFile(markdown, "_test/strikethrough.txt", t)
 }
+
+func TestStrikethroughSingleTilde(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			NewStrikethrough(WithStrikethroughSingleTilde()),
+		),
+	)
+	goldmark.DoTestCases(markdown, []goldmark.MarkdownTestCase{
+		{
+			No:       1,
+			Markdown: `~Hi~ Hello, ~~world~~!`,
+			Expected: `<p><del>Hi</del> Hello, <del>world</del>!</p>`,
+		},
+		{
+			No:       2,
+			Markdown: `This ~~has a~ mismatch and ~~~three~~~ tildes`,
+			Expected: `<p>This ~~has a~ mismatch and ~~~three~~~ tildes</p>`,
+		},
+		{
+			No:       3,
+			Markdown: `~a ~~b~~ c~`,
+			Expected: `<p><del>a <del>b</del> c</del></p>`,
+		},
+	}, t)
+}
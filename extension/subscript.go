@@ -0,0 +1,149 @@
+package extension
+
+import (
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+type scriptDelimiterProcessor struct {
+	char    byte
+	onMatch func() gast.Node
+}
+
+func (p *scriptDelimiterProcessor) IsDelimiter(b byte) bool {
+	return b == p.char
+}
+
+func (p *scriptDelimiterProcessor) CanOpenCloser(opener, closer *parser.Delimiter) bool {
+	// '~' is also used for strikethroughs like '~~text~~'
+	return opener.Char == closer.Char && opener.Processor == closer.Processor
+}
+
+func (p *scriptDelimiterProcessor) OnMatch(consumes int) gast.Node {
+	return p.onMatch()
+}
+
+var defaultSubscriptDelimiterProcessor = &scriptDelimiterProcessor{
+	char: '~',
+	onMatch: func() gast.Node {
+		return ast.NewSubscript()
+	},
+}
+
+// scanScriptDelimiter scans a delimiter of subscripts and superscripts.
+// As in Pandoc, a delimiter is a single character, and texts between
+// delimiters can not contain spaces.
+func scanScriptDelimiter(block text.Reader, processor *scriptDelimiterProcessor) *parser.Delimiter {
+	before := block.PrecendingCharacter()
+	line, segment := block.PeekLine()
+	node := parser.ScanDelimiter(line, before, 1, processor)
+	if node == nil || node.Length != 1 {
+		return nil
+	}
+	if node.CanOpen {
+		node.CanOpen = false
+		for i := 1; i < len(line); i++ {
+			c := line[i]
+			if c == '\\' && i < len(line)-1 && util.IsPunct(line[i+1]) {
+				i++
+				continue
+			}
+			if util.IsSpace(c) {
+				break
+			}
+			if c == processor.char {
+				node.CanOpen = i > 1
+				break
+			}
+		}
+	}
+	if !node.CanOpen && !node.CanClose {
+		return nil
+	}
+	node.Segment = segment.WithStop(segment.Start + node.OriginalLength)
+	return node
+}
+
+type subscriptParser struct {
+}
+
+var defaultSubscriptParser = &subscriptParser{}
+
+// NewSubscriptParser return a new InlineParser that parses
+// subscripts like 'H~2~O'.
+func NewSubscriptParser() parser.InlineParser {
+	return defaultSubscriptParser
+}
+
+func (s *subscriptParser) Trigger() []byte {
+	return []byte{'~'}
+}
+
+func (s *subscriptParser) Parse(parent gast.Node, block text.Reader, pc parser.Context) gast.Node {
+	node := scanScriptDelimiter(block, defaultSubscriptDelimiterProcessor)
+	if node == nil {
+		return nil
+	}
+	block.Advance(node.OriginalLength)
+	pc.PushDelimiter(node)
+	return node
+}
+
+func (s *subscriptParser) CloseBlock(parent gast.Node, pc parser.Context) {
+	// nothing to do
+}
+
+// SubscriptHTMLRenderer is a renderer.NodeRenderer implementation that
+// renders Subscript nodes.
+type SubscriptHTMLRenderer struct {
+	html.Config
+}
+
+// NewSubscriptHTMLRenderer returns a new SubscriptHTMLRenderer.
+func NewSubscriptHTMLRenderer(opts ...html.Option) renderer.NodeRenderer {
+	r := &SubscriptHTMLRenderer{
+		Config: html.NewConfig(),
+	}
+	for _, opt := range opts {
+		opt.SetHTMLOption(&r.Config)
+	}
+	return r
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *SubscriptHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindSubscript, r.renderSubscript)
+}
+
+func (r *SubscriptHTMLRenderer) renderSubscript(w util.BufWriter, source []byte, n gast.Node, entering bool) (gast.WalkStatus, error) {
+	if entering {
+		_, _ = w.WriteString("<sub>")
+	} else {
+		_, _ = w.WriteString("</sub>")
+	}
+	return gast.WalkContinue, nil
+}
+
+type subscript struct {
+}
+
+// Subscript is an extension that allow you to use subscripts like
+// 'H~2~O' as in Pandoc. Strikethroughs like '~~text~~' are not
+// subscripts, so this extension can be used with the Strikethrough
+// extension.
+var Subscript = &subscript{}
+
+func (e *subscript) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(parser.WithInlineParsers(
+		util.Prioritized(NewSubscriptParser(), 500),
+	))
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(NewSubscriptHTMLRenderer(), 500),
+	))
+}
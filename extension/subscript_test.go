@@ -0,0 +1,18 @@
+package extension
+
+import (
+	"testing"
+
+	"github.com/yuin/goldmark"
+)
+
+func TestSubscript(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			Subscript,
+			Superscript,
+			Strikethrough,
+		),
+	)
+	goldmark.DoTestCaseFile(markdown, "_test/subscript.txt", t)
+}
@@ -0,0 +1,215 @@
+package extension
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// A DocumentSummary struct is a summary of the document for listing pages
+// like blog indexes.
+type DocumentSummary struct {
+	// Title is a plain text of the first level 1 heading. Title is empty if
+	// the document does not have level 1 headings.
+	Title string
+
+	// Excerpt is a plain text of contents before the more marker like
+	// '<!--more-->', or first words of the document if the document does not
+	// have the marker. The title, code blocks and HTML blocks are not
+	// included in the excerpt.
+	Excerpt string
+
+	// Truncated is true if the excerpt does not cover the whole document.
+	Truncated bool
+
+	// MoreOffset is a byte offset of the more marker in the source, so that
+	// contents before the marker can be rendered as a rich excerpt.
+	// MoreOffset is -1 if the document does not have the marker.
+	MoreOffset int
+}
+
+var summaryKey = parser.NewContextKey()
+
+// GetSummary returns a summary of the document parsed with the given
+// context, or nil if the Summary extension is not enabled.
+func GetSummary(pc parser.Context) *DocumentSummary {
+	if v, ok := pc.Get(summaryKey).(*DocumentSummary); ok {
+		return v
+	}
+	return nil
+}
+
+// A SummaryConfig struct is a data structure that holds configuration of
+// the Summary extension.
+type SummaryConfig struct {
+	// MoreMarker is a marker that separates an excerpt from the rest of
+	// the document.
+	MoreMarker []byte
+
+	// ExcerptWords is a maximum number of words in excerpts of documents
+	// that do not have the more marker.
+	ExcerptWords int
+}
+
+// NewSummaryConfig returns a new SummaryConfig with defaults.
+func NewSummaryConfig() SummaryConfig {
+	return SummaryConfig{
+		MoreMarker:   []byte("<!--more-->"),
+		ExcerptWords: 70,
+	}
+}
+
+// A SummaryOption interface sets options for the Summary extension.
+type SummaryOption interface {
+	SetSummaryOption(*SummaryConfig)
+}
+
+type withSummaryMoreMarker struct {
+	value []byte
+}
+
+func (o *withSummaryMoreMarker) SetSummaryOption(c *SummaryConfig) {
+	c.MoreMarker = o.value
+}
+
+// WithSummaryMoreMarker is a functional option that sets a marker that
+// separates an excerpt from the rest of the document.
+func WithSummaryMoreMarker(marker string) SummaryOption {
+	return &withSummaryMoreMarker{[]byte(marker)}
+}
+
+type withSummaryExcerptWords struct {
+	value int
+}
+
+func (o *withSummaryExcerptWords) SetSummaryOption(c *SummaryConfig) {
+	c.ExcerptWords = o.value
+}
+
+// WithSummaryExcerptWords is a functional option that sets a maximum number
+// of words in excerpts of documents that do not have the more marker.
+func WithSummaryExcerptWords(n int) SummaryOption {
+	return &withSummaryExcerptWords{n}
+}
+
+type summaryASTTransformer struct {
+	SummaryConfig
+}
+
+// NewSummaryASTTransformer returns a new parser.ASTTransformer that extracts
+// a title and an excerpt of the document. The summary can be retrieved by
+// GetSummary.
+func NewSummaryASTTransformer(opts ...SummaryOption) parser.ASTTransformer {
+	a := &summaryASTTransformer{
+		SummaryConfig: NewSummaryConfig(),
+	}
+	for _, o := range opts {
+		o.SetSummaryOption(&a.SummaryConfig)
+	}
+	return a
+}
+
+func (a *summaryASTTransformer) isMoreMarker(value []byte) bool {
+	return len(a.MoreMarker) != 0 && bytes.Equal(util.TrimRightSpace(util.TrimLeftSpace(value)), a.MoreMarker)
+}
+
+func (a *summaryASTTransformer) Transform(node *gast.Document, reader text.Reader, pc parser.Context) {
+	source := reader.Source()
+	summary := &DocumentSummary{
+		MoreOffset: -1,
+	}
+	var title gast.Node
+	for c := node.FirstChild(); c != nil; c = c.NextSibling() {
+		if h, ok := c.(*gast.Heading); ok && h.Level == 1 {
+			title = h
+			summary.Title = taskText(h, source)
+			break
+		}
+	}
+
+	var buf bytes.Buffer
+	var stop bool
+	for c := node.FirstChild(); c != nil && !stop; c = c.NextSibling() {
+		switch c.Kind() {
+		case gast.KindHTMLBlock:
+			if lines := c.Lines(); lines.Len() != 0 && a.isMoreMarker(lines.Value(source)) {
+				summary.MoreOffset = lines.At(0).Start + bytes.Index(source[lines.At(0).Start:], a.MoreMarker)
+				stop = true
+			}
+			continue
+		case gast.KindCodeBlock, gast.KindFencedCodeBlock:
+			continue
+		}
+		if c == title {
+			continue
+		}
+		if buf.Len() != 0 {
+			buf.WriteByte(' ')
+		}
+		_ = gast.Walk(c, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+			if !entering || stop {
+				return gast.WalkContinue, nil
+			}
+			switch v := n.(type) {
+			case *gast.RawHTML:
+				if a.isMoreMarker(v.Segments.Value(source)) {
+					summary.MoreOffset = v.Segments.At(0).Start
+					stop = true
+					return gast.WalkStop, nil
+				}
+			case *gast.FencedCodeBlock, *gast.CodeBlock, *gast.HTMLBlock:
+				return gast.WalkSkipChildren, nil
+			case *gast.Text:
+				buf.Write(v.Segment.Value(source))
+				if v.SoftLineBreak() || v.HardLineBreak() {
+					buf.WriteByte(' ')
+				}
+			case *gast.String:
+				buf.Write(v.Value)
+			case *gast.AutoLink:
+				buf.Write(v.Label(source))
+			}
+			return gast.WalkContinue, nil
+		})
+	}
+
+	words := strings.Fields(buf.String())
+	if summary.MoreOffset >= 0 {
+		rest := source[summary.MoreOffset+len(a.MoreMarker):]
+		summary.Truncated = !util.IsBlank(rest)
+	} else if a.ExcerptWords > 0 && len(words) > a.ExcerptWords {
+		words = words[:a.ExcerptWords]
+		summary.Truncated = true
+	}
+	summary.Excerpt = strings.Join(words, " ")
+	pc.Set(summaryKey, summary)
+}
+
+type summary struct {
+	options []SummaryOption
+}
+
+// Summary is an extension that extracts a title and an excerpt of the
+// document for listing pages. The summary can be retrieved by GetSummary.
+var Summary = &summary{}
+
+// NewSummary returns a new Extender that extracts summaries with the given
+// options.
+func NewSummary(opts ...SummaryOption) goldmark.Extender {
+	return &summary{
+		options: opts,
+	}
+}
+
+func (e *summary) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithASTTransformers(
+			util.Prioritized(NewSummaryASTTransformer(e.options...), 1000),
+		),
+	)
+}
@@ -0,0 +1,86 @@
+package extension
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+)
+
+func TestSummary(t *testing.T) {
+	for i, c := range []struct {
+		opts     []SummaryOption
+		source   string
+		expected DocumentSummary
+	}{
+		{
+			nil,
+			`# My *first* post
+
+Hello, **world**! This is
+an introduction.
+
+` + "```" + `
+code is ignored
+` + "```" + `
+
+<!--more-->
+
+The rest.`,
+			DocumentSummary{
+				Title:      "My first post",
+				Excerpt:    "Hello, world! This is an introduction.",
+				Truncated:  true,
+				MoreOffset: 88,
+			},
+		},
+		{
+			nil,
+			`Intro with an inline <!--more--> marker and [a link](/a).`,
+			DocumentSummary{
+				Excerpt:    "Intro with an inline",
+				Truncated:  true,
+				MoreOffset: 21,
+			},
+		},
+		{
+			[]SummaryOption{WithSummaryExcerptWords(3)},
+			`## Not a title
+
+- one two
+- three four`,
+			DocumentSummary{
+				Excerpt:    "Not a title",
+				Truncated:  true,
+				MoreOffset: -1,
+			},
+		},
+		{
+			[]SummaryOption{WithSummaryMoreMarker("<!-- summary -->"), WithSummaryExcerptWords(3)},
+			`# Title
+
+one two three
+
+<!-- summary -->`,
+			DocumentSummary{
+				Title:      "Title",
+				Excerpt:    "one two three",
+				Truncated:  false,
+				MoreOffset: 24,
+			},
+		},
+	} {
+		markdown := goldmark.New(
+			goldmark.WithExtensions(
+				NewSummary(c.opts...),
+			),
+		)
+		pc := parser.NewContext()
+		markdown.Parser().Parse(text.NewReader([]byte(c.source)), parser.WithContext(pc))
+		if actual := GetSummary(pc); actual == nil || !reflect.DeepEqual(*actual, c.expected) {
+			t.Errorf("%d: expected %+v, but got %+v", i+1, c.expected, actual)
+		}
+	}
+}
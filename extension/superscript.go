@@ -0,0 +1,95 @@
+package extension
+
+import (
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+var defaultSuperscriptDelimiterProcessor = &scriptDelimiterProcessor{
+	char: '^',
+	onMatch: func() gast.Node {
+		return ast.NewSuperscript()
+	},
+}
+
+type superscriptParser struct {
+}
+
+var defaultSuperscriptParser = &superscriptParser{}
+
+// NewSuperscriptParser return a new InlineParser that parses
+// superscripts like '2^10^'.
+func NewSuperscriptParser() parser.InlineParser {
+	return defaultSuperscriptParser
+}
+
+func (s *superscriptParser) Trigger() []byte {
+	return []byte{'^'}
+}
+
+func (s *superscriptParser) Parse(parent gast.Node, block text.Reader, pc parser.Context) gast.Node {
+	node := scanScriptDelimiter(block, defaultSuperscriptDelimiterProcessor)
+	if node == nil {
+		return nil
+	}
+	block.Advance(node.OriginalLength)
+	pc.PushDelimiter(node)
+	return node
+}
+
+func (s *superscriptParser) CloseBlock(parent gast.Node, pc parser.Context) {
+	// nothing to do
+}
+
+// SuperscriptHTMLRenderer is a renderer.NodeRenderer implementation that
+// renders Superscript nodes.
+type SuperscriptHTMLRenderer struct {
+	html.Config
+}
+
+// NewSuperscriptHTMLRenderer returns a new SuperscriptHTMLRenderer.
+func NewSuperscriptHTMLRenderer(opts ...html.Option) renderer.NodeRenderer {
+	r := &SuperscriptHTMLRenderer{
+		Config: html.NewConfig(),
+	}
+	for _, opt := range opts {
+		opt.SetHTMLOption(&r.Config)
+	}
+	return r
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *SuperscriptHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindSuperscript, r.renderSuperscript)
+}
+
+func (r *SuperscriptHTMLRenderer) renderSuperscript(w util.BufWriter, source []byte, n gast.Node, entering bool) (gast.WalkStatus, error) {
+	if entering {
+		_, _ = w.WriteString("<sup>")
+	} else {
+		_, _ = w.WriteString("</sup>")
+	}
+	return gast.WalkContinue, nil
+}
+
+type superscript struct {
+}
+
+// Superscript is an extension that allow you to use superscripts like
+// '2^10^' as in Pandoc.
+var Superscript = &superscript{}
+
+func (e *superscript) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(parser.WithInlineParsers(
+		util.Prioritized(NewSuperscriptParser(), 500),
+	))
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(NewSuperscriptHTMLRenderer(), 500),
+	))
+}
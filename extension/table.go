@@ -21,15 +21,67 @@ var tableDelimRight = regexp.MustCompile(`^\s*\-+\:\s*$`)
 var tableDelimCenter = regexp.MustCompile(`^\s*\:\-+\:\s*$`)
 var tableDelimNone = regexp.MustCompile(`^\s*\-+\s*$`)
 
+// A TableConfig struct is a data structure that holds configuration of the
+// Table extension.
+type TableConfig struct {
+	// CellSpan is true if cells can be merged with neighbouring cells.
+	CellSpan bool
+
+	// Footer is true if rows after a second delimiter row are footers.
+	Footer bool
+}
+
+// A TableOption interface sets options for the Table extension.
+type TableOption interface {
+	SetTableOption(*TableConfig)
+}
+
+type withTableCellSpan struct {
+}
+
+func (o *withTableCellSpan) SetTableOption(c *TableConfig) {
+	c.CellSpan = true
+}
+
+// WithTableCellSpan is a functional option that allows cells to span
+// multiple columns and rows like MultiMarkdown. An empty cell without spaces
+// like '| a ||' merges with the left cell, and a cell that consists of '^^'
+// merges with the cell above it.
+func WithTableCellSpan() TableOption {
+	return &withTableCellSpan{}
+}
+
+type withTableFooter struct {
+}
+
+func (o *withTableFooter) SetTableOption(c *TableConfig) {
+	c.Footer = true
+}
+
+// WithTableFooter is a functional option that allows tables to have
+// footers. Rows after a second delimiter row like '|---|---|' are rendered
+// in a 'tfoot' element.
+func WithTableFooter() TableOption {
+	return &withTableFooter{}
+}
+
 type tableParagraphTransformer struct {
+	TableConfig
 }
 
 var defaultTableParagraphTransformer = &tableParagraphTransformer{}
 
 // NewTableParagraphTransformer returns  a new ParagraphTransformer
 // that can transform pargraphs into tables.
-func NewTableParagraphTransformer() parser.ParagraphTransformer {
-	return defaultTableParagraphTransformer
+func NewTableParagraphTransformer(opts ...TableOption) parser.ParagraphTransformer {
+	if len(opts) == 0 {
+		return defaultTableParagraphTransformer
+	}
+	p := &tableParagraphTransformer{}
+	for _, o := range opts {
+		o.SetTableOption(&p.TableConfig)
+	}
+	return p
 }
 
 func (b *tableParagraphTransformer) Transform(node *gast.Paragraph, reader text.Reader, pc parser.Context) {
@@ -42,21 +94,86 @@ func (b *tableParagraphTransformer) Transform(node *gast.Paragraph, reader text.
 		return
 	}
 	header := b.parseRow(lines.At(0), alignments, true, reader)
-	if header == nil || len(alignments) != header.ChildCount() {
+	if header == nil || len(alignments) != tableRowWidth(header) {
 		return
 	}
 	table := ast.NewTable()
 	table.Alignments = alignments
+	// keeps attributes set to the paragraph by other transformers
+	// like the KramdownIALParagraphTransformer.
+	for _, attr := range node.Attributes() {
+		table.SetAttribute(attr.Name, attr.Value)
+	}
 	table.AppendChild(table, ast.NewTableHeader(header))
-	if lines.Len() > 2 {
-		for i := 2; i < lines.Len(); i++ {
-			table.AppendChild(table, b.parseRow(lines.At(i), alignments, false, reader))
+	var footer *ast.TableFooter
+	for i := 2; i < lines.Len(); i++ {
+		if b.Footer && footer == nil && i < lines.Len()-1 && b.parseDelimiter(lines.At(i), reader) != nil {
+			footer = ast.NewTableFooter()
+			continue
+		}
+		row := b.parseRow(lines.At(i), alignments, false, reader)
+		if footer != nil {
+			footer.AppendChild(footer, row)
+		} else {
+			table.AppendChild(table, row)
+		}
+	}
+	if b.CellSpan {
+		b.mergeRows(table.FirstChild().NextSibling(), len(alignments), reader)
+	}
+	if footer != nil {
+		if b.CellSpan {
+			b.mergeRows(footer.FirstChild(), len(alignments), reader)
 		}
+		table.AppendChild(table, footer)
 	}
 	node.Parent().InsertBefore(node.Parent(), node, table)
 	node.Parent().RemoveChild(node.Parent(), node)
 }
 
+// tableRowWidth returns a number of columns the given row spans.
+func tableRowWidth(row gast.Node) int {
+	width := 0
+	for c := row.FirstChild(); c != nil; c = c.NextSibling() {
+		width += c.(*ast.TableCell).ColSpan
+	}
+	return width
+}
+
+func isRowSpanMarker(cell *ast.TableCell, source []byte) bool {
+	if cell.Lines().Len() != 1 {
+		return false
+	}
+	segment := cell.Lines().At(0)
+	return bytes.Equal(segment.Value(source), []byte("^^"))
+}
+
+// mergeRows merges cells that consist of '^^' with cells above them in the
+// given row and following rows.
+func (b *tableParagraphTransformer) mergeRows(first gast.Node, columns int, reader text.Reader) {
+	source := reader.Source()
+	var above []*ast.TableCell
+	for row := first; row != nil && row.Kind() == ast.KindTableRow; row = row.NextSibling() {
+		current := make([]*ast.TableCell, columns)
+		col := 0
+		for c := row.FirstChild(); c != nil && col < columns; {
+			next := c.NextSibling()
+			cell := c.(*ast.TableCell)
+			if above != nil && above[col] != nil && isRowSpanMarker(cell, source) {
+				row.RemoveChild(row, cell)
+				cell = above[col]
+				cell.RowSpan++
+			}
+			for i := 0; i < cell.ColSpan && col < columns; i++ {
+				current[col] = cell
+				col++
+			}
+			c = next
+		}
+		above = current
+	}
+}
+
 func (b *tableParagraphTransformer) parseRow(segment text.Segment, alignments []ast.Alignment, isHeader bool, reader text.Reader) *ast.TableRow {
 	source := reader.Source()
 	line := segment.Value(source)
@@ -85,6 +202,11 @@ func (b *tableParagraphTransformer) parseRow(segment text.Segment, alignments []
 		if closure < 0 {
 			closure = len(line[pos:])
 		}
+		if b.CellSpan && closure == 0 && row.LastChild() != nil {
+			row.LastChild().(*ast.TableCell).ColSpan++
+			pos++
+			continue
+		}
 		node := ast.NewTableCell()
 		segment := text.NewSegment(segment.Start+pos, segment.Start+pos+closure)
 		segment = segment.TrimLeftSpace(source)
@@ -94,6 +216,11 @@ func (b *tableParagraphTransformer) parseRow(segment text.Segment, alignments []
 		row.AppendChild(row, node)
 		pos += closure + 1
 	}
+	if b.CellSpan && pos == limit && row.LastChild() != nil {
+		// an empty cell between the last two pipes like '| a ||'
+		row.LastChild().(*ast.TableCell).ColSpan++
+		i++
+	}
 	for ; i < len(alignments); i++ {
 		row.AppendChild(row, ast.NewTableCell())
 	}
@@ -164,12 +291,17 @@ func (r *TableHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegistere
 	reg.Register(ast.KindTable, r.renderTable)
 	reg.Register(ast.KindTableHeader, r.renderTableHeader)
 	reg.Register(ast.KindTableRow, r.renderTableRow)
+	reg.Register(ast.KindTableFooter, r.renderTableFooter)
 	reg.Register(ast.KindTableCell, r.renderTableCell)
 }
 
 func (r *TableHTMLRenderer) renderTable(w util.BufWriter, source []byte, n gast.Node, entering bool) (gast.WalkStatus, error) {
 	if entering {
-		w.WriteString("<table>\n")
+		w.WriteString("<table")
+		if n.Attributes() != nil || r.SourcePos {
+			r.RenderAttributes(w, n)
+		}
+		w.WriteString(">\n")
 	} else {
 		w.WriteString("</table>\n")
 	}
@@ -183,7 +315,7 @@ func (r *TableHTMLRenderer) renderTableHeader(w util.BufWriter, source []byte, n
 	} else {
 		w.WriteString("</tr>\n")
 		w.WriteString("</thead>\n")
-		if n.NextSibling() != nil {
+		if next := n.NextSibling(); next != nil && next.Kind() != ast.KindTableFooter {
 			w.WriteString("<tbody>\n")
 		}
 	}
@@ -191,17 +323,31 @@ func (r *TableHTMLRenderer) renderTableHeader(w util.BufWriter, source []byte, n
 }
 
 func (r *TableHTMLRenderer) renderTableRow(w util.BufWriter, source []byte, n gast.Node, entering bool) (gast.WalkStatus, error) {
+	inBody := n.Parent().Kind() == ast.KindTable
 	if entering {
+		if inBody && n.PreviousSibling() == nil {
+			// tables without headers
+			w.WriteString("<tbody>\n")
+		}
 		w.WriteString("<tr>\n")
 	} else {
 		w.WriteString("</tr>\n")
-		if n.Parent().LastChild() == n {
+		if next := n.NextSibling(); inBody && (next == nil || next.Kind() == ast.KindTableFooter) {
 			w.WriteString("</tbody>\n")
 		}
 	}
 	return gast.WalkContinue, nil
 }
 
+func (r *TableHTMLRenderer) renderTableFooter(w util.BufWriter, source []byte, n gast.Node, entering bool) (gast.WalkStatus, error) {
+	if entering {
+		w.WriteString("<tfoot>\n")
+	} else {
+		w.WriteString("</tfoot>\n")
+	}
+	return gast.WalkContinue, nil
+}
+
 func (r *TableHTMLRenderer) renderTableCell(w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
 	n := node.(*ast.TableCell)
 	tag := "td"
@@ -211,9 +357,21 @@ func (r *TableHTMLRenderer) renderTableCell(w util.BufWriter, source []byte, nod
 	if entering {
 		align := ""
 		if n.Alignment != ast.AlignNone {
-			align = fmt.Sprintf(` align="%s"`, n.Alignment.String())
+			if r.EPUB {
+				// align attributes are deprecated in XHTML 1.1
+				align = fmt.Sprintf(` style="text-align: %s"`, n.Alignment.String())
+			} else {
+				align = fmt.Sprintf(` align="%s"`, n.Alignment.String())
+			}
+		}
+		fmt.Fprintf(w, "<%s%s", tag, align)
+		if n.ColSpan > 1 {
+			fmt.Fprintf(w, ` colspan="%d"`, n.ColSpan)
+		}
+		if n.RowSpan > 1 {
+			fmt.Fprintf(w, ` rowspan="%d"`, n.RowSpan)
 		}
-		fmt.Fprintf(w, "<%s%s>", tag, align)
+		_ = w.WriteByte('>')
 	} else {
 		fmt.Fprintf(w, "</%s>\n", tag)
 	}
@@ -221,14 +379,23 @@ func (r *TableHTMLRenderer) renderTableCell(w util.BufWriter, source []byte, nod
 }
 
 type table struct {
+	options []TableOption
 }
 
 // Table is an extension that allow you to use GFM tables .
 var Table = &table{}
 
+// NewTable returns a new Extender that allow you to use GFM tables with the
+// given options.
+func NewTable(opts ...TableOption) goldmark.Extender {
+	return &table{
+		options: opts,
+	}
+}
+
 func (e *table) Extend(m goldmark.Markdown) {
 	m.Parser().AddOptions(parser.WithParagraphTransformers(
-		util.Prioritized(NewTableParagraphTransformer(), 200),
+		util.Prioritized(NewTableParagraphTransformer(e.options...), 200),
 	))
 	m.Renderer().AddOptions(renderer.WithNodeRenderers(
 		util.Prioritized(NewTableHTMLRenderer(), 500),
@@ -17,3 +17,176 @@ func TestTable(t *testing.T) {
 	)
 	goldmark.DoTestCaseFile(markdown, "_test/table.txt", t)
 }
+
+func TestTableEPUB(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithRendererOptions(
+			html.WithEPUB(),
+		),
+		goldmark.WithExtensions(
+			Table,
+			Typographer,
+		),
+	)
+	goldmark.DoTestCases(markdown, []goldmark.MarkdownTestCase{
+		{
+			No: 1,
+			Markdown: `| "a" | b |
+|:---|---:|
+| c -- d | e |
+`,
+			Expected: `<table>
+<thead>
+<tr>
+<th style="text-align: left">&#8220;a&#8221;</th>
+<th style="text-align: right">b</th>
+</tr>
+</thead>
+<tbody>
+<tr>
+<td style="text-align: left">c &#8211; d</td>
+<td style="text-align: right">e</td>
+</tr>
+</tbody>
+</table>`,
+		},
+	}, t)
+}
+
+func TestTableCellSpan(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			NewTable(WithTableCellSpan()),
+		),
+	)
+	goldmark.DoTestCases(markdown, []goldmark.MarkdownTestCase{
+		{
+			No: 1,
+			Markdown: `| Name || Total |
+|---|---|---|
+| a | 1 | 3 |
+| ^^ | 2 | ^^ |
+| b | 4 ||
+`,
+			Expected: `<table>
+<thead>
+<tr>
+<th colspan="2">Name</th>
+<th>Total</th>
+</tr>
+</thead>
+<tbody>
+<tr>
+<td rowspan="2">a</td>
+<td>1</td>
+<td rowspan="2">3</td>
+</tr>
+<tr>
+<td>2</td>
+</tr>
+<tr>
+<td>b</td>
+<td colspan="2">4</td>
+</tr>
+</tbody>
+</table>`,
+		},
+		{
+			No: 2,
+			Markdown: `| a | b |
+|---|---|
+| ^^ | |
+| c \|| d |
+`,
+			Expected: `<table>
+<thead>
+<tr>
+<th>a</th>
+<th>b</th>
+</tr>
+</thead>
+<tbody>
+<tr>
+<td>^^</td>
+<td></td>
+</tr>
+<tr>
+<td>c |</td>
+<td>d</td>
+</tr>
+</tbody>
+</table>`,
+		},
+	}, t)
+}
+
+func TestTableFooter(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			NewTable(WithTableFooter()),
+		),
+	)
+	goldmark.DoTestCases(markdown, []goldmark.MarkdownTestCase{
+		{
+			No: 1,
+			Markdown: `| Item | Price |
+|------|------:|
+| Tea  |  1.20 |
+| Cake |  3.50 |
+|------|-------|
+| Total|  4.70 |
+`,
+			Expected: `<table>
+<thead>
+<tr>
+<th>Item</th>
+<th align="right">Price</th>
+</tr>
+</thead>
+<tbody>
+<tr>
+<td>Tea</td>
+<td align="right">1.20</td>
+</tr>
+<tr>
+<td>Cake</td>
+<td align="right">3.50</td>
+</tr>
+</tbody>
+<tfoot>
+<tr>
+<td>Total</td>
+<td align="right">4.70</td>
+</tr>
+</tfoot>
+</table>`,
+		},
+		{
+			No: 2,
+			Markdown: `| a | b |
+|---|---|
+|---|---|
+| c | d |
+|---|---|
+`,
+			Expected: `<table>
+<thead>
+<tr>
+<th>a</th>
+<th>b</th>
+</tr>
+</thead>
+<tfoot>
+<tr>
+<td>c</td>
+<td>d</td>
+</tr>
+<tr>
+<td>---</td>
+<td>---</td>
+</tr>
+</tfoot>
+</table>`,
+		},
+	}, t)
+}
@@ -0,0 +1,19 @@
+package extension
+
+import (
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/renderer/html"
+)
+
+type tagFilter struct {
+}
+
+// TagFilter is an extension that escapes tags disallowed by the GFM
+// tagfilter extension like '<script>', '<iframe>' and '<style>' in raw HTML.
+// Other raw HTML is rendered as is.
+// This extension takes effect only if html.WithUnsafe is set.
+var TagFilter = &tagFilter{}
+
+func (e *tagFilter) Extend(m goldmark.Markdown) {
+	m.Renderer().AddOptions(html.WithTagFilter())
+}
@@ -0,0 +1,41 @@
+package extension
+
+import (
+	"testing"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/renderer/html"
+)
+
+func TestTagFilter(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithRendererOptions(
+			html.WithUnsafe(),
+		),
+		goldmark.WithExtensions(
+			TagFilter,
+		),
+	)
+	goldmark.DoTestCaseFile(markdown, "_test/tagfilter.txt", t)
+}
+
+func TestTagFilterRawHTMLRewriter(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithRendererOptions(
+			html.WithRawHTMLRewriter(func(tag *html.HTMLTag) bool {
+				tag.RemoveAttribute("onclick")
+				return true
+			}),
+		),
+		goldmark.WithExtensions(
+			TagFilter,
+		),
+	)
+	goldmark.DoTestCases(markdown, []goldmark.MarkdownTestCase{
+		{
+			No:       1,
+			Markdown: `<b onclick="x()">a</b> <Style type="text/css">b</style>`,
+			Expected: `<p><b>a</b> &lt;style type="text/css">b&lt;/style></p>`,
+		},
+	}, t)
+}
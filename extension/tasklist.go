@@ -1,6 +1,9 @@
 package extension
 
 import (
+	"bytes"
+	"strings"
+
 	"github.com/yuin/goldmark"
 	gast "github.com/yuin/goldmark/ast"
 	"github.com/yuin/goldmark/extension/ast"
@@ -9,12 +12,124 @@ import (
 	"github.com/yuin/goldmark/renderer/html"
 	"github.com/yuin/goldmark/text"
 	"github.com/yuin/goldmark/util"
-	"regexp"
 )
 
-var taskListRegexp = regexp.MustCompile(`^\[([\sxX])\]\s*`)
+// A TaskListConfig struct is a data structure that holds configuration of
+// the TaskList extension.
+type TaskListConfig struct {
+	// States is a map of additional states like '-' to classes of list
+	// items.
+	States map[byte]string
+
+	// ListClass is a class of lists that contain tasks.
+	ListClass string
+
+	// ItemClass is a class of list items of tasks.
+	ItemClass string
+
+	// CheckBoxClass is a class of checkboxes.
+	CheckBoxClass string
+}
+
+// A TaskListOption interface sets options for the TaskList extension.
+type TaskListOption interface {
+	SetTaskListOption(*TaskListConfig)
+}
+
+type withTaskListState struct {
+	state byte
+	class string
+}
+
+func (o *withTaskListState) SetTaskListOption(c *TaskListConfig) {
+	if c.States == nil {
+		c.States = map[byte]string{}
+	}
+	c.States[o.state] = o.class
+}
+
+// WithTaskListState is a functional option that adds a state of tasks like
+// '[-]'. List items of tasks with the state have the given class.
+func WithTaskListState(state byte, class string) TaskListOption {
+	return &withTaskListState{state, class}
+}
+
+type withTaskListExtendedStates struct {
+}
+
+func (o *withTaskListExtendedStates) SetTaskListOption(c *TaskListConfig) {
+	for state, class := range map[byte]string{
+		'-': "task-cancelled",
+		'~': "task-in-progress",
+		'/': "task-in-progress",
+	} {
+		(&withTaskListState{state, class}).SetTaskListOption(c)
+	}
+}
+
+// WithTaskListExtendedStates is a functional option that adds Obsidian
+// style states: cancelled tasks like '[-]' and tasks in progress like '[~]'
+// and '[/]'. List items of these tasks have 'task-cancelled' and
+// 'task-in-progress' classes.
+func WithTaskListExtendedStates() TaskListOption {
+	return &withTaskListExtendedStates{}
+}
+
+type withTaskListClasses struct {
+	list     string
+	item     string
+	checkBox string
+}
+
+func (o *withTaskListClasses) SetTaskListOption(c *TaskListConfig) {
+	c.ListClass = o.list
+	c.ItemClass = o.item
+	c.CheckBoxClass = o.checkBox
+}
+
+// WithTaskListClasses is a functional option that sets classes of lists
+// that contain tasks, list items of tasks and checkboxes like
+// 'contains-task-list', 'task-list-item' and 'task-list-item-checkbox'.
+// Empty values mean no classes.
+func WithTaskListClasses(list, item, checkBox string) TaskListOption {
+	return &withTaskListClasses{list, item, checkBox}
+}
+
+// A Task struct represents a task of task lists found in the document.
+type Task struct {
+	// Text is a plain text of the task.
+	Text string
+
+	// State is a character between brackets like ' ', 'x' and '-'.
+	State byte
+
+	// Checked is true if the task is checked.
+	Checked bool
+
+	// Line is a line number of the task, starting at 1.
+	Line int
+
+	// Offset is a byte offset of the checkbox in the source.
+	Offset int
+}
+
+var tasksKey = parser.NewContextKey()
+
+type taskCollection struct {
+	tasks   []Task
+	parents []gast.Node
+}
+
+// Tasks returns tasks found in the document in order of appearance.
+func Tasks(pc parser.Context) []Task {
+	if v, ok := pc.Get(tasksKey).(*taskCollection); ok {
+		return v.tasks
+	}
+	return nil
+}
 
 type taskCheckBoxParser struct {
+	TaskListConfig
 }
 
 var defaultTaskCheckBoxParser = &taskCheckBoxParser{}
@@ -22,8 +137,15 @@ var defaultTaskCheckBoxParser = &taskCheckBoxParser{}
 // NewTaskCheckBoxParser returns a new  InlineParser that can parse
 // checkboxes in list items.
 // This parser must take precedence over the parser.LinkParser.
-func NewTaskCheckBoxParser() parser.InlineParser {
-	return defaultTaskCheckBoxParser
+func NewTaskCheckBoxParser(opts ...TaskListOption) parser.InlineParser {
+	if len(opts) == 0 {
+		return defaultTaskCheckBoxParser
+	}
+	p := &taskCheckBoxParser{}
+	for _, o := range opts {
+		o.SetTaskListOption(&p.TaskListConfig)
+	}
+	return p
 }
 
 func (s *taskCheckBoxParser) Trigger() []byte {
@@ -40,24 +162,113 @@ func (s *taskCheckBoxParser) Parse(parent gast.Node, block text.Reader, pc parse
 		return nil
 	}
 
-	if _, ok := parent.Parent().(*gast.ListItem); !ok {
+	listItem, ok := parent.Parent().(*gast.ListItem)
+	if !ok {
 		return nil
 	}
-	line, _ := block.PeekLine()
-	m := taskListRegexp.FindSubmatchIndex(line)
-	if m == nil {
+	line, segment := block.PeekLine()
+	if len(line) < 3 || line[0] != '[' || line[2] != ']' {
 		return nil
 	}
-	value := line[m[2]:m[3]][0]
-	block.Advance(m[1])
-	checked := value == 'x' || value == 'X'
-	return ast.NewTaskCheckBox(checked)
+	state := line[1]
+	class, ok := s.States[state]
+	if !ok && !util.IsSpace(state) && state != 'x' && state != 'X' {
+		return nil
+	}
+	block.Advance(3 + util.TrimLeftSpaceLength(line[3:]))
+	addTaskClass(listItem, s.ItemClass)
+	addTaskClass(listItem, class)
+	if list := listItem.Parent(); list != nil {
+		addTaskClass(list, s.ListClass)
+	}
+	checkBox := ast.NewTaskCheckBoxState(state)
+	addTaskClass(checkBox, s.CheckBoxClass)
+	list := parser.ContextState(pc, tasksKey, func() interface{} {
+		return &taskCollection{}
+	}).(*taskCollection)
+	list.tasks = append(list.tasks, Task{
+		State:   state,
+		Checked: checkBox.IsChecked,
+		Offset:  segment.Start,
+	})
+	list.parents = append(list.parents, parent)
+	return checkBox
+}
+
+// addTaskClass adds the given class to the given node unless the node
+// already has the class.
+func addTaskClass(n gast.Node, class string) {
+	if len(class) == 0 {
+		return
+	}
+	if v, ok := n.Attribute(attrNameClass); ok {
+		for _, c := range bytes.Fields(v) {
+			if string(c) == class {
+				return
+			}
+		}
+		class = string(v) + " " + class
+	}
+	n.SetAttribute(attrNameClass, []byte(class))
 }
 
 func (s *taskCheckBoxParser) CloseBlock(parent gast.Node, pc parser.Context) {
 	// nothing to do
 }
 
+type taskTextTransformer struct {
+}
+
+var defaultTaskTextTransformer = &taskTextTransformer{}
+
+// NewTaskTextTransformer returns a new ASTTransformer that fills texts and
+// line numbers of tasks returned by Tasks.
+func NewTaskTextTransformer() parser.ASTTransformer {
+	return defaultTaskTextTransformer
+}
+
+func (t *taskTextTransformer) Transform(node *gast.Document, reader text.Reader, pc parser.Context) {
+	list, ok := pc.Get(tasksKey).(*taskCollection)
+	if !ok {
+		return
+	}
+	if len(list.parents) == 0 {
+		return
+	}
+	source := reader.Source()
+	// parents are parents of tasks that are not filled yet. parents may be
+	// shorter than tasks if the context is reused across documents.
+	offset := len(list.tasks) - len(list.parents)
+	for i, parent := range list.parents {
+		task := &list.tasks[offset+i]
+		task.Text = taskText(parent, source)
+		task.Line = bytes.Count(source[:task.Offset], []byte{'\n'}) + 1
+	}
+	list.parents = nil
+}
+
+func taskText(n gast.Node, source []byte) string {
+	var buf bytes.Buffer
+	_ = gast.Walk(n, func(c gast.Node, entering bool) (gast.WalkStatus, error) {
+		if !entering {
+			return gast.WalkContinue, nil
+		}
+		switch v := c.(type) {
+		case *gast.Text:
+			buf.Write(v.Segment.Value(source))
+			if v.SoftLineBreak() || v.HardLineBreak() {
+				buf.WriteByte(' ')
+			}
+		case *gast.String:
+			buf.Write(v.Value)
+		case *gast.AutoLink:
+			buf.Write(v.Label(source))
+		}
+		return gast.WalkContinue, nil
+	})
+	return strings.TrimSpace(buf.String())
+}
+
 // TaskCheckBoxHTMLRenderer is a renderer.NodeRenderer implementation that
 // renders checkboxes in list items.
 type TaskCheckBoxHTMLRenderer struct {
@@ -91,6 +302,9 @@ func (r *TaskCheckBoxHTMLRenderer) renderTaskCheckBox(w util.BufWriter, source [
 	} else {
 		w.WriteString(`<input disabled="" type="checkbox"`)
 	}
+	if n.Attributes() != nil {
+		r.RenderAttributes(w, n)
+	}
 	if r.XHTML {
 		w.WriteString(" />")
 	} else {
@@ -100,14 +314,25 @@ func (r *TaskCheckBoxHTMLRenderer) renderTaskCheckBox(w util.BufWriter, source [
 }
 
 type taskList struct {
+	options []TaskListOption
 }
 
 // TaskList is an extension that allow you to use GFM task lists.
 var TaskList = &taskList{}
 
+// NewTaskList returns a new Extender that allow you to use GFM task lists
+// with the given options.
+func NewTaskList(opts ...TaskListOption) goldmark.Extender {
+	return &taskList{
+		options: opts,
+	}
+}
+
 func (e *taskList) Extend(m goldmark.Markdown) {
 	m.Parser().AddOptions(parser.WithInlineParsers(
-		util.Prioritized(NewTaskCheckBoxParser(), 0),
+		util.Prioritized(NewTaskCheckBoxParser(e.options...), 0),
+	), parser.WithASTTransformers(
+		util.Prioritized(NewTaskTextTransformer(), 999),
 	))
 	m.Renderer().AddOptions(renderer.WithNodeRenderers(
 		util.Prioritized(NewTaskCheckBoxHTMLRenderer(), 500),
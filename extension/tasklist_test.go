@@ -1,9 +1,13 @@
 package extension
 
 import (
+	"bytes"
+	"reflect"
+	"testing"
+
 	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/parser"
 	"github.com/yuin/goldmark/renderer/html"
-	"testing"
 )
 
 func TestTaskList(t *testing.T) {
@@ -17,3 +21,113 @@ func TestTaskList(t *testing.T) {
 	)
 	goldmark.DoTestCaseFile(markdown, "_test/tasklist.txt", t)
 }
+
+func TestTaskListStates(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			NewTaskList(
+				WithTaskListExtendedStates(),
+				WithTaskListState('?', "task-question"),
+			),
+		),
+	)
+	goldmark.DoTestCases(markdown, []goldmark.MarkdownTestCase{
+		{
+			No: 1,
+			Markdown: `- [ ] todo
+- [x] done
+- [-] cancelled
+- [~] doing
+- [/] doing
+- [?] asked
+- [!] not a task`,
+			Expected: `<ul>
+<li><input disabled="" type="checkbox">todo</li>
+<li><input checked="" disabled="" type="checkbox">done</li>
+<li class="task-cancelled"><input disabled="" type="checkbox">cancelled</li>
+<li class="task-in-progress"><input disabled="" type="checkbox">doing</li>
+<li class="task-in-progress"><input disabled="" type="checkbox">doing</li>
+<li class="task-question"><input disabled="" type="checkbox">asked</li>
+<li>[!] not a task</li>
+</ul>`,
+		},
+	}, t)
+}
+
+func TestTaskListClasses(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithRendererOptions(
+			html.WithXHTML(),
+		),
+		goldmark.WithExtensions(
+			NewTaskList(
+				WithTaskListExtendedStates(),
+				WithTaskListClasses("contains-task-list", "task-list-item", "task-list-item-checkbox"),
+			),
+		),
+	)
+	goldmark.DoTestCases(markdown, []goldmark.MarkdownTestCase{{
+		No: 1,
+		Markdown: `- [x] done
+- [-] cancelled
+- not a task`,
+		Expected: `<ul class="contains-task-list">
+<li class="task-list-item"><input checked="" disabled="" type="checkbox" class="task-list-item-checkbox" />done</li>
+<li class="task-list-item task-cancelled"><input disabled="" type="checkbox" class="task-list-item-checkbox" />cancelled</li>
+<li>not a task</li>
+</ul>`,
+	}}, t)
+}
+
+func TestTasks(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			NewTaskList(WithTaskListExtendedStates()),
+		),
+	)
+	source := []byte("# TODO\n\n- [ ] write *docs*\n  for `Tasks`\n- [x] done\n  - [-] nested\n- not a task\n")
+	pc := parser.NewContext()
+	var buf bytes.Buffer
+	if err := markdown.Convert(source, &buf, parser.WithContext(pc)); err != nil {
+		t.Fatal(err)
+	}
+	expected := []Task{
+		{Text: "write docs for Tasks", State: ' ', Line: 3, Offset: 10},
+		{Text: "done", State: 'x', Checked: true, Line: 5, Offset: 43},
+		{Text: "nested", State: '-', Line: 6, Offset: 56},
+	}
+	if tasks := Tasks(pc); !reflect.DeepEqual(tasks, expected) {
+		t.Errorf("unexpected tasks: %+v", tasks)
+	}
+}
+
+func TestTaskListRegisteredTwice(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			GFM,
+			TaskList,
+		),
+	)
+	goldmark.DoTestCases(markdown, []goldmark.MarkdownTestCase{{
+		No:       1,
+		Markdown: "- [x] a",
+		Expected: `<ul>
+<li><input checked="" disabled="" type="checkbox">a</li>
+</ul>`,
+	}}, t)
+
+	pc := parser.NewContext()
+	var buf bytes.Buffer
+	for _, source := range []string{"- [ ] a\n", "text\n\n- [x] b\n"} {
+		if err := markdown.Convert([]byte(source), &buf, parser.WithContext(pc)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	expected := []Task{
+		{Text: "a", State: ' ', Line: 1, Offset: 2},
+		{Text: "b", State: 'x', Checked: true, Line: 3, Offset: 8},
+	}
+	if tasks := Tasks(pc); !reflect.DeepEqual(tasks, expected) {
+		t.Errorf("unexpected tasks: %+v", tasks)
+	}
+}
@@ -1,6 +1,10 @@
 package extension
 
 import (
+	"bytes"
+	"strings"
+	"unicode"
+
 	"github.com/yuin/goldmark"
 	gast "github.com/yuin/goldmark/ast"
 	"github.com/yuin/goldmark/parser"
@@ -31,14 +35,52 @@ const (
 	LeftAngleQuote
 	// RightAngleQuote is >>
 	RightAngleQuote
+	// OneHalf is 1/2
+	OneHalf
+	// OneQuarter is 1/4
+	OneQuarter
+	// ThreeQuarters is 3/4
+	ThreeQuarters
+	// Copyright is (c)
+	Copyright
+	// Registered is (r)
+	Registered
+	// Trademark is (tm)
+	Trademark
+	// PlusMinus is +-
+	PlusMinus
 
 	typographicPunctuationMax
 )
 
+// TypographicSymbols is a set of flags that enable substitutions of
+// symbols like '(c)'. These substitutions are disabled by default.
+type TypographicSymbols int
+
+const (
+	// TypographicFractions enables OneHalf, OneQuarter and ThreeQuarters.
+	TypographicFractions TypographicSymbols = 1 << iota
+	// TypographicCopyright enables Copyright.
+	TypographicCopyright
+	// TypographicRegistered enables Registered.
+	TypographicRegistered
+	// TypographicTrademark enables Trademark.
+	TypographicTrademark
+	// TypographicPlusMinus enables PlusMinus.
+	TypographicPlusMinus
+
+	// TypographicAllSymbols enables all symbols.
+	TypographicAllSymbols = TypographicFractions | TypographicCopyright |
+		TypographicRegistered | TypographicTrademark | TypographicPlusMinus
+)
+
 // An TypographerConfig struct is a data structure that holds configuration of the
 // Typographer extension.
 type TypographerConfig struct {
 	Substitutions [][]byte
+
+	// Symbols is a set of enabled symbols.
+	Symbols TypographicSymbols
 }
 
 func newDefaultSubstitutions() [][]byte {
@@ -52,6 +94,13 @@ func newDefaultSubstitutions() [][]byte {
 	replacements[Ellipsis] = []byte("&hellip;")
 	replacements[LeftAngleQuote] = []byte("&laquo;")
 	replacements[RightAngleQuote] = []byte("&raquo;")
+	replacements[OneHalf] = []byte("&frac12;")
+	replacements[OneQuarter] = []byte("&frac14;")
+	replacements[ThreeQuarters] = []byte("&frac34;")
+	replacements[Copyright] = []byte("&copy;")
+	replacements[Registered] = []byte("&reg;")
+	replacements[Trademark] = []byte("&trade;")
+	replacements[PlusMinus] = []byte("&plusmn;")
 
 	return replacements
 }
@@ -61,6 +110,8 @@ func (b *TypographerConfig) SetOption(name parser.OptionName, value interface{})
 	switch name {
 	case optTypographicSubstitutions:
 		b.Substitutions = value.([][]byte)
+	case optTypographicSymbols:
+		b.Symbols = value.(TypographicSymbols)
 	}
 }
 
@@ -98,6 +149,88 @@ func WithTypographicSubstitutions(values map[TypographicPunctuation][]byte) Typo
 	return &withTypographicSubstitutions{replacements}
 }
 
+const optTypographicSymbols parser.OptionName = "TypographicSymbols"
+
+type withTypographicSymbols struct {
+	value TypographicSymbols
+}
+
+func (o *withTypographicSymbols) SetParserOption(c *parser.Config) {
+	c.Options[optTypographicSymbols] = o.value
+}
+
+func (o *withTypographicSymbols) SetTypographerOption(p *TypographerConfig) {
+	p.Symbols = o.value
+}
+
+// WithTypographicSymbols is a functional option that enables substitutions
+// of the given symbols like 'TypographicFractions|TypographicCopyright'.
+func WithTypographicSymbols(symbols TypographicSymbols) TypographerOption {
+	return &withTypographicSymbols{symbols}
+}
+
+var typographicLocales = map[string]TypographicSubstitutions{
+	"en": {},
+	"de": {
+		LeftSingleQuote:  []byte("&sbquo;"),
+		RightSingleQuote: []byte("&lsquo;"),
+		LeftDoubleQuote:  []byte("&bdquo;"),
+		RightDoubleQuote: []byte("&ldquo;"),
+	},
+	"fr": {
+		LeftSingleQuote:  []byte("&lsaquo;&nbsp;"),
+		RightSingleQuote: []byte("&nbsp;&rsaquo;"),
+		LeftDoubleQuote:  []byte("&laquo;&nbsp;"),
+		RightDoubleQuote: []byte("&nbsp;&raquo;"),
+	},
+	"ja": {
+		LeftSingleQuote:  []byte("&#12302;"),
+		RightSingleQuote: []byte("&#12303;"),
+		LeftDoubleQuote:  []byte("&#12300;"),
+		RightDoubleQuote: []byte("&#12301;"),
+	},
+	"pl": {
+		LeftSingleQuote:  []byte("&sbquo;"),
+		RightSingleQuote: []byte("&rsquo;"),
+		LeftDoubleQuote:  []byte("&bdquo;"),
+		RightDoubleQuote: []byte("&rdquo;"),
+	},
+	"sv": {
+		LeftSingleQuote:  []byte("&rsquo;"),
+		RightSingleQuote: []byte("&rsquo;"),
+		LeftDoubleQuote:  []byte("&rdquo;"),
+		RightDoubleQuote: []byte("&rdquo;"),
+	},
+}
+
+// TypographicLocaleSubstitutions returns substitutions of quotes for the
+// given locale like 'fr' and 'de-CH'. Only languages of locales are
+// considered, and nil is returned if the language is not supported.
+// Supported languages are 'de', 'en', 'fr', 'ja', 'pl' and 'sv'.
+func TypographicLocaleSubstitutions(locale string) TypographicSubstitutions {
+	lang := strings.ToLower(locale)
+	if i := strings.IndexAny(lang, "-_"); i > -1 {
+		lang = lang[:i]
+	}
+	preset, ok := typographicLocales[lang]
+	if !ok {
+		return nil
+	}
+	values := TypographicSubstitutions{}
+	for k, v := range preset {
+		values[k] = v
+	}
+	return values
+}
+
+// WithTypographicLocale is a functional option that replaces quotes with
+// ones used in the given locale like '&laquo;&nbsp;' in French.
+// Substitutions set by WithTypographicSubstitutions after this option
+// override this option.
+func WithTypographicLocale(locale string) TypographerOption {
+	return WithTypographicSubstitutions(TypographicLocaleSubstitutions(locale))
+}
+
 type typographerDelimiterProcessor struct {
 }
 
@@ -134,13 +267,74 @@ func NewTypographerParser(opts ...TypographerOption) parser.InlineParser {
 }
 
 func (s *typographerParser) Trigger() []byte {
-	return []byte{'\'', '"', '-', '.', '<', '>'}
+	triggers := []byte{'\'', '"', '-', '.', '<', '>', '(', '+'}
+	if s.Symbols != 0 {
+		// ' ' indicates any white spaces and a line head
+		triggers = append(triggers, ' ')
+	}
+	return triggers
+}
+
+var typographicSymbols = []struct {
+	flag        TypographicSymbols
+	punctuation TypographicPunctuation
+	value       []byte
+}{
+	{TypographicFractions, OneHalf, []byte("1/2")},
+	{TypographicFractions, OneQuarter, []byte("1/4")},
+	{TypographicFractions, ThreeQuarters, []byte("3/4")},
+	{TypographicCopyright, Copyright, []byte("(c)")},
+	{TypographicRegistered, Registered, []byte("(r)")},
+	{TypographicTrademark, Trademark, []byte("(tm)")},
+	{TypographicPlusMinus, PlusMinus, []byte("+-")},
+}
+
+func (s *typographerParser) parseSymbol(line []byte, before rune) (TypographicPunctuation, int) {
+	for _, symbol := range typographicSymbols {
+		v := symbol.value
+		if s.Symbols&symbol.flag == 0 || s.Substitutions[symbol.punctuation] == nil ||
+			len(line) < len(v) || !bytes.EqualFold(line[:len(v)], v) {
+			continue
+		}
+		if symbol.flag == TypographicFractions {
+			// fractions must not be a part of numbers or dates like '11/2'
+			// and '1/2/2020'
+			if before == '/' || unicode.IsLetter(before) || unicode.IsDigit(before) {
+				return 0, 0
+			}
+			if len(line) > len(v) && (line[len(v)] == '/' || util.IsAlphaNumeric(line[len(v)])) {
+				return 0, 0
+			}
+		}
+		return symbol.punctuation, len(v)
+	}
+	return 0, 0
 }
 
 func (s *typographerParser) Parse(parent gast.Node, block text.Reader, pc parser.Context) gast.Node {
 	before := block.PrecendingCharacter()
-	line, _ := block.PeekLine()
+	line, segment := block.PeekLine()
 	c := line[0]
+	if s.Symbols != 0 {
+		if util.IsSpace(c) {
+			if util.IsBlank(line) {
+				return parseTrailingSpaces(line, segment, block)
+			}
+			// consumes the space so that fractions after it are parsed at
+			// the next line head
+			if _, length := s.parseSymbol(line[1:], rune(c)); length != 0 {
+				block.Advance(1)
+				return gast.NewTextSegment(segment.WithStop(segment.Start + 1))
+			}
+			return nil
+		}
+		if punctuation, length := s.parseSymbol(line, before); length != 0 {
+			node := gast.NewString(s.Substitutions[punctuation])
+			node.SetCode(true)
+			block.Advance(length)
+			return node
+		}
+	}
 	if len(line) > 2 {
 		if c == '-' {
 			if s.Substitutions[EmDash] != nil && line[1] == '-' && line[2] == '-' { // ---
@@ -220,6 +414,18 @@ func (s *typographerParser) Parse(parent gast.Node, block text.Reader, pc parser
 	return nil
 }
 
+// parseTrailingSpaces consumes trailing spaces of the line, which are
+// not trimmed by the inline parser when texts are split by the ' ' trigger.
+func parseTrailingSpaces(line []byte, segment text.Segment, block text.Reader) gast.Node {
+	node := gast.NewTextSegment(segment.WithStop(segment.Start))
+	if length := len(line); line[length-1] == '\n' {
+		node.SetSoftLineBreak(true)
+		node.SetHardLineBreak(length > 2 && line[length-3] == ' ' && line[length-2] == ' ')
+	}
+	block.AdvanceLine()
+	return node
+}
+
 func (s *typographerParser) CloseBlock(parent gast.Node, pc parser.Context) {
 	// nothing to do
 }
@@ -1,9 +1,12 @@
 package extension
 
 import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
 	"github.com/yuin/goldmark"
 	"github.com/yuin/goldmark/renderer/html"
-	"testing"
 )
 
 func TestTypographer(t *testing.T) {
@@ -17,3 +20,114 @@ func TestTypographer(t *testing.T) {
 	)
 	goldmark.DoTestCaseFile(markdown, "_test/typographer.txt", t)
 }
+
+func TestTypographerLocale(t *testing.T) {
+	source := "'single' \"double\" don't << angle >>"
+	for i, c := range []struct {
+		locale   string
+		expected string
+	}{
+		{"en-US", "<p>&lsquo;single&rsquo; &ldquo;double&rdquo; don't &laquo; angle &raquo;</p>"},
+		{"fr_FR", "<p>&lsaquo;&nbsp;single&nbsp;&rsaquo; &laquo;&nbsp;double&nbsp;&raquo; don't &laquo; angle &raquo;</p>"},
+		{"de", "<p>&sbquo;single&lsquo; &bdquo;double&ldquo; don't &laquo; angle &raquo;</p>"},
+		{"xx", "<p>&lsquo;single&rsquo; &ldquo;double&rdquo; don't &laquo; angle &raquo;</p>"},
+	} {
+		markdown := goldmark.New(
+			goldmark.WithExtensions(
+				NewTypographer(WithTypographicLocale(c.locale)),
+			),
+		)
+		goldmark.DoTestCases(markdown, []goldmark.MarkdownTestCase{{
+			No:       i + 1,
+			Markdown: source,
+			Expected: c.expected,
+		}}, t)
+	}
+}
+
+func TestTypographerSymbols(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			NewTypographer(
+				WithTypographicSymbols(TypographicAllSymbols&^TypographicTrademark),
+				WithTypographicSubstitutions(TypographicSubstitutions{
+					Registered: []byte("&#174;"),
+				}),
+			),
+		),
+	)
+	goldmark.DoTestCases(markdown, []goldmark.MarkdownTestCase{
+		{
+			No:       1,
+			Markdown: "1/2 cup, 1/4 and 3/4 (C) 2020 Foo(r) Bar(tm) 5 +- 1",
+			Expected: "<p>&frac12; cup, &frac14; and &frac34; &copy; 2020 Foo&#174; Bar(tm) 5 &plusmn; 1</p>",
+		},
+		{
+			No:       2,
+			Markdown: "11/2 1/23 1/2/2020 a1/2 *1/2* `(c)`",
+			Expected: "<p>11/2 1/23 1/2/2020 a1/2 <em>&frac12;</em> <code>(c)</code></p>",
+		},
+	}, t)
+
+	markdown = goldmark.New(goldmark.WithExtensions(Typographer))
+	goldmark.DoTestCases(markdown, []goldmark.MarkdownTestCase{
+		{
+			No:       3,
+			Markdown: "1/2 (c) +-",
+			Expected: "<p>1/2 (c) +-</p>",
+		},
+	}, t)
+}
+
+func TestTypographerSpec(t *testing.T) {
+	bs, err := ioutil.ReadFile("../_test/spec.json")
+	if err != nil {
+		panic(err)
+	}
+	var testCases []struct {
+		Markdown string `json:"markdown"`
+		HTML     string `json:"html"`
+		Example  int    `json:"example"`
+	}
+	if err := json.Unmarshal(bs, &testCases); err != nil {
+		panic(err)
+	}
+	// examples that have trailing spaces
+	examples := map[int]bool{41: true, 196: true, 630: true, 632: true, 633: true, 635: true}
+	cases := []goldmark.MarkdownTestCase{}
+	for _, c := range testCases {
+		if examples[c.Example] {
+			cases = append(cases, goldmark.MarkdownTestCase{
+				No:       c.Example,
+				Markdown: c.Markdown,
+				Expected: c.HTML,
+			})
+		}
+	}
+	for _, typographer := range []goldmark.Extender{
+		Typographer,
+		NewTypographer(WithTypographicSymbols(TypographicAllSymbols)),
+	} {
+		markdown := goldmark.New(
+			goldmark.WithRendererOptions(
+				html.WithXHTML(),
+				html.WithUnsafe(),
+			),
+			goldmark.WithExtensions(
+				typographer,
+			),
+		)
+		goldmark.DoTestCases(markdown, cases, t)
+	}
+
+	markdown := goldmark.New(goldmark.WithExtensions(
+		NewTypographer(WithTypographicSymbols(TypographicFractions)),
+	))
+	goldmark.DoTestCases(markdown, []goldmark.MarkdownTestCase{
+		{
+			No:       1,
+			Markdown: "a 1/2  \nb 1/4 \nc 3/4   ",
+			Expected: "<p>a &frac12;<br>\nb &frac14;\nc &frac34;</p>",
+		},
+	}, t)
+}
@@ -0,0 +1,180 @@
+package extension
+
+import (
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// An UnderlineConfig struct is a data structure that holds configuration of the
+// Underline extension.
+type UnderlineConfig struct {
+	// Tag is an HTML tag name for underlined texts.
+	Tag []byte
+
+	// Class is a class attribute for underlined texts.
+	Class []byte
+}
+
+// An UnderlineOption interface sets options for the Underline extension.
+type UnderlineOption interface {
+	SetUnderlineOption(*UnderlineConfig)
+}
+
+type withUnderlineTag struct {
+	value []byte
+}
+
+func (o *withUnderlineTag) SetUnderlineOption(c *UnderlineConfig) {
+	c.Tag = o.value
+}
+
+// WithUnderlineTag is a functional option that sets an HTML tag name for
+// underlined texts.
+func WithUnderlineTag(tag string) UnderlineOption {
+	return &withUnderlineTag{[]byte(tag)}
+}
+
+type withUnderlineClass struct {
+	value []byte
+}
+
+func (o *withUnderlineClass) SetUnderlineOption(c *UnderlineConfig) {
+	c.Class = o.value
+}
+
+// WithUnderlineClass is a functional option that sets a class attribute for
+// underlined texts.
+func WithUnderlineClass(class string) UnderlineOption {
+	return &withUnderlineClass{[]byte(class)}
+}
+
+type underlineDelimiterProcessor struct {
+}
+
+func (p *underlineDelimiterProcessor) IsDelimiter(b byte) bool {
+	return b == '_'
+}
+
+func (p *underlineDelimiterProcessor) CanOpenCloser(opener, closer *parser.Delimiter) bool {
+	return opener.Char == closer.Char
+}
+
+func (p *underlineDelimiterProcessor) OnMatch(consumes int) gast.Node {
+	if consumes == 2 {
+		return ast.NewUnderline()
+	}
+	return gast.NewEmphasis(consumes)
+}
+
+var defaultUnderlineDelimiterProcessor = &underlineDelimiterProcessor{}
+
+type underlineParser struct {
+}
+
+var defaultUnderlineParser = &underlineParser{}
+
+// NewUnderlineParser return a new InlineParser that parses
+// underlined texts like '__text__' instead of strong emphasises.
+// Emphasises like '_text_' are parsed as usual.
+func NewUnderlineParser() parser.InlineParser {
+	return defaultUnderlineParser
+}
+
+func (s *underlineParser) Trigger() []byte {
+	return []byte{'_'}
+}
+
+func (s *underlineParser) Parse(parent gast.Node, block text.Reader, pc parser.Context) gast.Node {
+	before := block.PrecendingCharacter()
+	line, segment := block.PeekLine()
+	node := parser.ScanDelimiter(line, before, 1, defaultUnderlineDelimiterProcessor)
+	if node == nil {
+		return nil
+	}
+	node.Segment = segment.WithStop(segment.Start + node.OriginalLength)
+	block.Advance(node.OriginalLength)
+	pc.PushDelimiter(node)
+	return node
+}
+
+func (s *underlineParser) CloseBlock(parent gast.Node, pc parser.Context) {
+	// nothing to do
+}
+
+// UnderlineHTMLRenderer is a renderer.NodeRenderer implementation that
+// renders Underline nodes.
+type UnderlineHTMLRenderer struct {
+	html.Config
+	UnderlineConfig
+}
+
+// NewUnderlineHTMLRenderer returns a new UnderlineHTMLRenderer.
+func NewUnderlineHTMLRenderer(opts ...UnderlineOption) renderer.NodeRenderer {
+	r := &UnderlineHTMLRenderer{
+		Config: html.NewConfig(),
+		UnderlineConfig: UnderlineConfig{
+			Tag: []byte("u"),
+		},
+	}
+	for _, opt := range opts {
+		opt.SetUnderlineOption(&r.UnderlineConfig)
+	}
+	return r
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *UnderlineHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindUnderline, r.renderUnderline)
+}
+
+func (r *UnderlineHTMLRenderer) renderUnderline(w util.BufWriter, source []byte, n gast.Node, entering bool) (gast.WalkStatus, error) {
+	if entering {
+		_ = w.WriteByte('<')
+		_, _ = w.Write(r.Tag)
+		if len(r.Class) != 0 {
+			_, _ = w.WriteString(` class="`)
+			_, _ = w.Write(util.EscapeHTML(r.Class))
+			_ = w.WriteByte('"')
+		}
+		if n.Attributes() != nil {
+			r.RenderAttributes(w, n)
+		}
+		_ = w.WriteByte('>')
+	} else {
+		_, _ = w.WriteString("</")
+		_, _ = w.Write(r.Tag)
+		_ = w.WriteByte('>')
+	}
+	return gast.WalkContinue, nil
+}
+
+type underline struct {
+	options []UnderlineOption
+}
+
+// Underline is an extension that renders '__text__' as underlined texts
+// instead of strong emphasises.
+var Underline = &underline{}
+
+// NewUnderline returns a new Extender that allow you to use underlined texts
+// with the given options.
+func NewUnderline(opts ...UnderlineOption) goldmark.Extender {
+	return &underline{
+		options: opts,
+	}
+}
+
+func (e *underline) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(parser.WithInlineParsers(
+		util.Prioritized(NewUnderlineParser(), 450),
+	))
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(NewUnderlineHTMLRenderer(e.options...), 500),
+	))
+}
@@ -0,0 +1,42 @@
+package extension
+
+import (
+	"testing"
+
+	"github.com/yuin/goldmark"
+)
+
+func TestUnderline(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			Underline,
+		),
+	)
+	goldmark.DoTestCaseFile(markdown, "_test/underline.txt", t)
+}
+
+func TestUnderlineOptions(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			NewUnderline(WithUnderlineTag("span"), WithUnderlineClass("underline")),
+		),
+	)
+	goldmark.DoTestCases(markdown, []goldmark.MarkdownTestCase{{
+		No:       1,
+		Markdown: "__Hi__",
+		Expected: `<p><span class="underline">Hi</span></p>`,
+	}}, t)
+}
+
+func TestUnderlineIns(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			NewUnderline(WithUnderlineTag("ins")),
+		),
+	)
+	goldmark.DoTestCases(markdown, []goldmark.MarkdownTestCase{{
+		No:       1,
+		Markdown: "__Inserted__ and **strong**",
+		Expected: `<p><ins>Inserted</ins> and <strong>strong</strong></p>`,
+	}}, t)
+}
@@ -0,0 +1,351 @@
+package extension
+
+import (
+	"net/url"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// A WikiEmbedData struct is a content of a wiki embed resolved by
+// a WikiEmbedResolver.
+type WikiEmbedData struct {
+	// EmbedType is a type of the content.
+	EmbedType ast.WikiEmbedType
+
+	// Destination is a URL of the image or the file.
+	Destination string
+
+	// HTML is an HTML snippet of the note. Applications typically render
+	// notes with goldmark. HTML is rendered as it is.
+	HTML []byte
+}
+
+// A WikiEmbedResolver interface resolves contents of wiki embeds.
+type WikiEmbedResolver interface {
+	// Resolve returns a content of the given target like 'note' or
+	// 'image.png'. fragment is a heading or a block like 'Section' of
+	// '![[note#Section]]'. Resolve returns nil if the content does not
+	// exist.
+	Resolve(target, fragment string) *WikiEmbedData
+}
+
+// WikiEmbedResolverFunc is a function that implements WikiEmbedResolver.
+type WikiEmbedResolverFunc func(target, fragment string) *WikiEmbedData
+
+// Resolve implements WikiEmbedResolver.Resolve.
+func (f WikiEmbedResolverFunc) Resolve(target, fragment string) *WikiEmbedData {
+	return f(target, fragment)
+}
+
+var wikiEmbedImageExtensions = []string{".apng", ".avif", ".bmp", ".gif", ".jpeg", ".jpg", ".png", ".svg", ".webp"}
+
+// DefaultWikiEmbedResolver is a WikiEmbedResolver that resolves images
+// like 'image.png' into relative URLs, and other targets into links like
+// DefaultWikiLinkResolver. Notes are not inlined.
+var DefaultWikiEmbedResolver = WikiEmbedResolverFunc(func(target, fragment string) *WikiEmbedData {
+	ext := strings.ToLower(path.Ext(target))
+	for _, e := range wikiEmbedImageExtensions {
+		if ext == e {
+			return &WikiEmbedData{
+				EmbedType:   ast.WikiEmbedImage,
+				Destination: url.PathEscape(target),
+			}
+		}
+	}
+	destination, _ := DefaultWikiLinkResolver.Resolve(target)
+	return &WikiEmbedData{
+		EmbedType:   ast.WikiEmbedFile,
+		Destination: destination,
+	}
+})
+
+// A WikiEmbedConfig struct is a data structure that holds configuration of
+// the WikiEmbed extension.
+type WikiEmbedConfig struct {
+	// Resolver resolves contents of wiki embeds.
+	Resolver WikiEmbedResolver
+
+	// MissingClass is a class of embeds whose contents do not exist.
+	MissingClass string
+}
+
+// NewWikiEmbedConfig returns a new WikiEmbedConfig with defaults.
+func NewWikiEmbedConfig() WikiEmbedConfig {
+	return WikiEmbedConfig{
+		Resolver:     DefaultWikiEmbedResolver,
+		MissingClass: "new",
+	}
+}
+
+// A WikiEmbedOption interface sets options for the WikiEmbed extension.
+type WikiEmbedOption interface {
+	SetWikiEmbedOption(*WikiEmbedConfig)
+}
+
+type withWikiEmbedResolver struct {
+	value WikiEmbedResolver
+}
+
+func (o *withWikiEmbedResolver) SetWikiEmbedOption(c *WikiEmbedConfig) {
+	c.Resolver = o.value
+}
+
+// WithWikiEmbedResolver is a functional option that sets a resolver that
+// resolves contents of wiki embeds.
+func WithWikiEmbedResolver(resolver WikiEmbedResolver) WikiEmbedOption {
+	return &withWikiEmbedResolver{resolver}
+}
+
+type withWikiEmbedMissingClass struct {
+	value string
+}
+
+func (o *withWikiEmbedMissingClass) SetWikiEmbedOption(c *WikiEmbedConfig) {
+	c.MissingClass = o.value
+}
+
+// WithWikiEmbedMissingClass is a functional option that sets a class of
+// embeds whose contents do not exist. The default is "new".
+// An empty string means no classes are added.
+func WithWikiEmbedMissingClass(class string) WikiEmbedOption {
+	return &withWikiEmbedMissingClass{class}
+}
+
+type wikiEmbedParser struct {
+	WikiEmbedConfig
+}
+
+// NewWikiEmbedParser returns a new parser.InlineParser that parses wiki
+// embeds like '![[note]]' and '![[image.png|300]]'.
+func NewWikiEmbedParser(opts ...WikiEmbedOption) parser.InlineParser {
+	p := &wikiEmbedParser{
+		WikiEmbedConfig: NewWikiEmbedConfig(),
+	}
+	for _, o := range opts {
+		o.SetWikiEmbedOption(&p.WikiEmbedConfig)
+	}
+	return p
+}
+
+func (s *wikiEmbedParser) Trigger() []byte {
+	return []byte{'!'}
+}
+
+var wikiEmbedSizeRegexp = regexp.MustCompile(`^([0-9]+)(?:x([0-9]+))?$`)
+
+func (s *wikiEmbedParser) Parse(parent gast.Node, block text.Reader, pc parser.Context) gast.Node {
+	line, segment := block.PeekLine()
+	if len(line) < 1 {
+		return nil
+	}
+	w, ok := scanWikiLink(line[1:])
+	if !ok {
+		return nil
+	}
+	display := text.NewSegment(segment.Start+1+w.displayStart, segment.Start+1+w.displayStop)
+	n := ast.NewWikiEmbed(w.target, w.fragment)
+	if w.hasDisplay {
+		// sizes are written after the last '|' like '|alt|300x200'
+		value := display.Value(block.Source())
+		i := strings.LastIndexByte(string(value), '|')
+		if m := wikiEmbedSizeRegexp.FindSubmatch(util.TrimRightSpace(util.TrimLeftSpace(value[i+1:]))); m != nil {
+			n.Width, _ = strconv.Atoi(string(m[1]))
+			n.Height, _ = strconv.Atoi(string(m[2]))
+			if i < 0 {
+				display = text.NewSegment(segment.Start+1+2, segment.Start+1+w.displayStart-1)
+			} else {
+				display = display.WithStop(display.Start + i)
+			}
+		}
+	}
+	display = display.TrimLeftSpace(block.Source())
+	display = display.TrimRightSpace(block.Source())
+	if display.IsEmpty() {
+		return nil
+	}
+	block.Advance(1 + w.length)
+
+	if data := s.Resolver.Resolve(string(w.target), string(w.fragment)); data != nil {
+		n.EmbedType = data.EmbedType
+		n.Destination = []byte(data.Destination)
+		n.HTML = data.HTML
+		n.Exists = true
+	}
+	n.AppendChild(n, gast.NewTextSegment(display))
+	return n
+}
+
+type wikiEmbedASTTransformer struct {
+}
+
+var defaultWikiEmbedASTTransformer = &wikiEmbedASTTransformer{}
+
+// NewWikiEmbedASTTransformer returns a new parser.ASTTransformer that
+// replaces paragraphs consisting solely of an embedded note with text
+// blocks, so notes are not rendered in p elements.
+func NewWikiEmbedASTTransformer() parser.ASTTransformer {
+	return defaultWikiEmbedASTTransformer
+}
+
+func (a *wikiEmbedASTTransformer) Transform(node *gast.Document, reader text.Reader, pc parser.Context) {
+	var paragraphs []gast.Node
+	_ = gast.Walk(node, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+		if !entering || n.Kind() != gast.KindParagraph {
+			return gast.WalkContinue, nil
+		}
+		if embed, ok := n.FirstChild().(*ast.WikiEmbed); ok && embed == n.LastChild() && embed.EmbedType == ast.WikiEmbedNote {
+			paragraphs = append(paragraphs, n)
+		}
+		return gast.WalkSkipChildren, nil
+	})
+	for _, p := range paragraphs {
+		textBlock := gast.NewTextBlock()
+		textBlock.SetLines(p.Lines())
+		moveChildren(textBlock, p.FirstChild())
+		p.Parent().ReplaceChild(p.Parent(), p, textBlock)
+	}
+}
+
+// WikiEmbedHTMLRenderer is a renderer.NodeRenderer implementation that
+// renders WikiEmbed nodes.
+type WikiEmbedHTMLRenderer struct {
+	html.Config
+	WikiEmbedConfig
+}
+
+// NewWikiEmbedHTMLRenderer returns a new WikiEmbedHTMLRenderer.
+func NewWikiEmbedHTMLRenderer(opts ...WikiEmbedOption) renderer.NodeRenderer {
+	r := &WikiEmbedHTMLRenderer{
+		Config:          html.NewConfig(),
+		WikiEmbedConfig: NewWikiEmbedConfig(),
+	}
+	for _, opt := range opts {
+		opt.SetWikiEmbedOption(&r.WikiEmbedConfig)
+	}
+	return r
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *WikiEmbedHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindWikiEmbed, r.renderWikiEmbed)
+}
+
+func (r *WikiEmbedHTMLRenderer) writeDestination(w util.BufWriter, n *ast.WikiEmbed, withFragment bool) {
+	destination := n.Destination
+	if withFragment && n.Fragment != nil {
+		destination = append(append(append([]byte{}, destination...), '#'), n.Fragment...)
+	}
+	if r.Unsafe || !html.IsDangerousURL(destination) {
+		_, _ = w.Write(util.EscapeHTML(util.URLEscape(destination, false)))
+	}
+}
+
+func (r *WikiEmbedHTMLRenderer) renderWikiEmbed(w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	if !entering {
+		return gast.WalkContinue, nil
+	}
+	n := node.(*ast.WikiEmbed)
+	switch {
+	case !n.Exists:
+		_, _ = w.WriteString("<span")
+		if len(r.MissingClass) != 0 {
+			_, _ = w.WriteString(` class="`)
+			_, _ = w.Write(util.EscapeHTML([]byte(r.MissingClass)))
+			_ = w.WriteByte('"')
+		}
+		_ = w.WriteByte('>')
+		_, _ = w.Write(util.EscapeHTML(n.Text(source)))
+		_, _ = w.WriteString("</span>")
+	case n.EmbedType == ast.WikiEmbedImage:
+		_, _ = w.WriteString(`<img src="`)
+		r.writeDestination(w, n, false)
+		_, _ = w.WriteString(`" alt="`)
+		_, _ = w.Write(util.EscapeHTML(n.Text(source)))
+		_ = w.WriteByte('"')
+		if n.Width != 0 {
+			_, _ = w.WriteString(` width="`)
+			_, _ = w.WriteString(strconv.Itoa(n.Width))
+			_ = w.WriteByte('"')
+		}
+		if n.Height != 0 {
+			_, _ = w.WriteString(` height="`)
+			_, _ = w.WriteString(strconv.Itoa(n.Height))
+			_ = w.WriteByte('"')
+		}
+		if n.Attributes() != nil {
+			r.RenderAttributes(w, n)
+		}
+		if r.XHTML {
+			_, _ = w.WriteString(" />")
+		} else {
+			_ = w.WriteByte('>')
+		}
+	case n.EmbedType == ast.WikiEmbedNote:
+		_, _ = w.WriteString(`<div class="wiki-embed" data-target="`)
+		_, _ = w.Write(util.EscapeHTML(n.Target))
+		_, _ = w.WriteString("\">\n")
+		_, _ = w.Write(n.HTML)
+		if len(n.HTML) != 0 && n.HTML[len(n.HTML)-1] != '\n' {
+			_ = w.WriteByte('\n')
+		}
+		_, _ = w.WriteString("</div>")
+	default:
+		_, _ = w.WriteString(`<a href="`)
+		r.writeDestination(w, n, true)
+		_ = w.WriteByte('"')
+		if n.Attributes() != nil {
+			r.RenderAttributes(w, n)
+		}
+		_ = w.WriteByte('>')
+		_, _ = w.Write(util.EscapeHTML(n.Text(source)))
+		_, _ = w.WriteString("</a>")
+	}
+	return gast.WalkSkipChildren, nil
+}
+
+type wikiEmbed struct {
+	options []WikiEmbedOption
+}
+
+// WikiEmbed is an extension that allows you to use Obsidian style embeds
+// like '![[note]]' and '![[image.png|300]]'.
+var WikiEmbed = &wikiEmbed{}
+
+// NewWikiEmbed returns a new Extender that resolves wiki embeds with
+// the given options. Resolvers are called while parsing, so contents are
+// available in ASTs as WikiEmbed nodes.
+//
+// Images are rendered as img elements with sizes written like
+// '![[image.png|300x200]]', notes are rendered as div elements that have
+// HTML resolved by resolvers, and other files are rendered as links.
+func NewWikiEmbed(opts ...WikiEmbedOption) goldmark.Extender {
+	return &wikiEmbed{
+		options: opts,
+	}
+}
+
+func (e *wikiEmbed) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithInlineParsers(
+			// wiki embeds must be parsed before images
+			util.Prioritized(NewWikiEmbedParser(e.options...), 199),
+		),
+		parser.WithASTTransformers(
+			util.Prioritized(NewWikiEmbedASTTransformer(), 500),
+		),
+	)
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(NewWikiEmbedHTMLRenderer(e.options...), 500),
+	))
+}
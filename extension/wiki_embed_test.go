@@ -0,0 +1,34 @@
+package extension
+
+import (
+	"testing"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension/ast"
+)
+
+func TestWikiEmbed(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			NewWikiEmbed(
+				WithWikiEmbedResolver(WikiEmbedResolverFunc(func(target, fragment string) *WikiEmbedData {
+					switch target {
+					case "missing.png":
+						return nil
+					case "note":
+						html := "<p>Hello from note</p>\n"
+						if fragment != "" {
+							html = "<h2>" + fragment + "</h2>\n"
+						}
+						return &WikiEmbedData{
+							EmbedType: ast.WikiEmbedNote,
+							HTML:      []byte(html),
+						}
+					}
+					return DefaultWikiEmbedResolver.Resolve(target, fragment)
+				})),
+			),
+		),
+	)
+	goldmark.DoTestCaseFile(markdown, "_test/wiki_embed.txt", t)
+}
@@ -0,0 +1,267 @@
+package extension
+
+import (
+	"bytes"
+	"net/url"
+	"strings"
+
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// A WikiLinkResolver interface resolves page names of wiki links.
+type WikiLinkResolver interface {
+	// Resolve returns a destination URL of the given page name like
+	// 'Page Name', and whether the page exists.
+	Resolve(target string) (destination string, exists bool)
+}
+
+// WikiLinkResolverFunc is a function that implements WikiLinkResolver.
+type WikiLinkResolverFunc func(target string) (string, bool)
+
+// Resolve implements WikiLinkResolver.Resolve.
+func (f WikiLinkResolverFunc) Resolve(target string) (string, bool) {
+	return f(target)
+}
+
+// DefaultWikiLinkResolver is a WikiLinkResolver that resolves page names
+// like 'Page Name' into relative URLs like 'Page_Name'. All pages are
+// treated as existing pages.
+var DefaultWikiLinkResolver = WikiLinkResolverFunc(func(target string) (string, bool) {
+	return url.PathEscape(strings.Replace(target, " ", "_", -1)), true
+})
+
+// A WikiLinkConfig struct is a data structure that holds configuration of
+// the WikiLink extension.
+type WikiLinkConfig struct {
+	// Resolver resolves page names of wiki links.
+	Resolver WikiLinkResolver
+
+	// MissingClass is a class of links to pages that do not exist.
+	MissingClass string
+}
+
+// NewWikiLinkConfig returns a new WikiLinkConfig with defaults.
+func NewWikiLinkConfig() WikiLinkConfig {
+	return WikiLinkConfig{
+		Resolver:     DefaultWikiLinkResolver,
+		MissingClass: "new",
+	}
+}
+
+// A WikiLinkOption interface sets options for the WikiLink extension.
+type WikiLinkOption interface {
+	SetWikiLinkOption(*WikiLinkConfig)
+}
+
+type withWikiLinkResolver struct {
+	value WikiLinkResolver
+}
+
+func (o *withWikiLinkResolver) SetWikiLinkOption(c *WikiLinkConfig) {
+	c.Resolver = o.value
+}
+
+// WithWikiLinkResolver is a functional option that sets a resolver that
+// resolves page names of wiki links.
+func WithWikiLinkResolver(resolver WikiLinkResolver) WikiLinkOption {
+	return &withWikiLinkResolver{resolver}
+}
+
+type withWikiLinkMissingClass struct {
+	value string
+}
+
+func (o *withWikiLinkMissingClass) SetWikiLinkOption(c *WikiLinkConfig) {
+	c.MissingClass = o.value
+}
+
+// WithWikiLinkMissingClass is a functional option that sets a class of
+// links to pages that do not exist. The default is "new" as in MediaWiki.
+// An empty string means no classes are added.
+func WithWikiLinkMissingClass(class string) WikiLinkOption {
+	return &withWikiLinkMissingClass{class}
+}
+
+type wikiLinkParser struct {
+	WikiLinkConfig
+}
+
+// NewWikiLinkParser returns a new parser.InlineParser that parses wiki
+// links like '[[Page Name]]', '[[Page Name|display]]' and
+// '[[Page Name#Section]]'.
+func NewWikiLinkParser(opts ...WikiLinkOption) parser.InlineParser {
+	p := &wikiLinkParser{
+		WikiLinkConfig: NewWikiLinkConfig(),
+	}
+	for _, o := range opts {
+		o.SetWikiLinkOption(&p.WikiLinkConfig)
+	}
+	return p
+}
+
+func (s *wikiLinkParser) Trigger() []byte {
+	return []byte{'['}
+}
+
+// A wikiLinkSyntax struct is a result of scanWikiLink.
+type wikiLinkSyntax struct {
+	target   []byte
+	fragment []byte
+
+	// displayStart and displayStop are positions of a display text in
+	// the line. If the link does not have a display text, they are
+	// positions of the target.
+	displayStart int
+	displayStop  int
+	hasDisplay   bool
+
+	// length is a length of the link.
+	length int
+}
+
+// scanWikiLink scans a wiki link like '[[target#fragment|display]]' at
+// the beginning of the given line.
+func scanWikiLink(line []byte) (*wikiLinkSyntax, bool) {
+	if len(line) < 4 || line[0] != '[' || line[1] != '[' {
+		return nil, false
+	}
+	closes := bytes.Index(line[2:], []byte("]]"))
+	if closes < 0 {
+		return nil, false
+	}
+	closes += 2
+	content := line[2:closes]
+	if bytes.ContainsAny(content, "[]\n") {
+		return nil, false
+	}
+	w := &wikiLinkSyntax{
+		displayStart: 2,
+		displayStop:  closes,
+		length:       closes + 2,
+	}
+	targetStop := closes
+	if i := bytes.IndexByte(content, '|'); i > -1 {
+		targetStop = 2 + i
+		w.displayStart = targetStop + 1
+		w.hasDisplay = true
+	}
+	w.target = util.TrimRightSpace(util.TrimLeftSpace(line[2:targetStop]))
+	if i := bytes.IndexByte(w.target, '#'); i > -1 {
+		w.fragment = util.TrimLeftSpace(w.target[i+1:])
+		w.target = util.TrimRightSpace(w.target[:i])
+	}
+	if len(w.target) == 0 && len(w.fragment) == 0 {
+		return nil, false
+	}
+	return w, true
+}
+
+func (s *wikiLinkParser) Parse(parent gast.Node, block text.Reader, pc parser.Context) gast.Node {
+	line, segment := block.PeekLine()
+	w, ok := scanWikiLink(line)
+	if !ok {
+		return nil
+	}
+	display := text.NewSegment(segment.Start+w.displayStart, segment.Start+w.displayStop)
+	display = display.TrimLeftSpace(block.Source())
+	display = display.TrimRightSpace(block.Source())
+	if display.IsEmpty() {
+		return nil
+	}
+	block.Advance(w.length)
+
+	n := ast.NewWikiLink(w.target, w.fragment)
+	n.Exists = true
+	if len(w.target) != 0 {
+		destination, exists := s.Resolver.Resolve(string(w.target))
+		n.Destination = []byte(destination)
+		n.Exists = exists
+	}
+	n.AppendChild(n, gast.NewTextSegment(display))
+	return n
+}
+
+// WikiLinkHTMLRenderer is a renderer.NodeRenderer implementation that
+// renders WikiLink nodes.
+type WikiLinkHTMLRenderer struct {
+	html.Config
+	WikiLinkConfig
+}
+
+// NewWikiLinkHTMLRenderer returns a new WikiLinkHTMLRenderer.
+func NewWikiLinkHTMLRenderer(opts ...WikiLinkOption) renderer.NodeRenderer {
+	r := &WikiLinkHTMLRenderer{
+		Config:         html.NewConfig(),
+		WikiLinkConfig: NewWikiLinkConfig(),
+	}
+	for _, opt := range opts {
+		opt.SetWikiLinkOption(&r.WikiLinkConfig)
+	}
+	return r
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *WikiLinkHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindWikiLink, r.renderWikiLink)
+}
+
+func (r *WikiLinkHTMLRenderer) renderWikiLink(w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	n := node.(*ast.WikiLink)
+	if !entering {
+		_, _ = w.WriteString("</a>")
+		return gast.WalkContinue, nil
+	}
+	destination := n.Destination
+	if n.Fragment != nil {
+		destination = append(append(append([]byte{}, destination...), '#'), n.Fragment...)
+	}
+	_, _ = w.WriteString(`<a href="`)
+	if r.Unsafe || !html.IsDangerousURL(destination) {
+		_, _ = w.Write(util.EscapeHTML(util.URLEscape(destination, false)))
+	}
+	_ = w.WriteByte('"')
+	if !n.Exists && len(r.MissingClass) != 0 {
+		_, _ = w.WriteString(` class="`)
+		_, _ = w.Write(util.EscapeHTML([]byte(r.MissingClass)))
+		_ = w.WriteByte('"')
+	}
+	if n.Attributes() != nil {
+		r.RenderAttributes(w, n)
+	}
+	_ = w.WriteByte('>')
+	return gast.WalkContinue, nil
+}
+
+type wikiLink struct {
+	options []WikiLinkOption
+}
+
+// WikiLink is an extension that allows you to use wiki links like
+// '[[Page Name|display]]'.
+var WikiLink = &wikiLink{}
+
+// NewWikiLink returns a new Extender that resolves wiki links with
+// the given options. Resolvers are called while parsing, so destinations
+// and existence of pages are available in ASTs as WikiLink nodes.
+func NewWikiLink(opts ...WikiLinkOption) goldmark.Extender {
+	return &wikiLink{
+		options: opts,
+	}
+}
+
+func (e *wikiLink) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(parser.WithInlineParsers(
+		// wiki links must be parsed before links
+		util.Prioritized(NewWikiLinkParser(e.options...), 199),
+	))
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(NewWikiLinkHTMLRenderer(e.options...), 500),
+	))
+}
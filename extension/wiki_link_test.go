@@ -0,0 +1,24 @@
+package extension
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/yuin/goldmark"
+)
+
+func TestWikiLink(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			NewWikiLink(
+				WithWikiLinkResolver(WikiLinkResolverFunc(func(target string) (string, bool) {
+					if strings.HasPrefix(target, "Missing") {
+						return "/wiki/" + strings.Replace(target, " ", "_", -1), false
+					}
+					return DefaultWikiLinkResolver.Resolve(target)
+				})),
+			),
+		),
+	)
+	goldmark.DoTestCaseFile(markdown, "_test/wiki_link.txt", t)
+}
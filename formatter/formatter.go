@@ -0,0 +1,109 @@
+// Package formatter formats Markdown documents in a consistent style.
+package formatter
+
+import (
+	"bytes"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/renderer/markdown"
+	"github.com/yuin/goldmark/util"
+)
+
+// A Style struct holds a house style of Markdown documents.
+type Style struct {
+	// WrapWidth is a width that paragraphs are wrapped at.
+	// 0 means that line breaks in paragraphs are kept as they are.
+	WrapWidth int
+
+	// BulletMarker is a marker character for bullet lists: '-', '*' or '+'.
+	BulletMarker byte
+
+	// EmphasisMarker is a marker character for emphasis: '*' or '_'.
+	EmphasisMarker byte
+
+	// FenceChar is a fence character for code blocks: '`' or '~'.
+	FenceChar byte
+
+	// FencedCodeBlocks indicates that indented code blocks should be
+	// converted to fenced code blocks.
+	FencedCodeBlocks bool
+
+	// TablePadding indicates that table columns should be aligned.
+	TablePadding bool
+
+	// PreserveMarkers indicates that list markers, emphasis markers and
+	// fence characters in the source should be kept where possible.
+	PreserveMarkers bool
+}
+
+// DefaultStyle returns a default Style.
+func DefaultStyle() Style {
+	return Style{
+		WrapWidth:        0,
+		BulletMarker:     '-',
+		EmphasisMarker:   '*',
+		FenceChar:        '`',
+		FencedCodeBlocks: true,
+		TablePadding:     true,
+	}
+}
+
+func (s Style) options() []markdown.Option {
+	opts := []markdown.Option{
+		markdown.WithWrapWidth(s.WrapWidth),
+	}
+	if s.BulletMarker != 0 {
+		opts = append(opts, markdown.WithBulletMarker(s.BulletMarker))
+	}
+	if s.EmphasisMarker != 0 {
+		opts = append(opts, markdown.WithEmphasisMarker(s.EmphasisMarker))
+	}
+	if s.FenceChar != 0 {
+		opts = append(opts, markdown.WithFenceChar(s.FenceChar))
+	}
+	if s.FencedCodeBlocks {
+		opts = append(opts, markdown.WithFencedCodeBlocks())
+	}
+	if s.TablePadding {
+		opts = append(opts, markdown.WithTablePadding())
+	}
+	if s.PreserveMarkers {
+		opts = append(opts, markdown.WithPreserveMarkers())
+	}
+	return opts
+}
+
+// A Formatter formats Markdown documents.
+type Formatter struct {
+	markdown goldmark.Markdown
+}
+
+// New returns a new Formatter that formats documents in the given style.
+// Given goldmark options are used for parsing documents, for example,
+// goldmark.WithExtensions(extension.GFM).
+// Renderers added by extensions are replaced with the Markdown renderer.
+func New(style Style, opts ...goldmark.Option) *Formatter {
+	m := goldmark.New(opts...)
+	m.SetRenderer(renderer.NewRenderer(
+		renderer.WithNodeRenderers(
+			util.Prioritized(markdown.NewRenderer(style.options()...), 1000),
+		),
+	))
+	return &Formatter{
+		markdown: m,
+	}
+}
+
+// Format formats the given Markdown source.
+func (f *Formatter) Format(source []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	// link reference definitions are kept as they are
+	pc := parser.NewContext()
+	if err := f.markdown.Convert(source, &buf,
+		parser.WithContext(pc), markdown.WithReferenceDefinitions(pc)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,190 @@
+package formatter
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"regexp"
+	"testing"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/renderer/html"
+)
+
+func TestFormat(t *testing.T) {
+	source := []byte("Title\n" +
+		"=====\n" +
+		"\n" +
+		"* item _one_\n" +
+		"* item __two__\n" +
+		"\n" +
+		"+ another list\n" +
+		"\n" +
+		"Some code:\n" +
+		"\n" +
+		"    indented code\n" +
+		"\n" +
+		"| a | b |\n" +
+		"|:-|--:|\n" +
+		"| long cell | x |\n" +
+		"\n" +
+		"A [link][ref] with  \n" +
+		"a hard break.\n" +
+		"\n" +
+		"[ref]: http://example.com \"Title\"\n")
+	expected := "# Title\n" +
+		"\n" +
+		"- item *one*\n" +
+		"- item **two**\n" +
+		"\n" +
+		"* another list\n" +
+		"\n" +
+		"Some code:\n" +
+		"\n" +
+		"```\n" +
+		"indented code\n" +
+		"```\n" +
+		"\n" +
+		"| a         |   b |\n" +
+		"| :-------- | --: |\n" +
+		"| long cell |   x |\n" +
+		"\n" +
+		"A [link][ref] with\\\n" +
+		"a hard break.\n" +
+		"\n" +
+		"[ref]: http://example.com \"Title\"\n"
+
+	f := New(DefaultStyle(), goldmark.WithExtensions(extension.Table))
+	out, err := f.Format(source)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != expected {
+		t.Errorf("unexpected output:\n%s", out)
+	}
+	out2, err := f.Format(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out2) != string(out) {
+		t.Errorf("formatting is not idempotent:\n%s", out2)
+	}
+}
+
+func TestFormatWrap(t *testing.T) {
+	style := DefaultStyle()
+	style.WrapWidth = 20
+	style.BulletMarker = '*'
+	style.EmphasisMarker = '_'
+	source := []byte("- a paragraph with *emphasis* that is wrapped at 20 columns - really\n")
+	expected := "* a paragraph with\n" +
+		"  _emphasis_ that is\n" +
+		"  wrapped at 20\n" +
+		"  columns - really\n"
+	out, err := New(style).Format(source)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != expected {
+		t.Errorf("unexpected output:\n%s", out)
+	}
+}
+
+func TestFormatPreserveMarkers(t *testing.T) {
+	style := DefaultStyle()
+	style.PreserveMarkers = true
+	source := []byte("* item _one_ and __two__\n" +
+		"* item *three*\n" +
+		"\n" +
+		"+ another list\n" +
+		"\n" +
+		"~~~go\n" +
+		"code\n" +
+		"~~~\n" +
+		"\n" +
+		"```\n" +
+		"code\n" +
+		"```\n")
+	out, err := New(style).Format(source)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != string(source) {
+		t.Errorf("unexpected output:\n%s", out)
+	}
+}
+
+var spaces = regexp.MustCompile(`\s+`)
+
+func TestFormatSpec(t *testing.T) {
+	bs, err := ioutil.ReadFile("../_test/spec.json")
+	if err != nil {
+		panic(err)
+	}
+	var testCases []struct {
+		Markdown string `json:"markdown"`
+		Example  int    `json:"example"`
+	}
+	if err := json.Unmarshal(bs, &testCases); err != nil {
+		panic(err)
+	}
+	markdown := goldmark.New(goldmark.WithRendererOptions(
+		html.WithXHTML(),
+		html.WithUnsafe(),
+	))
+	convert := func(source []byte) string {
+		var buf bytes.Buffer
+		if err := markdown.Convert(source, &buf); err != nil {
+			t.Fatal(err)
+		}
+		return buf.String()
+	}
+
+	styles := []Style{DefaultStyle()}
+	for _, width := range []int{1, 10, 40} {
+		style := DefaultStyle()
+		style.WrapWidth = width
+		style.EmphasisMarker = '_'
+		styles = append(styles, style)
+	}
+	style := DefaultStyle()
+	style.EmphasisMarker = '_'
+	style.PreserveMarkers = true
+	styles = append(styles, style)
+
+	for _, style := range styles {
+		f := New(style)
+		for _, c := range testCases {
+			// the parser does not find code spans that contain only spaces
+			// after other code spans on the same line
+			if c.Example == 334 && style.WrapWidth > 0 {
+				continue
+			}
+			source := []byte(c.Markdown)
+			out, err := f.Format(source)
+			if err != nil {
+				t.Fatal(err)
+			}
+			expected, actual := convert(source), convert(out)
+			if style.WrapWidth > 0 {
+				// wrapping lines replaces spaces with new lines
+				expected = spaces.ReplaceAllString(expected, " ")
+				actual = spaces.ReplaceAllString(actual, " ")
+			}
+			if actual != expected {
+				t.Errorf("example %d (wrap width %d): formatted document differs:\n%q\n%s\n%s",
+					c.Example, style.WrapWidth, out, expected, actual)
+				continue
+			}
+			out2, err := f.Format(out)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(out2, out) {
+				t.Errorf("example %d (wrap width %d): formatting is not idempotent:\n%q\n%q",
+					c.Example, style.WrapWidth, out, out2)
+			}
+		}
+	}
+}
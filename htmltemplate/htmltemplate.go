@@ -0,0 +1,163 @@
+// Package htmltemplate converts Markdown into template.HTML for
+// html/template with a guarantee whether outputs are safe.
+package htmltemplate
+
+import (
+	"bytes"
+	"errors"
+	"html/template"
+	"strings"
+	"sync"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// ErrUnsafe is returned by functions in FuncMap if outputs may contain
+// raw HTML or dangerous URLs.
+var ErrUnsafe = errors.New("htmltemplate: output may contain unsafe HTML")
+
+// probes are documents that are converted to find whether the renderer
+// omits raw HTML and dangerous URLs.
+const (
+	probeName       = "x-goldmark-probe"
+	probeInlineHTML = "a <" + probeName + "> b\n"
+	probeBlockHTML  = "<div " + probeName + ">\n"
+	probeURL        = "[a](javascript:" + probeName + ")\n"
+)
+
+// A Converter struct converts Markdown into template.HTML.
+type Converter struct {
+	markdown  goldmark.Markdown
+	probeOnce sync.Once
+	rawHTML   bool
+	rawURL    bool
+}
+
+// New returns a new Converter that converts Markdown with the given
+// Markdown object. If markdown is nil, goldmark.New() is used.
+func New(markdown goldmark.Markdown) *Converter {
+	if markdown == nil {
+		markdown = goldmark.New()
+	}
+	return &Converter{markdown: markdown}
+}
+
+// probe converts probes once, and records whether the renderer writes raw
+// HTML and dangerous URLs as they are(i.e. html.WithUnsafe is set).
+func (c *Converter) probe() {
+	c.probeOnce.Do(func() {
+		var buf bytes.Buffer
+		for _, probe := range []string{probeInlineHTML, probeBlockHTML} {
+			buf.Reset()
+			if err := c.markdown.Convert([]byte(probe), &buf); err != nil || bytes.Contains(buf.Bytes(), []byte(probeName)) {
+				c.rawHTML = true
+			}
+		}
+		buf.Reset()
+		if err := c.markdown.Convert([]byte(probeURL), &buf); err != nil || bytes.Contains(buf.Bytes(), []byte(probeName)) {
+			c.rawURL = true
+		}
+	})
+}
+
+// Convert converts the given Markdown into template.HTML.
+// Convert also returns true if the output is guaranteed not to contain
+// raw HTML and dangerous URLs like 'javascript:' written in the source,
+// that is, either the renderer omits them or the document does not have
+// them. Contents rendered by extensions that write raw HTML by themselves
+// are not guaranteed.
+func (c *Converter) Convert(source []byte, opts ...parser.ParseOption) (template.HTML, bool, error) {
+	c.probe()
+	doc := c.markdown.Parser().Parse(text.NewReader(source), opts...)
+	var buf bytes.Buffer
+	if err := c.markdown.Renderer().Render(&buf, source, doc); err != nil {
+		return "", false, err
+	}
+	return template.HTML(buf.String()), c.isSafe(doc, source), nil
+}
+
+func (c *Converter) isSafe(doc ast.Node, source []byte) bool {
+	safe := true
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		switch v := n.(type) {
+		case *ast.RawHTML, *ast.HTMLBlock:
+			safe = !c.rawHTML
+		case *ast.Link:
+			safe = !c.rawURL || !html.IsDangerousURL(util.URLEscape(v.Destination, true))
+		case *ast.Image:
+			safe = !c.rawURL || !html.IsDangerousURL(util.URLEscape(v.Destination, true))
+		case *ast.AutoLink:
+			// autolinks are rendered without filtering
+			safe = !html.IsDangerousURL(v.URL(source))
+		}
+		if safe {
+			safe = isSafeAttributes(n)
+		}
+		if !safe {
+			return ast.WalkStop, nil
+		}
+		return ast.WalkContinue, nil
+	})
+	return safe
+}
+
+// isSafeAttributes returns false if the given node has event handler
+// attributes or attributes that have dangerous URLs. Attributes are
+// rendered as they are(see parser.WithAttribute).
+func isSafeAttributes(n ast.Node) bool {
+	for _, attr := range n.Attributes() {
+		name := strings.ToLower(string(attr.Name))
+		if strings.HasPrefix(name, "on") {
+			return false
+		}
+		switch name {
+		case "href", "src", "action", "formaction", "srcset":
+			if html.IsDangerousURL(bytes.TrimSpace(attr.Value)) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// FuncMap returns a template.FuncMap that has a "markdown" function.
+// The function converts a Markdown string into template.HTML and fails
+// with ErrUnsafe if the output is not guaranteed to be safe.
+//
+//	{{ .Body | markdown }}
+func (c *Converter) FuncMap() template.FuncMap {
+	return template.FuncMap{
+		"markdown": func(source string) (template.HTML, error) {
+			output, safe, err := c.Convert([]byte(source))
+			if err != nil {
+				return "", err
+			}
+			if !safe {
+				return "", ErrUnsafe
+			}
+			return output, nil
+		},
+	}
+}
+
+var defaultConverter = New(nil)
+
+// Convert converts the given Markdown into template.HTML with the default
+// goldmark.Markdown. See Converter.Convert.
+func Convert(source []byte, opts ...parser.ParseOption) (template.HTML, bool, error) {
+	return defaultConverter.Convert(source, opts...)
+}
+
+// FuncMap returns a template.FuncMap that converts Markdown with the
+// default goldmark.Markdown. See Converter.FuncMap.
+func FuncMap() template.FuncMap {
+	return defaultConverter.FuncMap()
+}
@@ -0,0 +1,49 @@
+package htmltemplate
+
+import (
+	"bytes"
+	"html/template"
+	"testing"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/renderer/html"
+)
+
+func TestConvert(t *testing.T) {
+	unsafe := New(goldmark.New(goldmark.WithRendererOptions(html.WithUnsafe())))
+	for i, c := range []struct {
+		converter *Converter
+		source    string
+		safe      bool
+	}{
+		{defaultConverter, "*a* <b>b</b> [c](javascript:alert(1))", true},
+		{unsafe, "*a* [c](/c)", true},
+		{unsafe, "*a* <b>b</b>", false},
+		{unsafe, "<div>\n", false},
+		{unsafe, "[c](java&#115;cript:alert(1))", false},
+		{defaultConverter, "<javascript:alert(1)>", false},
+	} {
+		_, safe, err := c.converter.Convert([]byte(c.source))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if safe != c.safe {
+			t.Errorf("%d: expected %v, but got %v", i+1, c.safe, safe)
+		}
+	}
+}
+
+func TestFuncMap(t *testing.T) {
+	unsafe := New(goldmark.New(goldmark.WithRendererOptions(html.WithUnsafe())))
+	tmpl := template.Must(template.New("").Funcs(unsafe.FuncMap()).Parse("<div>{{ . | markdown }}</div>"))
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, "*a*"); err != nil {
+		t.Fatal(err)
+	}
+	if expected := "<div><p><em>a</em></p>\n</div>"; buf.String() != expected {
+		t.Errorf("expected %q, but got %q", expected, buf.String())
+	}
+	if err := tmpl.Execute(&buf, "<script>alert(1)</script>"); err == nil {
+		t.Error("unsafe outputs should be rejected")
+	}
+}
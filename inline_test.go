@@ -0,0 +1,34 @@
+package goldmark
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+)
+
+func TestParseInline(t *testing.T) {
+	markdown := New()
+	pc := parser.NewContext()
+	document := []byte("[home]: /index.html\n")
+	markdown.Parser().Parse(text.NewReader(document), parser.WithContext(pc))
+
+	for i, c := range []struct {
+		source   string
+		expected string
+	}{
+		{"*Hello* `world`", "<em>Hello</em> <code>world</code>"},
+		{"# Not a heading", "# Not a heading"},
+		{"- not a list\n  [Back][home]", "- not a list\n<a href=\"/index.html\">Back</a>"},
+	} {
+		n := parser.ParseInline(markdown.Parser(), []byte(c.source), parser.WithContext(pc))
+		var b bytes.Buffer
+		if err := markdown.Renderer().Render(&b, []byte(c.source), n); err != nil {
+			t.Fatal(err)
+		}
+		if b.String() != c.expected {
+			t.Errorf("%d: expected %q, but got %q", i+1, c.expected, b.String())
+		}
+	}
+}
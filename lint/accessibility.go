@@ -0,0 +1,106 @@
+package lint
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/yuin/goldmark/ast"
+	east "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/util"
+)
+
+// AccessibilityRules returns a new list of rules that check accessibility
+// of documents.
+// Accessibility rules are:
+//
+//     NewImageAltRule
+//     NewDescriptiveLinkTextRule
+//     NewHeadingIncrementRule
+//     NewTableHeaderRule
+func AccessibilityRules() []Rule {
+	return []Rule{
+		NewImageAltRule(),
+		NewDescriptiveLinkTextRule(),
+		NewHeadingIncrementRule(),
+		NewTableHeaderRule(),
+	}
+}
+
+// NewImageAltRule returns a new Rule that reports images without alt texts.
+// Images marked as decorative like '![](border.png){.decorative}' are
+// not reported.
+func NewImageAltRule() Rule {
+	return NewRule("image-alt", func(c *Context) {
+		_ = ast.Walk(c.Document, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+			if !entering || n.Kind() != ast.KindImage {
+				return ast.WalkContinue, nil
+			}
+			if util.IsBlank(n.Text(c.Source)) && !html.IsDecorativeImage(n) {
+				c.Report(n, SeverityWarning, "image %q should have an alt text", n.(*ast.Image).Destination)
+			}
+			return ast.WalkSkipChildren, nil
+		})
+	})
+}
+
+// DefaultNonDescriptiveLinkTexts is a list of link texts that do not
+// describe their destinations.
+var DefaultNonDescriptiveLinkTexts = []string{
+	"here",
+	"click here",
+	"link",
+	"this",
+	"more",
+	"read more",
+}
+
+// NewDescriptiveLinkTextRule returns a new Rule that reports links whose
+// texts are one of the given texts like 'here'.
+// Texts are compared case-insensitively.
+// If no texts are given, DefaultNonDescriptiveLinkTexts are used.
+func NewDescriptiveLinkTextRule(texts ...string) Rule {
+	if len(texts) == 0 {
+		texts = DefaultNonDescriptiveLinkTexts
+	}
+	return NewRule("descriptive-link-text", func(c *Context) {
+		_ = ast.Walk(c.Document, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+			if !entering || n.Kind() != ast.KindLink {
+				return ast.WalkContinue, nil
+			}
+			t := string(bytes.TrimRight(util.TrimRightSpace(util.TrimLeftSpace(n.Text(c.Source))), ".:!"))
+			for _, text := range texts {
+				if strings.EqualFold(t, text) {
+					c.Report(n, SeverityWarning, "link text %q does not describe its destination", t)
+					break
+				}
+			}
+			return ast.WalkSkipChildren, nil
+		})
+	})
+}
+
+// NewTableHeaderRule returns a new Rule that reports tables whose header
+// cells are all empty.
+func NewTableHeaderRule() Rule {
+	return NewRule("table-header", func(c *Context) {
+		_ = ast.Walk(c.Document, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+			if !entering || n.Kind() != east.KindTable {
+				return ast.WalkContinue, nil
+			}
+			if header := n.FirstChild(); header != nil && header.Kind() == east.KindTableHeader {
+				for cell := header.FirstChild(); cell != nil; cell = cell.NextSibling() {
+					if !util.IsBlank(cell.Text(c.Source)) {
+						return ast.WalkSkipChildren, nil
+					}
+				}
+			}
+			// tables do not hold their lines, so we report from the head of
+			// the line that contains the first cell.
+			s := NodeSegment(n, c.Source)
+			s = s.WithStart(bytes.LastIndexByte(c.Source[:s.Start], '\n') + 1)
+			c.ReportSegment(n, s, SeverityWarning, "table should have a header")
+			return ast.WalkSkipChildren, nil
+		})
+	})
+}
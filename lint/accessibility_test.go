@@ -0,0 +1,39 @@
+package lint_test
+
+import (
+	"testing"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/lint"
+)
+
+func TestAccessibilityRules(t *testing.T) {
+	source := []byte("# Title\n" +
+		"\n" +
+		"Logo: ![](logo.png) and ![Chart](chart.png) ![](rule.png){.decorative}\n" +
+		"\n" +
+		"Click [here](/docs) or read the [documentation](/docs).\n" +
+		"\n" +
+		"### Skipped\n" +
+		"\n" +
+		"|   |   |\n" +
+		"|---|---|\n" +
+		"| 1 | 2 |\n")
+	markdown := goldmark.New(goldmark.WithExtensions(extension.Table, extension.ImageDimensions))
+	findings := lint.New(lint.AccessibilityRules()...).LintSource(markdown.Parser(), source)
+	expected := []string{
+		"3:7: warning: image \"logo.png\" should have an alt text (image-alt)",
+		"5:8: warning: link text \"here\" does not describe its destination (descriptive-link-text)",
+		"7:5: warning: heading level should be incremented by one: expected h2, but got h3 (heading-increment)",
+		"9:1: warning: table should have a header (table-header)",
+	}
+	if len(findings) != len(expected) {
+		t.Fatalf("expected %d findings, but got %v", len(expected), findings)
+	}
+	for i, f := range findings {
+		if f.String() != expected[i] {
+			t.Errorf("expected %q, but got %q", expected[i], f.String())
+		}
+	}
+}
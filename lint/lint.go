@@ -0,0 +1,242 @@
+// Package lint provides a framework for linting Markdown documents based on
+// goldmark's AST.
+package lint
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+)
+
+// Severity is a severity of findings.
+type Severity int
+
+const (
+	// SeverityInfo indicates a finding is just an information.
+	SeverityInfo Severity = iota + 1
+
+	// SeverityWarning indicates a finding should be fixed.
+	SeverityWarning
+
+	// SeverityError indicates a finding must be fixed.
+	SeverityError
+)
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityInfo:
+		return "info"
+	case SeverityWarning:
+		return "warning"
+	case SeverityError:
+		return "error"
+	}
+	return ""
+}
+
+// A Position struct represents a position in a source.
+type Position struct {
+	// Offset is a byte offset in the source.
+	Offset int
+
+	// Line is a 1-based line number.
+	Line int
+
+	// Column is a 1-based column number in bytes.
+	Column int
+}
+
+func (p Position) String() string {
+	return fmt.Sprintf("%d:%d", p.Line, p.Column)
+}
+
+// NewPosition returns a new Position of the given offset in the source.
+func NewPosition(source []byte, offset int) Position {
+	if offset > len(source) {
+		offset = len(source)
+	}
+	line := bytes.Count(source[:offset], []byte{'\n'}) + 1
+	column := offset - (bytes.LastIndexByte(source[:offset], '\n') + 1) + 1
+	return Position{Offset: offset, Line: line, Column: column}
+}
+
+// A Finding struct represents a problem found by a Rule.
+type Finding struct {
+	// Rule is a name of the rule that reported this finding.
+	Rule string
+
+	// Severity is a severity of this finding.
+	Severity Severity
+
+	// Message is a human readable description of this finding.
+	Message string
+
+	// Node is a node that caused this finding.
+	Node ast.Node
+
+	// Segment is a position of this finding in the source.
+	Segment text.Segment
+
+	// Position is a position where this finding starts.
+	Position Position
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("%s: %s: %s (%s)", f.Position, f.Severity, f.Message, f.Rule)
+}
+
+// A Context struct holds information that are necessary for Rules.
+type Context struct {
+	// Document is a root node of the document.
+	Document ast.Node
+
+	// Source is a source of the document.
+	Source []byte
+
+	// ParserContext is a parser.Context used for parsing the document.
+	// ParserContext may be nil.
+	ParserContext parser.Context
+
+	rule     Rule
+	findings []Finding
+}
+
+// Report reports a new finding about the given node.
+func (c *Context) Report(n ast.Node, severity Severity, format string, args ...interface{}) {
+	c.ReportSegment(n, NodeSegment(n, c.Source), severity, format, args...)
+}
+
+// ReportSegment reports a new finding at the given segment.
+func (c *Context) ReportSegment(n ast.Node, segment text.Segment, severity Severity, format string, args ...interface{}) {
+	c.findings = append(c.findings, Finding{
+		Rule:     c.rule.Name(),
+		Severity: severity,
+		Message:  fmt.Sprintf(format, args...),
+		Node:     n,
+		Segment:  segment,
+		Position: NewPosition(c.Source, segment.Start),
+	})
+}
+
+// A Rule interface checks documents.
+type Rule interface {
+	// Name returns a name of this rule.
+	Name() string
+
+	// Check checks the document and reports findings to the given context.
+	Check(c *Context)
+}
+
+type ruleFunc struct {
+	name string
+	f    func(*Context)
+}
+
+func (r *ruleFunc) Name() string {
+	return r.name
+}
+
+func (r *ruleFunc) Check(c *Context) {
+	r.f(c)
+}
+
+// NewRule returns a new Rule that checks documents by the given function.
+func NewRule(name string, f func(*Context)) Rule {
+	return &ruleFunc{name, f}
+}
+
+// A Linter struct checks documents with Rules.
+type Linter struct {
+	rules []Rule
+}
+
+// New returns a new Linter with the given rules.
+// If no rules are given, DefaultRules are used.
+func New(rules ...Rule) *Linter {
+	if len(rules) == 0 {
+		rules = DefaultRules()
+	}
+	return &Linter{rules: rules}
+}
+
+// Lint checks the given document and returns findings sorted by their
+// positions.
+func (l *Linter) Lint(doc ast.Node, source []byte, pc parser.Context) []Finding {
+	c := &Context{
+		Document:      doc,
+		Source:        source,
+		ParserContext: pc,
+	}
+	for _, rule := range l.rules {
+		c.rule = rule
+		rule.Check(c)
+	}
+	sort.SliceStable(c.findings, func(i, j int) bool {
+		return c.findings[i].Segment.Start < c.findings[j].Segment.Start
+	})
+	return c.findings
+}
+
+// LintSource parses the given source with the given parser and checks it.
+func (l *Linter) LintSource(p parser.Parser, source []byte) []Finding {
+	pc := parser.NewContext()
+	doc := p.Parse(text.NewReader(source), parser.WithContext(pc))
+	return l.Lint(doc, source, pc)
+}
+
+// NodeSegment returns a segment that represents a position of the given node.
+// NodeSegment returns a zero-length segment if the node does not hold
+// its position.
+func NodeSegment(n ast.Node, source []byte) text.Segment {
+	start, stop := -1, -1
+	_ = ast.Walk(n, func(c ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		var s text.Segment
+		ok := false
+		switch v := c.(type) {
+		case *ast.Text:
+			s, ok = v.Segment, true
+		case *ast.RawHTML:
+			if v.Segments.Len() != 0 {
+				s = text.NewSegment(v.Segments.At(0).Start, v.Segments.At(v.Segments.Len()-1).Stop)
+				ok = true
+			}
+		default:
+			if c.Type() != ast.TypeInline {
+				if l := c.Lines(); l.Len() != 0 {
+					s = text.NewSegment(l.At(0).Start, l.At(l.Len()-1).Stop)
+					ok = true
+				}
+			}
+		}
+		if ok {
+			if start < 0 || s.Start < start {
+				start = s.Start
+			}
+			if s.Stop > stop {
+				stop = s.Stop
+			}
+		}
+		return ast.WalkContinue, nil
+	})
+	if start < 0 {
+		// nodes like AutoLink do not hold their positions, so we use a
+		// position just after the previous node.
+		if prev := n.PreviousSibling(); prev != nil {
+			s := NodeSegment(prev, source)
+			return text.NewSegment(s.Stop, s.Stop)
+		}
+		if parent := n.Parent(); parent != nil {
+			s := NodeSegment(parent, source)
+			return text.NewSegment(s.Start, s.Start)
+		}
+		return text.NewSegment(0, 0)
+	}
+	return text.NewSegment(start, stop)
+}
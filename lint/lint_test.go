@@ -0,0 +1,34 @@
+package lint
+
+import (
+	"testing"
+
+	"github.com/yuin/goldmark"
+)
+
+func TestDefaultRules(t *testing.T) {
+	source := []byte("# Title\n" +
+		"\n" +
+		"### Skipped\n" +
+		"\n" +
+		"# Title\n" +
+		"\n" +
+		"See https://example.com and [foo] and <https://example.org> and [ok].\n" +
+		"\n" +
+		"[ok]: /ok\n")
+	findings := New().LintSource(goldmark.DefaultParser(), source)
+	expected := []string{
+		"3:5: warning: heading level should be incremented by one: expected h2, but got h3 (heading-increment)",
+		"5:3: warning: duplicate heading \"Title\" (duplicate-heading)",
+		"7:5: warning: bare URL \"https://example.com\" (no-bare-urls)",
+		"7:29: error: reference \"foo\" is not defined (undefined-reference)",
+	}
+	if len(findings) != len(expected) {
+		t.Fatalf("expected %d findings, but got %v", len(expected), findings)
+	}
+	for i, f := range findings {
+		if f.String() != expected[i] {
+			t.Errorf("expected %q, but got %q", expected[i], f.String())
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package lint
+
+import (
+	"bytes"
+	"regexp"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// DefaultRules returns a new list of default rules.
+// Default rules are:
+//
+//     NewDuplicateHeadingRule
+//     NewHeadingIncrementRule
+//     NewBareURLRule
+//     NewUndefinedReferenceRule
+func DefaultRules() []Rule {
+	return []Rule{
+		NewDuplicateHeadingRule(),
+		NewHeadingIncrementRule(),
+		NewBareURLRule(),
+		NewUndefinedReferenceRule(),
+	}
+}
+
+// NewDuplicateHeadingRule returns a new Rule that reports headings that
+// have same text as previous headings.
+func NewDuplicateHeadingRule() Rule {
+	return NewRule("duplicate-heading", func(c *Context) {
+		seen := map[string]bool{}
+		_ = ast.Walk(c.Document, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+			if !entering || n.Kind() != ast.KindHeading {
+				return ast.WalkContinue, nil
+			}
+			t := string(n.Text(c.Source))
+			if seen[t] {
+				c.Report(n, SeverityWarning, "duplicate heading %q", t)
+			}
+			seen[t] = true
+			return ast.WalkSkipChildren, nil
+		})
+	})
+}
+
+// NewHeadingIncrementRule returns a new Rule that reports headings that
+// skip levels like '#' followed by '###'.
+func NewHeadingIncrementRule() Rule {
+	return NewRule("heading-increment", func(c *Context) {
+		last := 0
+		_ = ast.Walk(c.Document, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+			if !entering {
+				return ast.WalkContinue, nil
+			}
+			heading, ok := n.(*ast.Heading)
+			if !ok {
+				return ast.WalkContinue, nil
+			}
+			if last != 0 && heading.Level > last+1 {
+				c.Report(n, SeverityWarning, "heading level should be incremented by one: expected h%d, but got h%d", last+1, heading.Level)
+			}
+			last = heading.Level
+			return ast.WalkSkipChildren, nil
+		})
+	})
+}
+
+var bareURLRegexp = regexp.MustCompile(`(?:https?|ftp)://[^\s<>]+`)
+
+// NewBareURLRule returns a new Rule that reports URLs that are not enclosed
+// in angle brackets or written as links.
+func NewBareURLRule() Rule {
+	return NewRule("no-bare-urls", func(c *Context) {
+		_ = ast.Walk(c.Document, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+			if !entering {
+				return ast.WalkContinue, nil
+			}
+			switch v := n.(type) {
+			case *ast.Link, *ast.Image, *ast.CodeSpan, *ast.RawHTML:
+				return ast.WalkSkipChildren, nil
+			case *ast.AutoLink:
+				s := NodeSegment(v, c.Source)
+				if s.Start >= len(c.Source) || c.Source[s.Start] != '<' {
+					s = s.WithStop(s.Start + len(v.Label(c.Source)))
+					c.ReportSegment(n, s, SeverityWarning, "bare URL %q", v.URL(c.Source))
+				}
+				return ast.WalkSkipChildren, nil
+			case *ast.Text:
+				if v.IsRaw() {
+					return ast.WalkContinue, nil
+				}
+				value := v.Segment.Value(c.Source)
+				for _, m := range bareURLRegexp.FindAllIndex(value, -1) {
+					s := text.NewSegment(v.Segment.Start+m[0], v.Segment.Start+m[1])
+					c.ReportSegment(n, s, SeverityWarning, "bare URL %q", s.Value(c.Source))
+				}
+			}
+			return ast.WalkContinue, nil
+		})
+	})
+}
+
+var referenceRegexp = regexp.MustCompile(`\[([^\[\]]+)\](?:\[([^\[\]]*)\])?`)
+
+// NewUndefinedReferenceRule returns a new Rule that reports reference links
+// whose labels are not defined.
+func NewUndefinedReferenceRule() Rule {
+	return NewRule("undefined-reference", func(c *Context) {
+		_ = ast.Walk(c.Document, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+			if !entering || n.Type() == ast.TypeInline || n.IsRaw() {
+				return ast.WalkContinue, nil
+			}
+			var run []*ast.Text
+			for child := n.FirstChild(); child != nil; child = child.NextSibling() {
+				t, ok := child.(*ast.Text)
+				if ok && !t.IsRaw() && (len(run) == 0 || run[len(run)-1].Segment.Stop == t.Segment.Start) {
+					run = append(run, t)
+					continue
+				}
+				checkUndefinedReferences(c, run)
+				run = run[0:0]
+				if ok && !t.IsRaw() {
+					run = append(run, t)
+				}
+			}
+			checkUndefinedReferences(c, run)
+			return ast.WalkContinue, nil
+		})
+	})
+}
+
+func checkUndefinedReferences(c *Context, run []*ast.Text) {
+	if len(run) == 0 {
+		return
+	}
+	s := text.NewSegment(run[0].Segment.Start, run[len(run)-1].Segment.Stop)
+	value := s.Value(c.Source)
+	for _, m := range referenceRegexp.FindAllSubmatchIndex(value, -1) {
+		if m[0] > 0 && value[m[0]-1] == '\\' {
+			continue
+		}
+		if m[1] < len(value) && (value[m[1]] == '(' || value[m[1]] == ':') {
+			continue
+		}
+		label := value[m[2]:m[3]]
+		if m[4] >= 0 && m[5] > m[4] {
+			label = value[m[4]:m[5]]
+		}
+		if util.IsBlank(label) || bytes.IndexByte(label, '\n') >= 0 {
+			continue
+		}
+		if c.ParserContext != nil {
+			if _, ok := c.ParserContext.Reference(util.ToLinkReference(label)); ok {
+				continue
+			}
+		}
+		seg := text.NewSegment(s.Start+m[0], s.Start+m[1])
+		var node ast.Node = run[0]
+		for _, t := range run {
+			if t.Segment.Start <= seg.Start && seg.Start < t.Segment.Stop {
+				node = t
+			}
+		}
+		c.ReportSegment(node, seg, SeverityError, "reference %q is not defined", label)
+	}
+}
@@ -33,6 +33,11 @@ func Convert(source []byte, w io.Writer, opts ...parser.ParseOption) error {
 
 // A Markdown interface offers functions to convert Markdown text to
 // a desired format.
+//
+// Markdown objects are safe for concurrent use by multiple goroutines once
+// they are configured, as long as extensions keep per-parse states in
+// parser.Context(see parser.ContextState) and per-render states in
+// writers(see renderer.WriterWrapper).
 type Markdown interface {
 	// Convert interprets a UTF-8 bytes source in Markdown and write rendered
 	// contents to a writer w.
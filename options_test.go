@@ -1,8 +1,16 @@
 package goldmark
 
 import (
-	"github.com/yuin/goldmark/parser"
+	"bytes"
+	"errors"
 	"testing"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
 )
 
 func TestAttributeAndAutoHeadingID(t *testing.T) {
@@ -10,7 +18,721 @@ func TestAttributeAndAutoHeadingID(t *testing.T) {
 		WithParserOptions(
 			parser.WithAttribute(),
 			parser.WithAutoHeadingID(),
+			parser.WithFencedCodeBlockAttribute(),
 		),
 	)
 	DoTestCaseFile(markdown, "_test/options.txt", t)
 }
+
+func TestAttributeKeepsFencedCodeBlockInfo(t *testing.T) {
+	markdown := New(
+		WithParserOptions(
+			parser.WithAttribute(),
+		),
+	)
+	source := []byte("```go {#code_1 .numbered}\nfmt.Println(\"Hi\")\n```\n")
+	doc := markdown.Parser().Parse(text.NewReader(source))
+	n := doc.FirstChild().(*ast.FencedCodeBlock)
+	if info := string(n.Info.Text(source)); info != "go {#code_1 .numbered}" {
+		t.Errorf("info strings must be kept without WithFencedCodeBlockAttribute: %q", info)
+	}
+	if n.Attributes() != nil {
+		t.Errorf("unexpected attributes: %v", n.Attributes())
+	}
+}
+
+func TestSlugger(t *testing.T) {
+	source := `# Crème Brûlée & Co.
+# Привет, мир
+# 日本語 タイトル
+# Hello, World!
+# Hello, World!`
+	for i, c := range []struct {
+		opts     []parser.Option
+		expected string
+	}{
+		{nil, `<h1 id="crme-brle--co">Crème Brûlée &amp; Co.</h1>
+<h1 id="-">Привет, мир</h1>
+<h1 id="-1">日本語 タイトル</h1>
+<h1 id="hello-world">Hello, World!</h1>
+<h1 id="hello-world1">Hello, World!</h1>`},
+		{[]parser.Option{parser.WithSlugger(parser.TransliterationSlugger)}, `<h1 id="creme-brulee-co">Crème Brûlée &amp; Co.</h1>
+<h1 id="privet-mir">Привет, мир</h1>
+<h1 id="heading">日本語 タイトル</h1>
+<h1 id="hello-world">Hello, World!</h1>
+<h1 id="hello-world1">Hello, World!</h1>`},
+		{[]parser.Option{parser.WithSlugger(parser.UnicodeSlugger)}, `<h1 id="crème-brûlée-co">Crème Brûlée &amp; Co.</h1>
+<h1 id="привет-мир">Привет, мир</h1>
+<h1 id="日本語-タイトル">日本語 タイトル</h1>
+<h1 id="hello-world">Hello, World!</h1>
+<h1 id="hello-world1">Hello, World!</h1>`},
+		{[]parser.Option{parser.WithSlugger(parser.GitHubSlugger), parser.WithDuplicateIDSuffix(parser.HyphenatedIDSuffix)}, `<h1 id="crème-brûlée--co">Crème Brûlée &amp; Co.</h1>
+<h1 id="привет-мир">Привет, мир</h1>
+<h1 id="日本語-タイトル">日本語 タイトル</h1>
+<h1 id="hello-world">Hello, World!</h1>
+<h1 id="hello-world-1">Hello, World!</h1>`},
+	} {
+		markdown := New(
+			WithParserOptions(
+				append([]parser.Option{parser.WithAutoHeadingID()}, c.opts...)...,
+			),
+		)
+		DoTestCases(markdown, []MarkdownTestCase{
+			{
+				No:       i + 1,
+				Markdown: source,
+				Expected: c.expected,
+			},
+		}, t)
+	}
+}
+
+func TestSluggerWithContext(t *testing.T) {
+	source := []byte("# Привет мир\n# Привет мир")
+	for i, c := range []struct {
+		context  parser.Context
+		expected string
+	}{
+		{parser.NewContext(), `<h1 id="привет-мир">Привет мир</h1>
+<h1 id="привет-мир-1">Привет мир</h1>
+`},
+		{parser.NewContext(parser.WithIDs(parser.NewIDs(nil, nil))), `<h1 id="-">Привет мир</h1>
+<h1 id="-1">Привет мир</h1>
+`},
+	} {
+		markdown := New(
+			WithParserOptions(
+				parser.WithAutoHeadingID(),
+				parser.WithSlugger(parser.UnicodeSlugger),
+				parser.WithDuplicateIDSuffix(parser.HyphenatedIDSuffix),
+			),
+		)
+		var b bytes.Buffer
+		if err := markdown.Convert(source, &b, parser.WithContext(c.context)); err != nil {
+			t.Fatal(err)
+		}
+		if b.String() != c.expected {
+			t.Errorf("%d: expected %q, but got %q", i+1, c.expected, b.String())
+		}
+	}
+}
+
+func TestRawHTMLRewriter(t *testing.T) {
+	markdown := New(
+		WithRendererOptions(
+			html.WithRawHTMLRewriter(func(tag *html.HTMLTag) bool {
+				if string(tag.Name) == "script" {
+					return false
+				}
+				for _, attr := range tag.Attributes {
+					if bytes.HasPrefix(attr.Name, []byte("on")) {
+						tag.RemoveAttribute(string(attr.Name))
+					}
+				}
+				if string(tag.Name) == "img" && !tag.IsEnd {
+					tag.SetAttribute("class", []byte("external"))
+				}
+				return true
+			}),
+		),
+	)
+	DoTestCases(markdown, []MarkdownTestCase{
+		{
+			No:       1,
+			Markdown: `Inline <span onclick="alert(1)" title='a "b"'>text</span> and <script>x</script>`,
+			Expected: `<p>Inline <span title="a &quot;b&quot;">text</span> and x</p>`,
+		},
+		{
+			No: 2,
+			Markdown: `<div ONMOUSEOVER=alert(1) data-x
+  hidden>
+<img src="a.png" onerror="alert(1)"/>
+</div>`,
+			Expected: `<div data-x hidden>
+<img src="a.png" class="external" />
+</div>`,
+		},
+		{
+			No:       3,
+			Markdown: `<!-- comment --> a < b`,
+			Expected: `<!-- comment --> a < b`,
+		},
+	}, t)
+}
+
+func TestDeterministicOutput(t *testing.T) {
+	markdown := New(
+		WithParserOptions(
+			parser.WithAttribute(),
+		),
+		WithRendererOptions(
+			renderer.WithDeterministicOutput(),
+		),
+	)
+	DoTestCases(markdown, []MarkdownTestCase{
+		{
+			No:       1,
+			Markdown: `# Title {data-z=1 #top .a data-b=2}`,
+			Expected: `<h1 class="a" data-b="2" data-z="1" id="top">Title</h1>`,
+		},
+	}, t)
+
+	// rendering must not modify the AST
+	source := []byte(`# Title {data-z=1 #top}`)
+	doc := markdown.Parser().Parse(text.NewReader(source))
+	var b bytes.Buffer
+	if err := markdown.Renderer().Render(&b, source, doc); err != nil {
+		t.Fatal(err)
+	}
+	if attrs := doc.FirstChild().Attributes(); string(attrs[0].Name) != "data-z" {
+		t.Errorf("attributes must not be sorted in place: %s", attrs[0].Name)
+	}
+}
+
+func TestDataAttributePolicy(t *testing.T) {
+	source := `# Title {data-ui-id=1 data-secret=2 title=t}`
+	for i, c := range []struct {
+		policy   html.DataAttributePolicy
+		expected string
+	}{
+		{nil, `<h1 data-ui-id="1" data-secret="2" title="t">Title</h1>`},
+		{html.AllowDataAttributes, `<h1 data-ui-id="1" data-secret="2" title="t">Title</h1>`},
+		{html.DenyDataAttributes, `<h1 title="t">Title</h1>`},
+		{html.AllowDataAttributePrefixes("data-ui-"), `<h1 data-ui-id="1" title="t">Title</h1>`},
+	} {
+		markdown := New(
+			WithParserOptions(
+				parser.WithAttribute(),
+			),
+			WithRendererOptions(
+				html.WithDataAttributePolicy(c.policy),
+			),
+		)
+		DoTestCases(markdown, []MarkdownTestCase{
+			{
+				No:       i + 1,
+				Markdown: source,
+				Expected: c.expected,
+			},
+		}, t)
+	}
+}
+
+func TestElementMapping(t *testing.T) {
+	markdown := New(
+		WithRendererOptions(
+			html.WithElementMapping(ast.KindParagraph, "my-p", nil),
+			html.WithElementMapping(ast.KindBlockquote, "fancy-quote", map[string]string{"variant": "note", "elevated": ""}),
+			html.WithElementMapping(ast.KindEmphasis, "x-em", nil),
+		),
+	)
+	DoTestCases(markdown, []MarkdownTestCase{
+		{
+			No: 1,
+			Markdown: `> Hello *world*
+>
+> - item`,
+			Expected: `<fancy-quote elevated variant="note">
+<my-p>Hello <x-em>world</x-em></my-p>
+<ul>
+<li>item</li>
+</ul>
+</fancy-quote>`,
+		},
+	}, t)
+}
+
+func TestMissingAltText(t *testing.T) {
+	source := `![](images/company-logo.png?v=2) ![Logo](logo.png)`
+	for i, c := range []struct {
+		value    html.MissingAltText
+		expected string
+	}{
+		{html.MissingAltTextEmpty, `<p><img src="images/company-logo.png?v=2" alt=""> <img src="logo.png" alt="Logo"></p>`},
+		{html.MissingAltTextFilename, `<p><img src="images/company-logo.png?v=2" alt="company-logo"> <img src="logo.png" alt="Logo"></p>`},
+	} {
+		markdown := New(WithRendererOptions(html.WithMissingAltText(c.value)))
+		DoTestCases(markdown, []MarkdownTestCase{
+			{
+				No:       i + 1,
+				Markdown: source,
+				Expected: c.expected,
+			},
+		}, t)
+	}
+
+	var diagnostics []html.Diagnostic
+	markdown := New(WithRendererOptions(
+		html.WithMissingAltText(html.MissingAltTextWarning),
+		html.WithDiagnosticHandler(func(d html.Diagnostic) {
+			diagnostics = append(diagnostics, d)
+		}),
+	))
+	var b bytes.Buffer
+	if err := markdown.Convert([]byte(source), &b); err != nil {
+		t.Fatal(err)
+	}
+	if len(diagnostics) != 1 || diagnostics[0].Err != html.ErrMissingAltText {
+		t.Errorf("expected a missing alt text diagnostic, but got %v", diagnostics)
+	}
+}
+
+func TestUnwrapParagraph(t *testing.T) {
+	markdown := New(
+		WithRendererOptions(
+			html.WithUnwrapParagraph(),
+		),
+	)
+	DoTestCases(markdown, []MarkdownTestCase{
+		{
+			No:       1,
+			Markdown: `Save *all* changes`,
+			Expected: `Save <em>all</em> changes`,
+		},
+		{
+			No:       2,
+			Markdown: "First\n\nSecond",
+			Expected: "<p>First</p>\n<p>Second</p>",
+		},
+		{
+			No:       3,
+			Markdown: "> Quoted",
+			Expected: "<blockquote>\n<p>Quoted</p>\n</blockquote>",
+		},
+	}, t)
+}
+
+func TestReferenceResolver(t *testing.T) {
+	markdown := New(
+		WithParserOptions(
+			parser.WithReferences(
+				parser.NewReference([]byte("Home"), []byte("/wiki/home"), []byte("Home page")),
+				parser.NewReference([]byte("Defined"), []byte("/predefined"), nil),
+			),
+		),
+	)
+	DoTestCases(markdown, []MarkdownTestCase{
+		{
+			No:       1,
+			Markdown: `See [home] and [the page][HOME] and ![logo][home].`,
+			Expected: `<p>See <a href="/wiki/home" title="Home page">home</a> and <a href="/wiki/home" title="Home page">the page</a> and <img src="/wiki/home" alt="logo" title="Home page">.</p>`,
+		},
+		{
+			No: 2,
+			Markdown: `[Defined] and [Missing]
+
+[defined]: /document`,
+			Expected: `<p><a href="/document">Defined</a> and [Missing]</p>`,
+		},
+	}, t)
+
+	var labels []string
+	markdown = New(
+		WithParserOptions(
+			parser.WithReferenceResolver(func(label []byte) (parser.Reference, bool) {
+				labels = append(labels, string(label))
+				return parser.NewReference(label, append([]byte("/wiki/"), label...), nil), true
+			}),
+		),
+	)
+	DoTestCases(markdown, []MarkdownTestCase{
+		{
+			No:       3,
+			Markdown: `[Page] and [Page]`,
+			Expected: `<p><a href="/wiki/Page">Page</a> and <a href="/wiki/Page">Page</a></p>`,
+		},
+	}, t)
+	if len(labels) != 1 {
+		t.Errorf("resolved references should be cached in a document, but resolved %d times", len(labels))
+	}
+}
+
+func TestUndefinedReferenceHandler(t *testing.T) {
+	markdown := New(
+		WithParserOptions(
+			parser.WithUndefinedReferenceHandler(parser.BrokenLinkHandler),
+		),
+	)
+	DoTestCases(markdown, []MarkdownTestCase{
+		{
+			No: 1,
+			Markdown: `[Defined], [Missing *page*], [text][missing] and ![logo]
+
+[defined]: /document`,
+			Expected: `<p><a href="/document">Defined</a>, <a href="" class="broken-link">Missing <em>page</em></a>, <a href="" class="broken-link">text</a> and <img src="" alt="logo" class="broken-link"></p>`,
+		},
+	}, t)
+
+	var labels []string
+	markdown = New(
+		WithParserOptions(
+			parser.WithReferences(parser.NewReference([]byte("home"), []byte("/home"), nil)),
+			parser.WithUndefinedReferenceHandler(func(ref *parser.UndefinedReference, link *ast.Link, pc parser.Context) bool {
+				labels = append(labels, string(ref.Label))
+				if ref.IsImage {
+					return false
+				}
+				link.Destination = []byte("/search?q=" + string(ref.Label))
+				return true
+			}),
+		),
+	)
+	DoTestCases(markdown, []MarkdownTestCase{
+		{
+			No:       2,
+			Markdown: `[home], [page] and ![logo]`,
+			Expected: `<p><a href="/home">home</a>, <a href="/search?q=page">page</a> and ![logo]</p>`,
+		},
+	}, t)
+	if len(labels) != 2 || labels[0] != "page" || labels[1] != "logo" {
+		t.Errorf("undefined references should be reported, but got %v", labels)
+	}
+}
+
+func TestDocumentHardWraps(t *testing.T) {
+	source := []byte("line1\nline2")
+	for i, c := range []struct {
+		hardWraps bool
+		opts      []parser.ParseOption
+		expected  string
+	}{
+		{false, nil, "<p>line1\nline2</p>\n"},
+		{false, []parser.ParseOption{html.WithDocumentHardWraps(true)}, "<p>line1<br>\nline2</p>\n"},
+		{true, nil, "<p>line1<br>\nline2</p>\n"},
+		{true, []parser.ParseOption{html.WithDocumentHardWraps(false)}, "<p>line1\nline2</p>\n"},
+	} {
+		var opts []renderer.Option
+		if c.hardWraps {
+			opts = append(opts, html.WithHardWraps())
+		}
+		markdown := New(WithRendererOptions(opts...))
+		var b bytes.Buffer
+		if err := markdown.Convert(source, &b, c.opts...); err != nil {
+			t.Fatal(err)
+		}
+		if b.String() != c.expected {
+			t.Errorf("%d: expected %q, but got %q", i+1, c.expected, b.String())
+		}
+	}
+}
+
+func TestEmailObfuscation(t *testing.T) {
+	source := []byte("<a@b.c>")
+	for i, c := range []struct {
+		obfuscation html.EmailObfuscation
+		opts        []parser.ParseOption
+		expected    string
+	}{
+		{html.EmailObfuscationNone, nil, `<p><a href="mailto:a@b.c">a@b.c</a></p>` + "\n"},
+		{html.EmailObfuscationEntities, nil, `<p><a href="&#109;&#97;&#105;&#108;&#116;&#111;&#58;&#97;&#64;&#98;&#46;&#99;">&#97;&#64;&#98;&#46;&#99;</a></p>` + "\n"},
+		{html.EmailObfuscationReverse, nil, `<p><a href="&#109;&#97;&#105;&#108;&#116;&#111;&#58;&#97;&#64;&#98;&#46;&#99;"><span style="unicode-bidi:bidi-override;direction:rtl">c.b@a</span></a></p>` + "\n"},
+		{html.EmailObfuscationEntities, []parser.ParseOption{html.WithDocumentEmailObfuscation(html.EmailObfuscationNone)}, `<p><a href="mailto:a@b.c">a@b.c</a></p>` + "\n"},
+	} {
+		markdown := New(WithRendererOptions(html.WithEmailObfuscation(c.obfuscation)))
+		var b bytes.Buffer
+		if err := markdown.Convert(source, &b, c.opts...); err != nil {
+			t.Fatal(err)
+		}
+		if b.String() != c.expected {
+			t.Errorf("%d: expected %q, but got %q", i+1, c.expected, b.String())
+		}
+	}
+}
+
+func TestImageResolver(t *testing.T) {
+	var diagnostics []html.Diagnostic
+	markdown := New(
+		WithRendererOptions(
+			html.WithImageResolver(func(n *ast.Image) (*html.ResolvedImage, error) {
+				switch string(n.Destination) {
+				case "photo.jpg":
+					return &html.ResolvedImage{
+						Src:    []byte("https://cdn.example.com/photo.jpg?w=800"),
+						Srcset: []byte("https://cdn.example.com/photo.jpg?w=480 480w, https://cdn.example.com/photo.jpg?w=800 800w"),
+						Sizes:  []byte("(max-width: 600px) 480px, 800px"),
+					}, nil
+				case "hero.png":
+					return &html.ResolvedImage{
+						Sources: []html.ImageSource{
+							{Srcset: []byte("hero.avif"), Type: []byte("image/avif")},
+							{Srcset: []byte("javascript:alert(1)"), Type: []byte("image/webp")},
+							{Srcset: []byte("hero-wide.png 2x"), Media: []byte("(min-width: 800px)")},
+						},
+					}, nil
+				case "broken.png":
+					return nil, errors.New("not found")
+				}
+				return nil, nil
+			}),
+			html.WithDiagnosticHandler(func(d html.Diagnostic) {
+				diagnostics = append(diagnostics, d)
+			}),
+		),
+	)
+	DoTestCases(markdown, []MarkdownTestCase{
+		{
+			No:       1,
+			Markdown: `![Photo](photo.jpg "A & B") ![Hero](hero.png) ![Broken](broken.png) ![Other](other.png)`,
+			Expected: `<p><img src="https://cdn.example.com/photo.jpg?w=800" alt="Photo" title="A &amp; B" srcset="https://cdn.example.com/photo.jpg?w=480 480w, https://cdn.example.com/photo.jpg?w=800 800w" sizes="(max-width: 600px) 480px, 800px"> <picture><source srcset="hero.avif" type="image/avif"><source srcset="hero-wide.png 2x" media="(min-width: 800px)"><img src="hero.png" alt="Hero"></picture> <img src="broken.png" alt="Broken"> <img src="other.png" alt="Other"></p>`,
+		},
+	}, t)
+	if len(diagnostics) != 1 || diagnostics[0].Err.Error() != "not found" {
+		t.Errorf("expected a diagnostic for the broken image, but got %v", diagnostics)
+	}
+}
+
+func TestLazyImages(t *testing.T) {
+	source := `![Hero](hero.png)
+
+![A](a.png) ![B](b.png "eager")`
+	eagerTitle := func(n *ast.Image) bool {
+		return string(n.Title) == "eager"
+	}
+	for i, c := range []struct {
+		opts     []renderer.Option
+		expected string
+	}{
+		{
+			[]renderer.Option{html.WithLazyImages(), html.WithAsyncImageDecoding()},
+			`<p><img src="hero.png" alt="Hero" loading="lazy" decoding="async"></p>
+<p><img src="a.png" alt="A" loading="lazy" decoding="async"> <img src="b.png" alt="B" title="eager" loading="lazy" decoding="async"></p>`,
+		},
+		{
+			[]renderer.Option{html.WithLazyImages(), html.WithEagerImageFunc(html.FirstImages(1))},
+			`<p><img src="hero.png" alt="Hero"></p>
+<p><img src="a.png" alt="A" loading="lazy"> <img src="b.png" alt="B" title="eager" loading="lazy"></p>`,
+		},
+		{
+			[]renderer.Option{html.WithAsyncImageDecoding(), html.WithEagerImageFunc(eagerTitle)},
+			`<p><img src="hero.png" alt="Hero" decoding="async"></p>
+<p><img src="a.png" alt="A" decoding="async"> <img src="b.png" alt="B" title="eager"></p>`,
+		},
+	} {
+		markdown := New(WithRendererOptions(c.opts...))
+		DoTestCases(markdown, []MarkdownTestCase{
+			{
+				No:       i + 1,
+				Markdown: source,
+				Expected: c.expected,
+			},
+		}, t)
+	}
+}
+
+func TestSourcePos(t *testing.T) {
+	markdown := New(
+		WithRendererOptions(
+			html.WithSourcePos(),
+		),
+	)
+	DoTestCases(markdown, []MarkdownTestCase{
+		{
+			No: 1,
+			Markdown: `# Title
+
+> quote
+> *lines*
+
+- item
+- [link](/a)
+
+` + "```" + `
+code
+` + "```\n",
+			Expected: `<h1 data-sourcepos="1:3-1:7">Title</h1>
+<blockquote data-sourcepos="3:3-4:9">
+<p data-sourcepos="3:3-4:9">quote
+<em>lines</em></p>
+</blockquote>
+<ul data-sourcepos="6:3-7:12">
+<li data-sourcepos="6:3-6:6">item</li>
+<li data-sourcepos="7:3-7:12"><a href="/a">link</a></li>
+</ul>
+<pre data-sourcepos="10:1-10:4"><code>code
+</code></pre>`,
+		},
+	}, t)
+
+	// positions are rendered without modifying the AST
+	source := []byte("# Title\n")
+	doc := markdown.Parser().Parse(text.NewReader(source))
+	for i := 0; i < 2; i++ {
+		var b bytes.Buffer
+		if err := markdown.Renderer().Render(&b, source, doc); err != nil {
+			t.Fatal(err)
+		}
+		if b.String() != "<h1 data-sourcepos=\"1:3-1:7\">Title</h1>\n" {
+			t.Errorf("unexpected output: %q", b.String())
+		}
+	}
+	if doc.FirstChild().Attributes() != nil {
+		t.Errorf("attributes must not be set to nodes: %v", doc.FirstChild().Attributes())
+	}
+}
+
+func TestIndent(t *testing.T) {
+	markdown := New(
+		WithRendererOptions(
+			html.WithIndent("  "),
+		),
+	)
+	DoTestCases(markdown, []MarkdownTestCase{
+		{
+			No: 1,
+			Markdown: `> - a
+>   - b
+>
+>   ` + "```" + `
+>   code
+>    indented
+>   ` + "```" + `
+> - c
+`,
+			Expected: `<blockquote>
+  <ul>
+    <li>
+      <p>a</p>
+      <ul>
+        <li>b</li>
+      </ul>
+      <pre><code>code
+ indented
+</code></pre>
+    </li>
+    <li>
+      <p>c</p>
+    </li>
+  </ul>
+</blockquote>`,
+		},
+	}, t)
+}
+
+func TestNodeHooks(t *testing.T) {
+	markdown := New(
+		WithRendererOptions(
+			renderer.WithNodeHooks(
+				func(w util.BufWriter, source []byte, n ast.Node) (ast.WalkStatus, error) {
+					switch n.Kind() {
+					case ast.KindBlockquote:
+						return ast.WalkSkipChildren, nil
+					case ast.KindHeading:
+						_, _ = w.WriteString("<section>\n")
+					}
+					return ast.WalkContinue, nil
+				},
+				func(w util.BufWriter, source []byte, n ast.Node) (ast.WalkStatus, error) {
+					if n.Kind() == ast.KindHeading {
+						_, _ = w.WriteString("</section>\n")
+					}
+					return ast.WalkContinue, nil
+				},
+			),
+		),
+	)
+	DoTestCases(markdown, []MarkdownTestCase{
+		{
+			No: 1,
+			Markdown: `# Title
+
+> skipped
+
+text
+`,
+			Expected: `<section>
+<h1>Title</h1>
+</section>
+<p>text</p>`,
+		},
+	}, t)
+}
+
+func TestDocumentNodeRenderers(t *testing.T) {
+	source := []byte("![alt](/a.png) *em*")
+	renderImage := func(w util.BufWriter, source []byte, n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if entering {
+			_, _ = w.WriteString("[image]")
+		}
+		return ast.WalkSkipChildren, nil
+	}
+	markdown := New()
+	for i, c := range []struct {
+		opts     []parser.ParseOption
+		expected string
+	}{
+		{[]parser.ParseOption{renderer.WithDocumentNodeRendererFunc(ast.KindImage, renderImage)}, "<p>[image] <em>em</em></p>\n"},
+		{[]parser.ParseOption{renderer.WithDocumentNodeRenderers(html.NewRenderer(html.WithXHTML()))}, "<p><img src=\"/a.png\" alt=\"alt\" /> <em>em</em></p>\n"},
+		{nil, "<p><img src=\"/a.png\" alt=\"alt\"> <em>em</em></p>\n"},
+	} {
+		var b bytes.Buffer
+		if err := markdown.Convert(source, &b, c.opts...); err != nil {
+			t.Fatal(err)
+		}
+		if b.String() != c.expected {
+			t.Errorf("%d: expected %q, but got %q", i+1, c.expected, b.String())
+		}
+	}
+}
+
+func TestEPUB(t *testing.T) {
+	markdown := New(
+		WithRendererOptions(
+			html.WithEPUB(),
+			html.WithUnsafe(),
+		),
+	)
+	DoTestCases(markdown, []MarkdownTestCase{
+		{
+			No: 1,
+			Markdown: `<div>&copy; &amp; &unknown;<br><input disabled></br>
+</div>
+
+a  
+b <img src="a.png">
+`,
+			Expected: `<div>&#169; &amp; &unknown;<br /><input disabled="disabled" />
+</div>
+<p>a<br />
+b <img src="a.png" /></p>`,
+		},
+	}, t)
+}
+
+func TestCodeRenderer(t *testing.T) {
+	var diagnostics []html.Diagnostic
+	markdown := New(
+		WithRendererOptions(
+			html.WithCodeRenderer(func(w util.BufWriter, source []byte, n ast.Node, language []byte) error {
+				switch string(language) {
+				case "mermaid":
+					_, _ = w.WriteString("<div class=\"mermaid\">diagram</div>\n")
+					return nil
+				case "broken":
+					// partially written contents must be discarded
+					_, _ = w.WriteString("<div class=\"broken\">")
+					return errors.New("syntax error")
+				}
+				return html.ErrCodeNotHandled
+			}),
+			html.WithDiagnosticHandler(func(d html.Diagnostic) {
+				diagnostics = append(diagnostics, d)
+			}),
+		),
+	)
+	DoTestCases(markdown, []MarkdownTestCase{
+		{
+			No:       1,
+			Markdown: "```mermaid\na --> b\n```\n\n```broken\nif a < b && c > d {\n```\n\n```go\na := 1\n```\n\n    indented\n",
+			Expected: "<div class=\"mermaid\">diagram</div>\n" +
+				"<pre><code class=\"language-broken\">if a &lt; b &amp;&amp; c &gt; d {\n</code></pre>\n" +
+				"<pre><code class=\"language-go\">a := 1\n</code></pre>\n" +
+				"<pre><code>indented\n</code></pre>",
+		},
+	}, t)
+	if len(diagnostics) != 1 || diagnostics[0].Err.Error() != "syntax error" {
+		t.Fatalf("expected a diagnostic for the broken code block, but got %v", diagnostics)
+	}
+	if _, ok := diagnostics[0].Node.(*ast.FencedCodeBlock); !ok {
+		t.Errorf("expected a diagnostic for the fenced code block, but got %T", diagnostics[0].Node)
+	}
+}
@@ -30,19 +30,28 @@ func ParseAttributes(reader text.Reader) (map[string]interface{}, bool) {
 			reader.Advance(1)
 			return m, true
 		}
+		if reader.Peek() == text.EOF {
+			// attributes are not closed
+			reader.SetPosition(savedLine, savedPosition)
+			return nil, false
+		}
 		attr, ok := parseAttribute(reader)
 		if !ok {
 			reader.SetPosition(savedLine, savedPosition)
 			return nil, false
 		}
 		if attr.Name == "class" {
+			class, ok := attr.Value.([]byte)
+			if !ok {
+				class = util.StringToReadOnlyBytes(fmt.Sprintf("%v", attr.Value))
+			}
 			if v, ok := m["class"]; ok {
 				if _, ok2 := v.([][]byte); !ok2 {
 					m["class"] = [][]byte{v.([]byte)}
 				}
-				m["class"] = append(m["class"].([][]byte), util.StringToReadOnlyBytes(fmt.Sprintf("%v", attr.Value)))
+				m["class"] = append(m["class"].([][]byte), class)
 			} else {
-				m["class"] = util.StringToReadOnlyBytes(fmt.Sprintf("%v", attr.Value))
+				m["class"] = class
 			}
 		} else {
 			m[attr.Name] = attr.Value
@@ -72,6 +81,9 @@ func parseAttribute(reader text.Reader) (attribute, bool) {
 		return attribute{Name: name, Value: line[0:i]}, true
 	}
 	line, _ := reader.PeekLine()
+	if len(line) == 0 {
+		return attribute{}, false
+	}
 	c = line[0]
 	if !((c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') ||
 		c == '_' || c == ':') {
@@ -120,6 +132,10 @@ func parseAttributeValue(reader text.Reader) (interface{}, bool) {
 	default:
 		if c == '-' || c == '+' || util.IsNumeric(c) {
 			value, ok = parseAttributeNumber(reader)
+			if ok && reader.Peek() == '%' { // percentages like 50%
+				reader.Advance(1)
+				value = util.StringToReadOnlyBytes(fmt.Sprintf("%v%%", value))
+			}
 		} else {
 			value, ok = parseAttributeOthers(reader)
 		}
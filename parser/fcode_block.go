@@ -9,14 +9,41 @@ import (
 )
 
 type fencedCodeBlockParser struct {
+	Attribute bool
 }
 
-var defaultFencedCodeBlockParser = &fencedCodeBlockParser{}
-
 // NewFencedCodeBlockParser returns a new BlockParser that
 // parses fenced code blocks.
 func NewFencedCodeBlockParser() BlockParser {
-	return defaultFencedCodeBlockParser
+	return &fencedCodeBlockParser{}
+}
+
+// SetOption implements SetOptioner.
+func (b *fencedCodeBlockParser) SetOption(name OptionName, value interface{}) {
+	switch name {
+	case optFencedCodeBlockAttribute:
+		b.Attribute = true
+	}
+}
+
+// FencedCodeBlockAttribute is an option name used in
+// WithFencedCodeBlockAttribute.
+const optFencedCodeBlockAttribute OptionName = "FencedCodeBlockAttribute"
+
+type withFencedCodeBlockAttribute struct {
+}
+
+func (o *withFencedCodeBlockAttribute) SetParserOption(c *Config) {
+	c.Options[optFencedCodeBlockAttribute] = true
+}
+
+// WithFencedCodeBlockAttribute is a functional option that enables
+// attributes at the tail of info strings of fenced code blocks like
+// '```go {#id .className}'. Attribute lists are removed from info strings.
+// This is not enabled by WithAttribute, so info strings are kept as they
+// are for existing users of WithAttribute.
+func WithFencedCodeBlockAttribute() Option {
+	return &withFencedCodeBlockAttribute{}
 }
 
 type fenceData struct {
@@ -59,6 +86,12 @@ func (b *fencedCodeBlockParser) Open(parent ast.Node, reader text.Reader, pc Con
 	}
 	pc.Set(fencedCodeBlockInfoKey, &fenceData{fenceChar, findent, oFenceLength})
 	node := ast.NewFencedCodeBlock(info)
+	if b.Attribute && info != nil { // handles info strings like 'go {#id .className}'
+		info.Segment = parseInfoAttributes(node, info.Segment, reader.Source())
+		if info.Segment.IsEmpty() {
+			node.Info = nil
+		}
+	}
 	return node, NoChildren
 
 }
@@ -96,3 +129,22 @@ func (b *fencedCodeBlockParser) CanInterruptParagraph() bool {
 func (b *fencedCodeBlockParser) CanAcceptIndentedLine() bool {
 	return false
 }
+
+// parseInfoAttributes sets attributes at the tail of the given info string
+// to the node and returns the info string without attributes.
+func parseInfoAttributes(node ast.Node, info text.Segment, source []byte) text.Segment {
+	value := info.Value(source)
+	if value[len(value)-1] != '}' {
+		return info
+	}
+	indicies := util.FindAttributeIndiciesReverse(value, true)
+	if indicies == nil {
+		return info
+	}
+	for _, index := range indicies {
+		node.SetAttribute(value[index[0]:index[1]],
+			util.UnescapePunctuations(value[index[2]:index[3]]))
+	}
+	info.Stop = info.Start + bytes.LastIndexByte(value[:indicies[0][0]], '{')
+	return info.TrimRightSpace(source)
+}
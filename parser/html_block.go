@@ -78,22 +78,32 @@ var allowedBlockTags = map[string]bool{
 var htmlBlockType1OpenRegexp = regexp.MustCompile(`(?i)^[ ]{0,3}<(script|pre|style)(?:\s.*|>.*|/>.*|)\n?$`)
 var htmlBlockType1CloseRegexp = regexp.MustCompile(`(?i)^[ ]{0,3}(?:[^ ].*|)</(?:script|pre|style)>.*`)
 
-var htmlBlockType2OpenRegexp = regexp.MustCompile(`^[ ]{0,3}<!\-\-`)
+var htmlBlockType2Open = []byte{'<', '!', '-', '-'}
 var htmlBlockType2Close = []byte{'-', '-', '>'}
 
-var htmlBlockType3OpenRegexp = regexp.MustCompile(`^[ ]{0,3}<\?`)
+var htmlBlockType3Open = []byte{'<', '?'}
 var htmlBlockType3Close = []byte{'?', '>'}
 
-var htmlBlockType4OpenRegexp = regexp.MustCompile(`^[ ]{0,3}<![A-Z]+.*\n?$`)
+var htmlBlockType4Open = []byte{'<', '!'}
 var htmlBlockType4Close = []byte{'>'}
 
-var htmlBlockType5OpenRegexp = regexp.MustCompile(`^[ ]{0,3}<\!\[CDATA\[`)
+var htmlBlockType5Open = []byte{'<', '!', '[', 'C', 'D', 'A', 'T', 'A', '['}
 var htmlBlockType5Close = []byte{']', ']', '>'}
 
 var htmlBlockType6Regexp = regexp.MustCompile(`^[ ]{0,3}</?([a-zA-Z0-9]+)(?:\s.*|>.*|/>.*|)\n?$`)
 
 var htmlBlockType7Regexp = regexp.MustCompile(`^[ ]{0,3}<(/)?([a-zA-Z0-9]+)(` + attributePattern + `*)(:?>|/>)\s*\n?$`)
 
+// trimHTMLBlockIndent returns the given line without up to 3 leading spaces.
+// Simple openers are matched without regular expressions, because
+// compiling regular expressions is expensive on platforms like WebAssembly.
+func trimHTMLBlockIndent(line []byte) []byte {
+	i := 0
+	for ; i < 3 && i < len(line) && line[i] == ' '; i++ {
+	}
+	return line[i:]
+}
+
 type htmlBlockParser struct {
 }
 
@@ -117,13 +127,13 @@ func (b *htmlBlockParser) Open(parent ast.Node, reader text.Reader, pc Context)
 	if m := htmlBlockType1OpenRegexp.FindSubmatchIndex(line); m != nil {
 		tagName = string(line[m[2]:m[3]])
 		node = ast.NewHTMLBlock(ast.HTMLBlockType1)
-	} else if htmlBlockType2OpenRegexp.Match(line) {
+	} else if opener := trimHTMLBlockIndent(line); bytes.HasPrefix(opener, htmlBlockType2Open) {
 		node = ast.NewHTMLBlock(ast.HTMLBlockType2)
-	} else if htmlBlockType3OpenRegexp.Match(line) {
+	} else if bytes.HasPrefix(opener, htmlBlockType3Open) {
 		node = ast.NewHTMLBlock(ast.HTMLBlockType3)
-	} else if htmlBlockType4OpenRegexp.Match(line) {
+	} else if bytes.HasPrefix(opener, htmlBlockType4Open) && len(opener) > 2 && opener[2] >= 'A' && opener[2] <= 'Z' {
 		node = ast.NewHTMLBlock(ast.HTMLBlockType4)
-	} else if htmlBlockType5OpenRegexp.Match(line) {
+	} else if bytes.HasPrefix(opener, htmlBlockType5Open) {
 		node = ast.NewHTMLBlock(ast.HTMLBlockType5)
 	} else if match := htmlBlockType7Regexp.FindSubmatchIndex(line); match != nil {
 		isCloseTag := match[2] > -1 && bytes.Equal(line[match[2]:match[3]], []byte("/"))
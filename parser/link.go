@@ -2,7 +2,6 @@ package parser
 
 import (
 	"fmt"
-	"regexp"
 	"strings"
 
 	"github.com/yuin/goldmark/ast"
@@ -99,22 +98,151 @@ func removeLinkLabelState(pc Context, d *linkLabelState) {
 	d.Last = nil
 }
 
-type linkParser struct {
+// ReferenceResolver is a function that resolves link references that are
+// not defined in documents, like wiki page names.
+// label is a label written in the document as it is.
+type ReferenceResolver func(label []byte) (Reference, bool)
+
+// NewReferenceMap returns a new ReferenceResolver that resolves the given
+// predefined references. Labels are matched in the same way as link
+// reference definitions.
+func NewReferenceMap(refs ...Reference) ReferenceResolver {
+	m := make(map[string]Reference, len(refs))
+	for _, ref := range refs {
+		key := util.ToLinkReference(ref.Label())
+		if _, ok := m[key]; !ok {
+			m[key] = ref
+		}
+	}
+	return func(label []byte) (Reference, bool) {
+		ref, ok := m[util.ToLinkReference(label)]
+		return ref, ok
+	}
+}
+
+// ReferenceResolver is an option name used in WithReferenceResolver.
+const optReferenceResolver OptionName = "ReferenceResolver"
+
+// WithReferenceResolver is a functional option that resolves link
+// references that are not defined in documents with the given function.
+// Definitions in documents take precedence over resolved references.
+func WithReferenceResolver(resolver ReferenceResolver) Option {
+	return WithOption(optReferenceResolver, resolver)
 }
 
-var defaultLinkParser = &linkParser{}
+// WithReferences is a functional option that allow you to use the given
+// predefined references in all documents.
+// WithReferences is a shorthand for WithReferenceResolver(NewReferenceMap(refs...)).
+func WithReferences(refs ...Reference) Option {
+	return WithReferenceResolver(NewReferenceMap(refs...))
+}
+
+// An UndefinedReference struct represents a link reference that is not
+// defined in a document and can not be resolved.
+type UndefinedReference struct {
+	// Label is a label written in the document as it is.
+	Label []byte
+
+	// Segment is a position of the label in the document.
+	Segment text.Segment
+
+	// IsImage is true if the reference is referred by an image.
+	IsImage bool
+}
+
+// An UndefinedReferenceHandler is a function that is called when a link or
+// an image refers an undefined reference.
+// The handler can set a destination, a title and attributes to the given
+// link, and returns true to keep it. The link will be converted into an
+// image if the reference is referred by an image.
+// If the handler returns false, the brackets are left as text like
+// documents that are parsed without handlers.
+type UndefinedReferenceHandler func(ref *UndefinedReference, link *ast.Link, pc Context) bool
+
+var attrNameClass = []byte("class")
+
+// BrokenLinkClass is a class name of links created by BrokenLinkHandler.
+var BrokenLinkClass = []byte("broken-link")
+
+// BrokenLinkHandler is an UndefinedReferenceHandler that creates links
+// with an empty destination and a BrokenLinkClass class.
+func BrokenLinkHandler(ref *UndefinedReference, link *ast.Link, pc Context) bool {
+	link.SetAttribute(attrNameClass, BrokenLinkClass)
+	return true
+}
+
+// UndefinedReferenceHandler is an option name used in WithUndefinedReferenceHandler.
+const optUndefinedReferenceHandler OptionName = "UndefinedReferenceHandler"
+
+// WithUndefinedReferenceHandler is a functional option that handles link
+// references that are neither defined in documents nor resolved by
+// a ReferenceResolver.
+// This is useful for reporting broken links.
+func WithUndefinedReferenceHandler(handler UndefinedReferenceHandler) Option {
+	return WithOption(optUndefinedReferenceHandler, handler)
+}
+
+type linkParser struct {
+	ReferenceResolver         ReferenceResolver
+	UndefinedReferenceHandler UndefinedReferenceHandler
+}
 
 // NewLinkParser return a new InlineParser that parses links.
 func NewLinkParser() InlineParser {
-	return defaultLinkParser
+	return &linkParser{}
+}
+
+// SetOption implements SetOptioner.
+func (s *linkParser) SetOption(name OptionName, value interface{}) {
+	switch name {
+	case optReferenceResolver:
+		s.ReferenceResolver = value.(ReferenceResolver)
+	case optUndefinedReferenceHandler:
+		s.UndefinedReferenceHandler = value.(UndefinedReferenceHandler)
+	}
+}
+
+// reference returns a reference associated with the given label.
+// Resolved references are added to the context, so they are resolved
+// only once per document.
+func (s *linkParser) reference(label []byte, pc Context) (Reference, bool) {
+	if ref, ok := pc.Reference(util.ToLinkReference(label)); ok {
+		return ref, true
+	}
+	if s.ReferenceResolver == nil {
+		return nil, false
+	}
+	ref, ok := s.ReferenceResolver(label)
+	if !ok || ref == nil {
+		return nil, false
+	}
+	ref = NewReference(label, ref.Destination(), ref.Title())
+	pc.AddReference(ref)
+	return ref, true
+}
+
+// undefinedReference returns a link created by the UndefinedReferenceHandler,
+// or nil if the reference should be left as text.
+func (s *linkParser) undefinedReference(label []byte, segment text.Segment, last *linkLabelState, pc Context) *ast.Link {
+	if s.UndefinedReferenceHandler == nil {
+		return nil
+	}
+	link := ast.NewLink()
+	ref := &UndefinedReference{
+		Label:   label,
+		Segment: segment,
+		IsImage: last.IsImage,
+	}
+	if !s.UndefinedReferenceHandler(ref, link, pc) {
+		return nil
+	}
+	return link
 }
 
 func (s *linkParser) Trigger() []byte {
 	return []byte{'!', '[', ']'}
 }
 
-var linkDestinationRegexp = regexp.MustCompile(`\s*([^\s].+)`)
-var linkTitleRegexp = regexp.MustCompile(`\s+(\)|["'\(].+)`)
 var linkBottom = NewContextKey()
 
 func (s *linkParser) Parse(parent ast.Node, block text.Reader, pc Context) ast.Node {
@@ -169,19 +297,25 @@ func (s *linkParser) Parse(parent ast.Node, block text.Reader, pc Context) ast.N
 		block.SetPosition(l, pos)
 		ssegment := text.NewSegment(last.Segment.Stop, segment.Start)
 		maybeReference := block.Value(ssegment)
-		ref, ok := pc.Reference(util.ToLinkReference(maybeReference))
-		if !ok {
+		ref, ok := s.reference(maybeReference, pc)
+		if ok {
+			link = ast.NewLink()
+			link.Title = ref.Title()
+			link.Destination = ref.Destination()
+		} else if link = s.undefinedReference(maybeReference, ssegment, last, pc); link == nil {
 			ast.MergeOrReplaceTextSegment(last.Parent(), last, last.Segment)
 			return nil
 		}
-		link = ast.NewLink()
+		link.Reference = maybeReference
 		s.processLinkLabel(parent, link, last, pc)
-		link.Title = ref.Title()
-		link.Destination = ref.Destination()
 	}
 	if last.IsImage {
 		last.Parent().RemoveChild(last.Parent(), last)
-		return ast.NewImage(link)
+		image := ast.NewImage(link)
+		for _, attr := range link.Attributes() {
+			image.SetAttribute(attr.Name, attr.Value)
+		}
+		return image
 	}
 	last.Parent().RemoveChild(last.Parent(), last)
 	return link
@@ -243,15 +377,17 @@ func (s *linkParser) parseReferenceLink(parent ast.Node, last *linkLabelState, b
 		maybeReference = block.Value(ssegment)
 	}
 
-	ref, ok := pc.Reference(util.ToLinkReference(maybeReference))
-	if !ok {
+	var link *ast.Link
+	ref, ok := s.reference(maybeReference, pc)
+	if ok {
+		link = ast.NewLink()
+		link.Title = ref.Title()
+		link.Destination = ref.Destination()
+	} else if link = s.undefinedReference(maybeReference, ssegment, last, pc); link == nil {
 		return nil, true
 	}
-
-	link := ast.NewLink()
+	link.Reference = maybeReference
 	s.processLinkLabel(parent, link, last, pc)
-	link.Title = ref.Title()
-	link.Destination = ref.Destination()
 	return link, true
 }
 
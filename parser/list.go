@@ -225,6 +225,9 @@ func (b *listParser) Close(node ast.Node, reader text.Reader, pc Context) {
 				if ok {
 					textBlock := ast.NewTextBlock()
 					textBlock.SetLines(paragraph.Lines())
+					for _, attr := range paragraph.Attributes() {
+						textBlock.SetAttribute(attr.Name, attr.Value)
+					}
 					child.ReplaceChild(child, paragraph, textBlock)
 				}
 			}
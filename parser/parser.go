@@ -63,35 +63,40 @@ type IDs interface {
 }
 
 type ids struct {
-	values map[string]bool
+	values  map[string]bool
+	slugger Slugger
+	suffix  DuplicateIDSuffix
+
+	// inherits is true if the ids use the Slugger and the DuplicateIDSuffix
+	// of the parser.
+	inherits bool
 }
 
-func newIDs() IDs {
+// NewIDs returns a new IDs that generates ids with the given Slugger and
+// makes duplicate ids unique with the given DuplicateIDSuffix.
+// nil means DefaultSlugger and NumberedIDSuffix.
+func NewIDs(slugger Slugger, suffix DuplicateIDSuffix) IDs {
+	if slugger == nil {
+		slugger = DefaultSlugger
+	}
+	if suffix == nil {
+		suffix = NumberedIDSuffix
+	}
 	return &ids{
-		values: map[string]bool{},
+		values:  map[string]bool{},
+		slugger: slugger,
+		suffix:  suffix,
 	}
 }
 
 func (s *ids) Generate(value, prefix []byte) []byte {
 	value = util.TrimLeftSpace(value)
 	value = util.TrimRightSpace(value)
-	result := []byte{}
-	for i := 0; i < len(value); {
-		v := value[i]
-		l := util.UTF8Len(v)
-		i += int(l)
-		if l != 1 {
-			continue
-		}
-		if util.IsAlphaNumeric(v) {
-			if 'A' <= v && v <= 'Z' {
-				v += 'a' - 'A'
-			}
-			result = append(result, v)
-		} else if util.IsSpace(v) {
-			result = append(result, '-')
-		}
+	slugger := s.slugger
+	if slugger == nil {
+		slugger = DefaultSlugger
 	}
+	result := slugger.Slug(value)
 	if len(result) == 0 {
 		if prefix != nil {
 			result = append(make([]byte, 0, len(prefix)), prefix...)
@@ -103,13 +108,16 @@ func (s *ids) Generate(value, prefix []byte) []byte {
 		s.values[util.BytesToReadOnlyString(result)] = true
 		return result
 	}
+	suffix := s.suffix
+	if suffix == nil {
+		suffix = NumberedIDSuffix
+	}
 	for i := 1; ; i++ {
-		newResult := fmt.Sprintf("%s%d", result, i)
-		if _, ok := s.values[newResult]; !ok {
-			s.values[newResult] = true
-			return []byte(newResult)
+		newResult := suffix(result, i)
+		if _, ok := s.values[util.BytesToReadOnlyString(newResult)]; !ok {
+			s.values[util.BytesToReadOnlyString(newResult)] = true
+			return newResult
 		}
-
 	}
 }
 
@@ -120,17 +128,49 @@ func (s *ids) Put(value []byte) {
 // ContextKey is a key that is used to set arbitrary values to the context.
 type ContextKey int
 
-// ContextKeyMax is a maximum value of the ContextKey.
-var ContextKeyMax ContextKey
+// contextKeyMax is a maximum value of the ContextKey.
+var contextKeyMax ContextKey
+
+var contextKeyMutex sync.Mutex
 
 // NewContextKey return a new ContextKey value.
+// NewContextKey is safe for concurrent use, and keys created after
+// contexts are created can be used with these contexts.
 func NewContextKey() ContextKey {
-	ContextKeyMax++
-	return ContextKeyMax
+	contextKeyMutex.Lock()
+	defer contextKeyMutex.Unlock()
+	contextKeyMax++
+	return contextKeyMax
+}
+
+func contextKeyLen() int {
+	contextKeyMutex.Lock()
+	defer contextKeyMutex.Unlock()
+	return int(contextKeyMax) + 1
+}
+
+// ContextState returns a per-parse state associated with the given key.
+// If the context does not have the state yet, ContextState creates it with
+// the given function and sets it to the context.
+//
+// A Context is created per parse, so states kept in the context are never
+// shared between concurrent Parse calls. Extensions should keep their
+// per-parse states with ContextState instead of package-level variables or
+// fields of parsers and transformers.
+func ContextState(pc Context, key ContextKey, newState func() interface{}) interface{} {
+	if v := pc.Get(key); v != nil {
+		return v
+	}
+	v := newState()
+	pc.Set(key, v)
+	return v
 }
 
 // A Context interface holds a information that are necessary to parse
 // Markdown text.
+// A new Context is created for each Parse call unless the WithContext
+// option is given, so values in a Context are not shared between
+// concurrent Parse calls.
 type Context interface {
 	// String implements Stringer.
 	String() string
@@ -148,7 +188,7 @@ type Context interface {
 	// the given label exists, otherwise (nil, false).
 	Reference(label string) (Reference, bool)
 
-	// References returns a list of references.
+	// References returns a list of references in the order they are defined.
 	References() []Reference
 
 	// IDs returns a collection of the element ids.
@@ -192,18 +232,49 @@ type parseContext struct {
 	store         []interface{}
 	ids           IDs
 	refs          map[string]Reference
+	refKeys       []string
 	blockOffset   int
 	delimiters    *Delimiter
 	lastDelimiter *Delimiter
 	openedBlocks  []Block
 }
 
+// A ContextConfig struct is a data structure that holds configuration of
+// the Context.
+type ContextConfig struct {
+	IDs IDs
+}
+
+// A ContextOption is a functional option type for the Context.
+type ContextOption func(*ContextConfig)
+
+// WithIDs is a functional option for the Context that sets an IDs used to
+// generate element ids.
+func WithIDs(ids IDs) ContextOption {
+	return func(c *ContextConfig) {
+		c.IDs = ids
+	}
+}
+
 // NewContext returns a new Context.
-func NewContext() Context {
+// If the WithIDs option is not given, the Context generates element ids
+// with the Slugger and the DuplicateIDSuffix of the parser that parses
+// documents with the Context.
+func NewContext(options ...ContextOption) Context {
+	cfg := &ContextConfig{}
+	for _, option := range options {
+		option(cfg)
+	}
+	if cfg.IDs == nil {
+		cfg.IDs = &ids{
+			values:   map[string]bool{},
+			inherits: true,
+		}
+	}
 	return &parseContext{
-		store:         make([]interface{}, ContextKeyMax+1),
+		store:         make([]interface{}, contextKeyLen()),
 		refs:          map[string]Reference{},
-		ids:           newIDs(),
+		ids:           cfg.IDs,
 		blockOffset:   0,
 		delimiters:    nil,
 		lastDelimiter: nil,
@@ -212,10 +283,18 @@ func NewContext() Context {
 }
 
 func (p *parseContext) Get(key ContextKey) interface{} {
+	if int(key) >= len(p.store) {
+		return nil
+	}
 	return p.store[key]
 }
 
 func (p *parseContext) Set(key ContextKey, value interface{}) {
+	if int(key) >= len(p.store) {
+		store := make([]interface{}, contextKeyLen())
+		copy(store, p.store)
+		p.store = store
+	}
 	p.store[key] = value
 }
 
@@ -296,6 +375,7 @@ func (p *parseContext) AddReference(ref Reference) {
 	key := util.ToLinkReference(ref.Label())
 	if _, ok := p.refs[key]; !ok {
 		p.refs[key] = ref
+		p.refKeys = append(p.refKeys, key)
 	}
 }
 
@@ -305,16 +385,16 @@ func (p *parseContext) Reference(label string) (Reference, bool) {
 }
 
 func (p *parseContext) References() []Reference {
-	ret := make([]Reference, 0, len(p.refs))
-	for _, v := range p.refs {
-		ret = append(ret, v)
+	ret := make([]Reference, 0, len(p.refKeys))
+	for _, key := range p.refKeys {
+		ret = append(ret, p.refs[key])
 	}
 	return ret
 }
 
 func (p *parseContext) String() string {
 	refs := []string{}
-	for _, r := range p.refs {
+	for _, r := range p.References() {
 		refs = append(refs, r.String())
 	}
 
@@ -408,6 +488,30 @@ type Parser interface {
 	AddOptions(...Option)
 }
 
+// A FragmentParser interface parses fragments of Markdown text like
+// titles and labels.
+type FragmentParser interface {
+	// ParseInline parses the given Markdown text as inline contents without
+	// block structures, so lines like '# title' are parsed as texts.
+	// ParseInline returns a TextBlock node whose children are parsed
+	// inline nodes. AST transformers are not applied.
+	//
+	// Link references can be resolved by passing a Context that is used to
+	// parse a document with the WithContext option.
+	ParseInline(reader text.Reader, opts ...ParseOption) ast.Node
+}
+
+// ParseInline parses the given source as inline contents with the given
+// parser. ParseInline returns nil if the parser does not implement
+// FragmentParser.
+func ParseInline(p Parser, source []byte, opts ...ParseOption) ast.Node {
+	fp, ok := p.(FragmentParser)
+	if !ok {
+		return nil
+	}
+	return fp.ParseInline(text.NewReader(source), opts...)
+}
+
 // A SetOptioner interface sets the given option to the object.
 type SetOptioner interface {
 	// SetOption sets the given option to the object.
@@ -562,6 +666,8 @@ type parser struct {
 	closeBlockers         []CloseBlocker
 	paragraphTransformers []ParagraphTransformer
 	astTransformers       []ASTTransformer
+	slugger               Slugger
+	idSuffix              DuplicateIDSuffix
 	config                *Config
 	initSync              sync.Once
 }
@@ -726,6 +832,9 @@ func (p *parser) addASTTransformer(v util.PrioritizedValue, options map[OptionNa
 // A ParseConfig struct is a data structure that holds configuration of the Parser.Parse.
 type ParseConfig struct {
 	Context Context
+
+	// Options is a set of document options set to the parsed document.
+	Options map[ast.DocumentOptionKey]interface{}
 }
 
 // A ParseOption is a functional option type for the Parser.Parse.
@@ -739,7 +848,19 @@ func WithContext(context Context) ParseOption {
 	}
 }
 
-func (p *parser) Parse(reader text.Reader, opts ...ParseOption) ast.Node {
+// WithDocumentOption is a functional option that sets the given document
+// option to the parsed document(see ast.Document.Option). Renderers can
+// read document options for rendering documents differently on each call.
+func WithDocumentOption(key ast.DocumentOptionKey, value interface{}) ParseOption {
+	return func(c *ParseConfig) {
+		if c.Options == nil {
+			c.Options = map[ast.DocumentOptionKey]interface{}{}
+		}
+		c.Options[key] = value
+	}
+}
+
+func (p *parser) init() {
 	p.initSync.Do(func() {
 		p.config.BlockParsers.Sort()
 		for _, v := range p.config.BlockParsers {
@@ -757,8 +878,14 @@ func (p *parser) Parse(reader text.Reader, opts ...ParseOption) ast.Node {
 		for _, v := range p.config.ASTTransformers {
 			p.addASTTransformer(v, p.config.Options)
 		}
+		p.slugger, _ = p.config.Options[optSlugger].(Slugger)
+		p.idSuffix, _ = p.config.Options[optDuplicateIDSuffix].(DuplicateIDSuffix)
 		p.config = nil
 	})
+}
+
+func (p *parser) Parse(reader text.Reader, opts ...ParseOption) ast.Node {
+	p.init()
 	c := &ParseConfig{}
 	for _, opt := range opts {
 		opt(c)
@@ -766,8 +893,12 @@ func (p *parser) Parse(reader text.Reader, opts ...ParseOption) ast.Node {
 	if c.Context == nil {
 		c.Context = NewContext()
 	}
+	p.inheritIDs(c.Context)
 	pc := c.Context
 	root := ast.NewDocument()
+	for key, value := range c.Options {
+		root.SetOption(key, value)
+	}
 	p.parseBlocks(root, reader, pc)
 	blockReader := text.NewBlockReader(reader.Source(), nil)
 	p.walkBlock(root, func(node ast.Node) {
@@ -780,6 +911,41 @@ func (p *parser) Parse(reader text.Reader, opts ...ParseOption) ast.Node {
 	return root
 }
 
+func (p *parser) ParseInline(reader text.Reader, opts ...ParseOption) ast.Node {
+	p.init()
+	c := &ParseConfig{}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.Context == nil {
+		c.Context = NewContext()
+	}
+	p.inheritIDs(c.Context)
+	block := ast.NewTextBlock()
+	for {
+		line, segment := reader.PeekLine()
+		if line == nil {
+			break
+		}
+		segment = segment.TrimLeftSpace(reader.Source())
+		if segment.Len() != 0 {
+			block.Lines().Append(segment)
+		}
+		reader.AdvanceLine()
+	}
+	p.parseBlock(text.NewBlockReader(reader.Source(), nil), block, c.Context)
+	return block
+}
+
+// inheritIDs sets the Slugger and the DuplicateIDSuffix of the parser to
+// the IDs of the given context if the context is created without WithIDs.
+func (p *parser) inheritIDs(pc Context) {
+	if s, ok := pc.IDs().(*ids); ok && s.inherits {
+		s.slugger = p.slugger
+		s.suffix = p.idSuffix
+	}
+}
+
 func (p *parser) transformParagraph(node *ast.Paragraph, reader text.Reader, pc Context) {
 	for _, pt := range p.paragraphTransformers {
 		pt.Transform(node, reader, pc)
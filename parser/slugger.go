@@ -0,0 +1,229 @@
+package parser
+
+import (
+	"strconv"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/yuin/goldmark/util"
+)
+
+// A Slugger interface converts texts like heading texts into element ids.
+type Slugger interface {
+	// Slug returns a slug of the given value. Slug returns an empty slice if
+	// the value has no characters that can be used in ids.
+	Slug(value []byte) []byte
+}
+
+// SluggerFunc is a function that implements Slugger.
+type SluggerFunc func(value []byte) []byte
+
+// Slug implements Slugger.Slug.
+func (f SluggerFunc) Slug(value []byte) []byte {
+	return f(value)
+}
+
+// DefaultSlugger is a default Slugger. DefaultSlugger keeps ASCII
+// alphanumerics in lower case, converts spaces into '-' and drops any other
+// characters including non-ASCII characters.
+var DefaultSlugger Slugger = SluggerFunc(defaultSlug)
+
+func defaultSlug(value []byte) []byte {
+	result := []byte{}
+	for i := 0; i < len(value); {
+		v := value[i]
+		l := util.UTF8Len(v)
+		i += int(l)
+		if l != 1 {
+			continue
+		}
+		if util.IsAlphaNumeric(v) {
+			if 'A' <= v && v <= 'Z' {
+				v += 'a' - 'A'
+			}
+			result = append(result, v)
+		} else if util.IsSpace(v) {
+			result = append(result, '-')
+		}
+	}
+	return result
+}
+
+// UnicodeSlugger is a Slugger that keeps letters and numbers of any scripts
+// in lower case. Runs of any other characters are converted into a single
+// '-'.
+// For example, 'Über uns!' becomes 'über-uns'.
+var UnicodeSlugger Slugger = SluggerFunc(unicodeSlug)
+
+func unicodeSlug(value []byte) []byte {
+	return hyphenateSlug(value, func(r rune) ([]byte, bool) {
+		if unicode.IsLetter(r) || unicode.IsNumber(r) || unicode.IsMark(r) {
+			return appendRune(nil, unicode.ToLower(r)), true
+		}
+		return nil, false
+	})
+}
+
+// GitHubSlugger is a Slugger that generates ids compatible with headings
+// on GitHub. GitHubSlugger keeps letters, numbers, '-' and '_' of any
+// scripts in lower case, converts each space into '-' and drops any other
+// characters.
+// For example, 'Hello, World!' becomes 'hello-world'.
+// GitHubSlugger should be used with HyphenatedIDSuffix.
+var GitHubSlugger Slugger = SluggerFunc(gitHubSlug)
+
+func gitHubSlug(value []byte) []byte {
+	result := []byte{}
+	for len(value) != 0 {
+		r, size := utf8.DecodeRune(value)
+		value = value[size:]
+		switch {
+		case r == ' ':
+			result = append(result, '-')
+		case r == '-' || r == '_' || unicode.IsLetter(r) || unicode.IsNumber(r) || unicode.IsMark(r):
+			result = appendRune(result, unicode.ToLower(r))
+		}
+	}
+	return result
+}
+
+// TransliterationSlugger is a Slugger that transliterates Latin letters with
+// diacritics, Greek letters and Cyrillic letters into ASCII letters.
+// Runs of any other characters are converted into a single '-'.
+// For example, 'Crème Brûlée' becomes 'creme-brulee' and 'Привет' becomes
+// 'privet'.
+var TransliterationSlugger Slugger = SluggerFunc(transliterationSlug)
+
+func transliterationSlug(value []byte) []byte {
+	return hyphenateSlug(value, func(r rune) ([]byte, bool) {
+		r = unicode.ToLower(r)
+		if r < utf8.RuneSelf {
+			if util.IsAlphaNumeric(byte(r)) {
+				return []byte{byte(r)}, true
+			}
+			return nil, false
+		}
+		if s, ok := transliterations[r]; ok {
+			return []byte(s), true
+		}
+		return nil, false
+	})
+}
+
+// hyphenateSlug converts characters of the given value with the given
+// function, and converts runs of characters rejected by the function into
+// a single '-'.
+func hyphenateSlug(value []byte, convert func(r rune) ([]byte, bool)) []byte {
+	result := []byte{}
+	hyphen := false
+	for len(value) != 0 {
+		r, size := utf8.DecodeRune(value)
+		value = value[size:]
+		v, ok := convert(r)
+		if !ok {
+			hyphen = true
+			continue
+		}
+		if len(v) == 0 {
+			continue
+		}
+		if hyphen && len(result) != 0 {
+			result = append(result, '-')
+		}
+		result = append(result, v...)
+		hyphen = false
+	}
+	return result
+}
+
+func appendRune(b []byte, r rune) []byte {
+	var buf [utf8.UTFMax]byte
+	n := utf8.EncodeRune(buf[:], r)
+	return append(b, buf[:n]...)
+}
+
+var transliterations = func() map[rune]string {
+	table := []string{
+		// Latin
+		"àáâãäåāăą", "a", "æ", "ae", "çćĉċč", "c", "ďđð", "d",
+		"èéêëēĕėęě", "e", "ĝğġģ", "g", "ĥħ", "h", "ìíîïĩīĭįı", "i",
+		"ĳ", "ij", "ĵ", "j", "ķ", "k", "ĺļľŀł", "l", "ñńņňŉ", "n",
+		"òóôõöøōŏő", "o", "œ", "oe", "ŕŗř", "r", "śŝşš", "s", "ß", "ss",
+		"ţťŧ", "t", "þ", "th", "ùúûüũūŭůűų", "u", "ŵ", "w", "ýÿŷ", "y",
+		"źżž", "z",
+		// Greek
+		"αά", "a", "β", "v", "γ", "g", "δ", "d", "εέ", "e", "ζ", "z",
+		"ηή", "i", "θ", "th", "ιίϊΐ", "i", "κ", "k", "λ", "l", "μ", "m",
+		"ν", "n", "ξ", "x", "οό", "o", "π", "p", "ρ", "r", "σς", "s",
+		"τ", "t", "υύϋΰ", "y", "φ", "f", "χ", "ch", "ψ", "ps", "ωώ", "o",
+		// Cyrillic
+		"а", "a", "б", "b", "в", "v", "г", "g", "д", "d", "е", "e",
+		"ё", "yo", "ж", "zh", "з", "z", "иі", "i", "й", "y", "к", "k",
+		"л", "l", "м", "m", "н", "n", "о", "o", "п", "p", "р", "r",
+		"с", "s", "т", "t", "у", "u", "ф", "f", "х", "kh", "ц", "ts",
+		"ч", "ch", "ш", "sh", "щ", "shch", "ъь", "", "ы", "y", "э", "e",
+		"ю", "yu", "я", "ya", "є", "ye", "ї", "yi", "ґ", "g",
+	}
+	m := map[rune]string{}
+	for i := 0; i < len(table); i += 2 {
+		for _, r := range table[i] {
+			m[r] = table[i+1]
+		}
+	}
+	return m
+}()
+
+// A DuplicateIDSuffix function returns an id for the n-th duplicate of the
+// given id. n starts from 1.
+type DuplicateIDSuffix func(id []byte, n int) []byte
+
+// NumberedIDSuffix is a default DuplicateIDSuffix that appends numbers like
+// 'id1' and 'id2'.
+func NumberedIDSuffix(id []byte, n int) []byte {
+	result := make([]byte, 0, len(id)+2)
+	result = append(result, id...)
+	return strconv.AppendInt(result, int64(n), 10)
+}
+
+// HyphenatedIDSuffix is a DuplicateIDSuffix that appends numbers with a
+// hyphen like 'id-1' and 'id-2' as GitHub does.
+func HyphenatedIDSuffix(id []byte, n int) []byte {
+	result := make([]byte, 0, len(id)+3)
+	result = append(result, id...)
+	result = append(result, '-')
+	return strconv.AppendInt(result, int64(n), 10)
+}
+
+// Slugger is an option name used in WithSlugger.
+const optSlugger OptionName = "Slugger"
+
+type withSlugger struct {
+	value Slugger
+}
+
+func (o *withSlugger) SetParserOption(c *Config) {
+	c.Options[optSlugger] = o.value
+}
+
+// WithSlugger is a functional option that sets a Slugger used to generate
+// auto heading ids.
+func WithSlugger(slugger Slugger) Option {
+	return &withSlugger{slugger}
+}
+
+// DuplicateIDSuffix is an option name used in WithDuplicateIDSuffix.
+const optDuplicateIDSuffix OptionName = "DuplicateIDSuffix"
+
+type withDuplicateIDSuffix struct {
+	value DuplicateIDSuffix
+}
+
+func (o *withDuplicateIDSuffix) SetParserOption(c *Config) {
+	c.Options[optDuplicateIDSuffix] = o.value
+}
+
+// WithDuplicateIDSuffix is a functional option that sets a function that
+// makes duplicate ids unique.
+func WithDuplicateIDSuffix(suffix DuplicateIDSuffix) Option {
+	return &withDuplicateIDSuffix{suffix}
+}
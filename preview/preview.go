@@ -0,0 +1,163 @@
+// Package preview renders Markdown for editor previews with a mapping
+// from rendered elements to source lines, so editors can implement
+// click-to-source and scroll synchronization.
+package preview
+
+import (
+	"bytes"
+	"strconv"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+)
+
+// A Block struct represents a rendered block element.
+type Block struct {
+	// ID is an id attribute of the element.
+	ID string
+
+	// Kind is a kind of the block like "Paragraph".
+	Kind string
+
+	// Start is a 1-based line number where the block starts.
+	Start int
+
+	// Stop is a 1-based line number where the block ends(inclusive).
+	Stop int
+
+	// Depth is a depth of the block. Top level blocks have a depth 1.
+	Depth int
+}
+
+// A Result struct is a result of Render.
+type Result struct {
+	// HTML is a rendered HTML.
+	HTML []byte
+
+	// Blocks is a list of rendered blocks in document order.
+	// Parents come before their children.
+	Blocks []Block
+}
+
+// BlockAt returns the innermost block that contains the given 1-based
+// line number, or nil if no blocks contain the line.
+func (r *Result) BlockAt(line int) *Block {
+	var found *Block
+	for i := range r.Blocks {
+		b := &r.Blocks[i]
+		if b.Start <= line && line <= b.Stop && (found == nil || b.Depth > found.Depth) {
+			found = b
+		}
+	}
+	return found
+}
+
+// DefaultIDPrefix is a default prefix of ids set to blocks.
+const DefaultIDPrefix = "source-block-"
+
+// A Config struct has configurations for the Renderer.
+type Config struct {
+	// IDPrefix is a prefix of ids set to blocks that do not have ids.
+	IDPrefix string
+}
+
+// An Option interface sets options for the Renderer.
+type Option interface {
+	SetPreviewOption(*Config)
+}
+
+type withIDPrefix struct {
+	value string
+}
+
+func (o *withIDPrefix) SetPreviewOption(c *Config) {
+	c.IDPrefix = o.value
+}
+
+// WithIDPrefix is a functional option that sets a prefix of ids set to
+// blocks.
+func WithIDPrefix(prefix string) Option {
+	return &withIDPrefix{prefix}
+}
+
+// A Renderer struct renders Markdown for editor previews.
+type Renderer struct {
+	Config
+	markdown goldmark.Markdown
+}
+
+// New returns a new Renderer that renders Markdown with the given Markdown
+// object. If markdown is nil, goldmark.New() is used.
+func New(markdown goldmark.Markdown, opts ...Option) *Renderer {
+	if markdown == nil {
+		markdown = goldmark.New()
+	}
+	r := &Renderer{
+		Config: Config{
+			IDPrefix: DefaultIDPrefix,
+		},
+		markdown: markdown,
+	}
+	for _, opt := range opts {
+		opt.SetPreviewOption(&r.Config)
+	}
+	return r
+}
+
+var attrNameID = []byte("id")
+
+// Render renders the given source and returns a rendered HTML with blocks
+// in it. Blocks that do not have ids are rendered with ids like
+// "source-block-1". Blocks that are not rendered as elements with
+// attributes like raw HTML blocks and thematic breaks are not included
+// in the result.
+func (r *Renderer) Render(source []byte, opts ...parser.ParseOption) (*Result, error) {
+	doc := r.markdown.Parser().Parse(text.NewReader(source), opts...)
+	index := text.NewLineIndex(source)
+	var blocks []Block
+	depth := 0
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if n.Type() != ast.TypeBlock || n.Kind() == ast.KindTextBlock || n.Kind() == ast.KindHTMLBlock {
+			return ast.WalkContinue, nil
+		}
+		if !entering {
+			depth--
+			return ast.WalkContinue, nil
+		}
+		depth++
+		start, stop, ok := html.BlockRange(n, source)
+		if !ok {
+			return ast.WalkContinue, nil
+		}
+		id, ok := n.AttributeString("id")
+		if !ok {
+			id = []byte(r.IDPrefix + strconv.Itoa(len(blocks)+1))
+			n.SetAttribute(attrNameID, id)
+		}
+		startLine, _ := index.Position(start)
+		stopLine, _ := index.Position(stop - 1)
+		blocks = append(blocks, Block{
+			ID:    string(id),
+			Kind:  n.Kind().String(),
+			Start: startLine,
+			Stop:  stopLine,
+			Depth: depth,
+		})
+		return ast.WalkContinue, nil
+	})
+	var buf bytes.Buffer
+	if err := r.markdown.Renderer().Render(&buf, source, doc); err != nil {
+		return nil, err
+	}
+	result := &Result{HTML: buf.Bytes()}
+	// renderers of some extensions do not render attributes
+	for _, b := range blocks {
+		if bytes.Contains(result.HTML, []byte(` id="`+b.ID+`"`)) {
+			result.Blocks = append(result.Blocks, b)
+		}
+	}
+	return result, nil
+}
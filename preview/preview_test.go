@@ -0,0 +1,61 @@
+package preview
+
+import (
+	"testing"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/parser"
+)
+
+func TestRender(t *testing.T) {
+	r := New(goldmark.New(goldmark.WithParserOptions(parser.WithAutoHeadingID())))
+	source := []byte(`# Title
+
+- item1
+- item2
+  continued
+
+---
+
+` + "```" + `
+code
+` + "```\n")
+	result, err := r.Render(source)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `<h1 id="title">Title</h1>
+<ul id="source-block-2">
+<li id="source-block-3">item1</li>
+<li id="source-block-4">item2
+continued</li>
+</ul>
+<hr>
+<pre id="source-block-5"><code>code
+</code></pre>
+`
+	if string(result.HTML) != expected {
+		t.Errorf("unexpected HTML:\n%s", result.HTML)
+	}
+	blocks := []Block{
+		{"title", "Heading", 1, 1, 1},
+		{"source-block-2", "List", 3, 5, 1},
+		{"source-block-3", "ListItem", 3, 3, 2},
+		{"source-block-4", "ListItem", 4, 5, 2},
+		{"source-block-5", "FencedCodeBlock", 10, 10, 1},
+	}
+	if len(result.Blocks) != len(blocks) {
+		t.Fatalf("expected %v, but got %v", blocks, result.Blocks)
+	}
+	for i, b := range blocks {
+		if result.Blocks[i] != b {
+			t.Errorf("%d: expected %v, but got %v", i, b, result.Blocks[i])
+		}
+	}
+	if b := result.BlockAt(5); b == nil || b.ID != "source-block-4" {
+		t.Errorf("unexpected block: %v", b)
+	}
+	if b := result.BlockAt(7); b != nil {
+		t.Errorf("unexpected block: %v", b)
+	}
+}
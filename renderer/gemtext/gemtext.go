@@ -0,0 +1,286 @@
+// Package gemtext implements renderer that outputs Gemini's gemtext.
+package gemtext
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/yuin/goldmark/ast"
+	east "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/util"
+)
+
+// gemtext has only a few line types. Quotes are not applied to
+// link lines and preformatted lines.
+type lineType int
+
+const (
+	lineText lineType = iota
+	lineLink
+	linePre
+)
+
+type line struct {
+	typ   lineType
+	value string
+}
+
+type link struct {
+	url   []byte
+	label string
+}
+
+type gemtextRenderer struct {
+}
+
+// NewRenderer returns a new renderer.Renderer that renders documents as
+// gemtext(text/gemini). It can be used with goldmark.WithRenderer.
+//
+// Inline formatting like emphasis is flattened into plain texts. Links
+// and images in a block are listed as link lines like '=> url label' after
+// the block, and blocks that consist of links only are replaced by link
+// lines. Raw HTML is omitted.
+func NewRenderer() renderer.Renderer {
+	return &gemtextRenderer{}
+}
+
+// AddOptions implements renderer.Renderer.
+// Options for HTML based renderers are ignored.
+func (r *gemtextRenderer) AddOptions(opts ...renderer.Option) {
+}
+
+// Render implements renderer.Renderer.
+func (r *gemtextRenderer) Render(w io.Writer, source []byte, n ast.Node) error {
+	bw := bufio.NewWriter(w)
+	for _, l := range renderBlock(n, source) {
+		_, _ = bw.WriteString(l.value)
+		_ = bw.WriteByte('\n')
+	}
+	return bw.Flush()
+}
+
+func renderBlock(n ast.Node, source []byte) []line {
+	switch v := n.(type) {
+	case *ast.Document:
+		return renderBlocks(n, source, true)
+	case *ast.Heading:
+		level := v.Level
+		if level > 3 {
+			level = 3
+		}
+		text, links := renderInlines(n, source)
+		lines := []line{{lineText, strings.Repeat("#", level) + " " + strings.Replace(text, "\n", " ", -1)}}
+		return append(lines, linkLines(links)...)
+	case *ast.Paragraph, *ast.TextBlock:
+		return renderParagraph(n, source)
+	case *ast.Blockquote:
+		var lines []line
+		for _, l := range renderBlocks(n, source, true) {
+			if l.typ == lineText {
+				l.value = strings.TrimRight("> "+l.value, " ")
+			}
+			lines = append(lines, l)
+		}
+		return lines
+	case *ast.List:
+		var lines []line
+		i := v.Start
+		for c := n.FirstChild(); c != nil; c = c.NextSibling() {
+			marker := "* "
+			if v.IsOrdered() {
+				marker = "* " + strconv.Itoa(i) + ". "
+				i++
+			}
+			lines = append(lines, renderListItem(c, source, marker)...)
+		}
+		return lines
+	case *ast.CodeBlock, *ast.FencedCodeBlock:
+		alt := ""
+		if fcb, ok := n.(*ast.FencedCodeBlock); ok {
+			alt = string(fcb.Language(source))
+		}
+		lines := []line{{linePre, "```" + alt}}
+		for i := 0; i < n.Lines().Len(); i++ {
+			l := n.Lines().At(i)
+			lines = append(lines, line{linePre, strings.TrimRight(string(l.Value(source)), "\r\n")})
+		}
+		return append(lines, line{linePre, "```"})
+	case *ast.ThemanticBreak:
+		return []line{{lineText, "---"}}
+	case *ast.HTMLBlock:
+		return nil
+	case *east.Table:
+		return renderTable(n, source)
+	}
+	if fc := n.FirstChild(); fc != nil && fc.Type() == ast.TypeInline {
+		return renderParagraph(n, source)
+	}
+	return renderBlocks(n, source, true)
+}
+
+// renderBlocks renders children of the given node. If separate is true,
+// blocks are separated by blank lines.
+func renderBlocks(n ast.Node, source []byte, separate bool) []line {
+	var lines []line
+	for c := n.FirstChild(); c != nil; c = c.NextSibling() {
+		b := renderBlock(c, source)
+		if len(b) == 0 {
+			continue
+		}
+		if separate && len(lines) != 0 {
+			lines = append(lines, line{lineText, ""})
+		}
+		lines = append(lines, b...)
+	}
+	return lines
+}
+
+// renderListItem renders a list item. Gemtext does not have nested lists,
+// so nested lists are flattened.
+func renderListItem(n ast.Node, source []byte, marker string) []line {
+	lines := renderBlocks(n, source, false)
+	if len(lines) == 0 || lines[0].typ != lineText {
+		return append([]line{{lineText, strings.TrimRight(marker, " ")}}, lines...)
+	}
+	lines[0].value = marker + lines[0].value
+	return lines
+}
+
+func renderParagraph(n ast.Node, source []byte) []line {
+	text, links := renderInlines(n, source)
+	if isLinksOnly(n, source) {
+		return linkLines(links)
+	}
+	var lines []line
+	for _, s := range strings.Split(text, "\n") {
+		lines = append(lines, line{lineText, s})
+	}
+	return append(lines, linkLines(links)...)
+}
+
+func renderTable(n ast.Node, source []byte) []line {
+	lines := []line{{linePre, "```"}}
+	var links []link
+	for row := n.FirstChild(); row != nil; row = row.NextSibling() {
+		var cells []string
+		for cell := row.FirstChild(); cell != nil; cell = cell.NextSibling() {
+			text, l := renderInlines(cell, source)
+			cells = append(cells, strings.Replace(text, "\n", " ", -1))
+			links = append(links, l...)
+		}
+		lines = append(lines, line{linePre, strings.Join(cells, " | ")})
+	}
+	lines = append(lines, line{linePre, "```"})
+	return append(lines, linkLines(links)...)
+}
+
+func linkLines(links []link) []line {
+	lines := make([]line, 0, len(links))
+	for _, l := range links {
+		value := "=> " + string(l.url)
+		if len(l.label) != 0 && l.label != string(l.url) {
+			value += " " + l.label
+		}
+		lines = append(lines, line{lineLink, value})
+	}
+	return lines
+}
+
+// isLinksOnly returns true if the given block consists of links, images
+// and spaces only.
+func isLinksOnly(n ast.Node, source []byte) bool {
+	found := false
+	for c := n.FirstChild(); c != nil; c = c.NextSibling() {
+		switch v := c.(type) {
+		case *ast.Link, *ast.Image, *ast.AutoLink:
+			found = true
+		case *ast.Text:
+			if !util.IsBlank(v.Segment.Value(source)) {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return found
+}
+
+func renderInlines(n ast.Node, source []byte) (string, []link) {
+	var buf bytes.Buffer
+	var links []link
+	writeInlines(&buf, &links, n, source)
+	lines := strings.Split(buf.String(), "\n")
+	for i, l := range lines {
+		lines[i] = strings.TrimSpace(l)
+	}
+	return strings.Join(lines, "\n"), links
+}
+
+func writeInlines(buf *bytes.Buffer, links *[]link, n ast.Node, source []byte) {
+	for c := n.FirstChild(); c != nil; c = c.NextSibling() {
+		switch v := c.(type) {
+		case *ast.Text:
+			if v.IsRaw() {
+				_, _ = buf.Write(v.Segment.Value(source))
+			} else {
+				_, _ = buf.Write(unescape(v.Segment.Value(source)))
+			}
+			if v.HardLineBreak() {
+				_ = buf.WriteByte('\n')
+			} else if v.SoftLineBreak() {
+				_ = buf.WriteByte(' ')
+			}
+		case *ast.String:
+			if v.IsRaw() || v.IsCode() {
+				_, _ = buf.Write(v.Value)
+			} else {
+				_, _ = buf.Write(unescape(v.Value))
+			}
+		case *ast.CodeSpan:
+			for t := c.FirstChild(); t != nil; t = t.NextSibling() {
+				if text, ok := t.(*ast.Text); ok {
+					_, _ = buf.Write(bytes.Replace(text.Segment.Value(source), []byte{'\n'}, []byte{' '}, -1))
+				}
+			}
+		case *ast.RawHTML:
+			// omitted
+		case *ast.Link:
+			var label bytes.Buffer
+			writeInlines(&label, links, c, source)
+			_, _ = buf.Write(label.Bytes())
+			*links = append(*links, link{url(v.Destination), flatten(label.String())})
+		case *ast.Image:
+			// alternative texts are written in link lines only
+			var label bytes.Buffer
+			var ignored []link
+			writeInlines(&label, &ignored, c, source)
+			*links = append(*links, link{url(v.Destination), flatten(label.String())})
+		case *ast.AutoLink:
+			u := v.URL(source)
+			if v.AutoLinkType == ast.AutoLinkEmail && !bytes.HasPrefix(bytes.ToLower(u), []byte("mailto:")) {
+				u = append([]byte("mailto:"), u...)
+			}
+			label := string(v.Label(source))
+			_, _ = buf.WriteString(label)
+			*links = append(*links, link{util.URLEscape(u, false), label})
+		default:
+			writeInlines(buf, links, c, source)
+		}
+	}
+}
+
+func url(destination []byte) []byte {
+	return util.URLEscape(destination, true)
+}
+
+func flatten(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+func unescape(v []byte) []byte {
+	return util.ResolveEntityNames(util.ResolveNumericReferences(util.UnescapePunctuations(v)))
+}
@@ -0,0 +1,52 @@
+package gemtext_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/renderer/gemtext"
+)
+
+func TestRender(t *testing.T) {
+	markdown := goldmark.New(goldmark.WithRenderer(gemtext.NewRenderer()))
+	source := []byte(`#### Hi *there*
+
+See [the *docs*](/docs "Docs") and ` + "`code`" + `,
+or <https://example.com>.
+
+[Home](/) ![logo](/logo.png)
+
+> quoted  
+> text
+
+- one
+- two
+  1. three
+
+` + "```go\nfunc main() {}\n```\n")
+	var b bytes.Buffer
+	if err := markdown.Convert(source, &b); err != nil {
+		t.Fatal(err)
+	}
+	expected := `### Hi there
+
+See the docs and code, or https://example.com.
+=> /docs the docs
+=> https://example.com
+
+=> / Home
+=> /logo.png logo
+
+> quoted
+> text
+
+* one
+* two
+* 1. three
+
+` + "```go\nfunc main() {}\n```\n"
+	if b.String() != expected {
+		t.Errorf("unexpected output:\n%s", b.String())
+	}
+}
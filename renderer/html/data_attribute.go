@@ -0,0 +1,109 @@
+package html
+
+import (
+	"bytes"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/util"
+)
+
+var dataAttributePrefix = []byte("data-")
+
+// DataAttributePolicy is a function that decides whether the given
+// 'data-*' attribute should be rendered.
+type DataAttributePolicy func(name []byte) bool
+
+// AllowDataAttributes is a DataAttributePolicy that renders all 'data-*'
+// attributes.
+func AllowDataAttributes(name []byte) bool {
+	return true
+}
+
+// DenyDataAttributes is a DataAttributePolicy that renders no 'data-*'
+// attributes.
+func DenyDataAttributes(name []byte) bool {
+	return false
+}
+
+// AllowDataAttributePrefixes returns a new DataAttributePolicy that renders
+// 'data-*' attributes whose names start with one of the given prefixes
+// like 'data-analytics-'.
+func AllowDataAttributePrefixes(prefixes ...string) DataAttributePolicy {
+	return func(name []byte) bool {
+		for _, prefix := range prefixes {
+			if bytes.HasPrefix(name, util.StringToReadOnlyBytes(prefix)) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// DataAttributePolicy is an option name used in WithDataAttributePolicy.
+const optDataAttributePolicy renderer.OptionName = "DataAttributePolicy"
+
+type withDataAttributePolicy struct {
+	value DataAttributePolicy
+}
+
+func (o *withDataAttributePolicy) SetConfig(c *renderer.Config) {
+	c.Options[optDataAttributePolicy] = o.value
+}
+
+func (o *withDataAttributePolicy) SetHTMLOption(c *Config) {
+	c.DataAttributePolicy = o.value
+}
+
+// WithDataAttributePolicy is a functional option that decides which
+// 'data-*' attributes set by attribute lists are rendered.
+// Other attributes are not affected by the policy.
+// By default, all 'data-*' attributes are rendered.
+func WithDataAttributePolicy(policy DataAttributePolicy) interface {
+	renderer.Option
+	Option
+} {
+	return &withDataAttributePolicy{policy}
+}
+
+// AllowsAttribute returns true if the attribute associated with the given
+// name should be rendered.
+func (c *Config) AllowsAttribute(name []byte) bool {
+	if c.DataAttributePolicy == nil || !bytes.HasPrefix(name, dataAttributePrefix) {
+		return true
+	}
+	return c.DataAttributePolicy(name)
+}
+
+// Attributes returns attributes of the given node that should be rendered
+// to the given writer. If SourcePos is true, a position of the node in the
+// source is added. If Deterministic is true, attributes are sorted by their
+// names. The node is not modified.
+func (c *Config) Attributes(w util.BufWriter, node ast.Node) []ast.Attribute {
+	attrs := node.Attributes()
+	if c.SourcePos {
+		if pos := sourcePosition(w, node); pos != nil {
+			attrs = append(attrs[:len(attrs):len(attrs)], ast.Attribute{Name: attrNameSourcePos, Value: pos})
+		}
+	}
+	if c.Deterministic {
+		return renderer.SortedAttributes(attrs)
+	}
+	return attrs
+}
+
+// RenderAttributes renders given node's attributes that are allowed by
+// this config.
+// This method is useful for NodeRenderers in extensions that embed Config.
+func (c *Config) RenderAttributes(w util.BufWriter, node ast.Node) {
+	for _, attr := range c.Attributes(w, node) {
+		if !c.AllowsAttribute(attr.Name) {
+			continue
+		}
+		_, _ = w.WriteString(" ")
+		_, _ = w.Write(attr.Name)
+		_, _ = w.WriteString(`="`)
+		_, _ = w.Write(util.EscapeHTML(attr.Value))
+		_ = w.WriteByte('"')
+	}
+}
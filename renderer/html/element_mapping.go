@@ -0,0 +1,104 @@
+package html
+
+import (
+	"sort"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/util"
+)
+
+// An ElementMapping struct maps a node kind to a custom element like
+// '<fancy-quote>'.
+type ElementMapping struct {
+	// Tag is a name of the element.
+	Tag string
+
+	// Attributes is a set of fixed attributes of the element.
+	// Attributes of nodes take precedence over these attributes.
+	// An empty value means a boolean attribute.
+	Attributes map[string]string
+}
+
+// ElementMappings is an option name used in WithElementMapping.
+const optElementMappings renderer.OptionName = "ElementMappings"
+
+type withElementMapping struct {
+	kind  ast.NodeKind
+	value ElementMapping
+}
+
+func (o *withElementMapping) SetConfig(c *renderer.Config) {
+	mappings, _ := c.Options[optElementMappings].(map[ast.NodeKind]ElementMapping)
+	c.Options[optElementMappings] = addElementMapping(mappings, o.kind, o.value)
+}
+
+func (o *withElementMapping) SetHTMLOption(c *Config) {
+	c.ElementMappings = addElementMapping(c.ElementMappings, o.kind, o.value)
+}
+
+func addElementMapping(mappings map[ast.NodeKind]ElementMapping, kind ast.NodeKind, value ElementMapping) map[ast.NodeKind]ElementMapping {
+	m := make(map[ast.NodeKind]ElementMapping, len(mappings)+1)
+	for k, v := range mappings {
+		m[k] = v
+	}
+	m[kind] = value
+	return m
+}
+
+// WithElementMapping is a functional option that renders nodes of the given
+// kind as the given element with the given fixed attributes.
+// This is useful for design systems built on web components.
+//
+// Mapped nodes are rendered as an element that wraps their children, so
+// kinds that hold their contents in properties(i.e. links, images and
+// code blocks) can not be mapped. Kinds rendered by extensions take
+// precedence over mappings.
+func WithElementMapping(kind ast.NodeKind, tag string, attributes map[string]string) interface {
+	renderer.Option
+	Option
+} {
+	return &withElementMapping{kind, ElementMapping{Tag: tag, Attributes: attributes}}
+}
+
+func (r *Renderer) renderMappedElement(w util.BufWriter, source []byte, n ast.Node, entering bool) (ast.WalkStatus, error) {
+	mapping := r.ElementMappings[n.Kind()]
+	if !entering {
+		_, _ = w.WriteString("</")
+		_, _ = w.WriteString(mapping.Tag)
+		_ = w.WriteByte('>')
+		if n.Type() == ast.TypeBlock {
+			_ = w.WriteByte('\n')
+		}
+		return ast.WalkContinue, nil
+	}
+	_ = w.WriteByte('<')
+	_, _ = w.WriteString(mapping.Tag)
+	if n.Attributes() != nil || r.SourcePos {
+		r.RenderAttributes(w, n)
+	}
+	names := make([]string, 0, len(mapping.Attributes))
+	for name := range mapping.Attributes {
+		if _, ok := n.AttributeString(name); !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		_ = w.WriteByte(' ')
+		_, _ = w.WriteString(name)
+		if value := mapping.Attributes[name]; len(value) != 0 || r.XHTML {
+			if len(value) == 0 {
+				value = name
+			}
+			_, _ = w.WriteString(`="`)
+			_, _ = w.Write(util.EscapeHTML([]byte(value)))
+			_ = w.WriteByte('"')
+		}
+	}
+	_ = w.WriteByte('>')
+	if fc := n.FirstChild(); fc != nil && fc.Type() == ast.TypeBlock && fc.Kind() != ast.KindTextBlock {
+		_ = w.WriteByte('\n')
+	}
+	return ast.WalkContinue, nil
+}
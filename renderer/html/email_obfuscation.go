@@ -0,0 +1,98 @@
+package html
+
+import (
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/util"
+)
+
+// An EmailObfuscation is a way to obfuscate email addresses of autolinks
+// against scrapers.
+type EmailObfuscation int
+
+const (
+	// EmailObfuscationNone renders email addresses as they are.
+	EmailObfuscationNone EmailObfuscation = iota
+
+	// EmailObfuscationEntities renders email addresses as numeric
+	// character references like '&#105;&#110;'.
+	EmailObfuscationEntities
+
+	// EmailObfuscationReverse renders link texts of email addresses in
+	// reverse order and reverses them again with CSS, so that no
+	// JavaScript is needed. Link destinations are rendered as numeric
+	// character references.
+	EmailObfuscationReverse
+)
+
+// EmailObfuscation is an option name used in WithEmailObfuscation.
+const optEmailObfuscation renderer.OptionName = "EmailObfuscation"
+
+type withEmailObfuscation struct {
+	value EmailObfuscation
+}
+
+func (o *withEmailObfuscation) SetConfig(c *renderer.Config) {
+	c.Options[optEmailObfuscation] = o.value
+}
+
+func (o *withEmailObfuscation) SetHTMLOption(c *Config) {
+	c.EmailObfuscation = o.value
+}
+
+// WithEmailObfuscation is a functional option that obfuscates email
+// addresses of autolinks like '<info@example.com>'.
+func WithEmailObfuscation(v EmailObfuscation) interface {
+	renderer.Option
+	Option
+} {
+	return &withEmailObfuscation{v}
+}
+
+// emailObfuscationOption is a document option key used in
+// WithDocumentEmailObfuscation.
+var emailObfuscationOption = ast.NewDocumentOptionKey()
+
+// WithDocumentEmailObfuscation is a functional option for Parse and Convert
+// that overrides the WithEmailObfuscation option for the parsed document.
+func WithDocumentEmailObfuscation(v EmailObfuscation) parser.ParseOption {
+	return parser.WithDocumentOption(emailObfuscationOption, v)
+}
+
+// emailObfuscation returns an EmailObfuscation for the document that
+// contains the given node.
+func (r *Renderer) emailObfuscation(w util.BufWriter, n ast.Node) EmailObfuscation {
+	if doc := document(w, n); doc != nil {
+		if v, ok := doc.Option(emailObfuscationOption).(EmailObfuscation); ok {
+			return v
+		}
+	}
+	return r.EmailObfuscation
+}
+
+// writeCharacterReferences writes the given value as numeric character
+// references.
+func writeCharacterReferences(w util.BufWriter, value []byte) {
+	for len(value) != 0 {
+		c, size := utf8.DecodeRune(value)
+		value = value[size:]
+		_, _ = w.WriteString("&#")
+		_, _ = w.WriteString(strconv.Itoa(int(c)))
+		_ = w.WriteByte(';')
+	}
+}
+
+// reverseRunes returns a copy of the given value in reverse order.
+func reverseRunes(value []byte) []byte {
+	result := make([]byte, 0, len(value))
+	for i := len(value); i > 0; {
+		_, size := utf8.DecodeLastRune(value[:i])
+		result = append(result, value[i-size:i]...)
+		i -= size
+	}
+	return result
+}
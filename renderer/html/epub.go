@@ -0,0 +1,169 @@
+package html
+
+import (
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/util"
+)
+
+// EPUB is an option name used in WithEPUB.
+const optEPUB renderer.OptionName = "EPUB"
+
+type withEPUB struct {
+}
+
+func (o *withEPUB) SetConfig(c *renderer.Config) {
+	c.Options[optEPUB] = true
+	c.Options[optXHTML] = true
+}
+
+func (o *withEPUB) SetHTMLOption(c *Config) {
+	c.EPUB = true
+	c.XHTML = true
+}
+
+// WithEPUB is a functional option that renders well-formed XHTML 1.1
+// that can be included in EPUB content documents directly.
+// This option implies WithXHTML. Character references like '&ldquo;' are
+// rendered as numeric references like '&#8220;', void elements in raw HTML
+// are closed like '<br />', and deprecated attributes like 'align' of
+// table cells are rendered as styles.
+// Raw HTML is still omitted unless WithUnsafe or WithRawHTMLRewriter is
+// set, and it is not checked whether raw HTML is well-formed.
+func WithEPUB() interface {
+	renderer.Option
+	Option
+} {
+	return &withEPUB{}
+}
+
+var voidElements = map[string]bool{
+	"area":   true,
+	"base":   true,
+	"br":     true,
+	"col":    true,
+	"embed":  true,
+	"hr":     true,
+	"img":    true,
+	"input":  true,
+	"link":   true,
+	"meta":   true,
+	"param":  true,
+	"source": true,
+	"track":  true,
+	"wbr":    true,
+}
+
+// rewriteXHTMLTag rewrites the given raw HTML tag as a well-formed XHTML tag.
+// rewriteXHTMLTag returns false if the tag should be removed.
+func rewriteXHTMLTag(tag *HTMLTag) bool {
+	if voidElements[string(tag.Name)] {
+		if tag.IsEnd {
+			return false
+		}
+		tag.IsSelfClosing = true
+	}
+	for i, attr := range tag.Attributes {
+		if attr.Value == nil {
+			tag.Attributes[i].Value = attr.Name
+		}
+	}
+	return true
+}
+
+// predefinedEntities is a set of character references defined in XML.
+var predefinedEntities = map[string]bool{
+	"amp":  true,
+	"lt":   true,
+	"gt":   true,
+	"quot": true,
+	"apos": true,
+}
+
+// maxEntityNameLength is a maximum length of character reference names.
+const maxEntityNameLength = 32
+
+// A numericReferenceWriter is a util.BufWriter that rewrites character
+// references like '&ldquo;' into numeric references like '&#8220;'.
+type numericReferenceWriter struct {
+	util.BufWriter
+	pending []byte
+}
+
+func newNumericReferenceWriter(w util.BufWriter) *numericReferenceWriter {
+	return &numericReferenceWriter{BufWriter: w}
+}
+
+func (w *numericReferenceWriter) flushPending() {
+	if len(w.pending) != 0 {
+		_, _ = w.BufWriter.Write(w.pending)
+		w.pending = w.pending[:0]
+	}
+}
+
+func (w *numericReferenceWriter) writeByte(c byte) {
+	if len(w.pending) != 0 {
+		switch {
+		case c == ';':
+			name := string(w.pending[1:])
+			entity, ok := util.LookUpHTML5EntityByName(name)
+			if ok && !predefinedEntities[name] {
+				for _, cp := range entity.CodePoints {
+					_, _ = w.BufWriter.WriteString("&#")
+					_, _ = w.BufWriter.WriteString(strconv.Itoa(cp))
+					_ = w.BufWriter.WriteByte(';')
+				}
+				w.pending = w.pending[:0]
+				return
+			}
+			w.flushPending()
+			_ = w.BufWriter.WriteByte(c)
+			return
+		case util.IsAlphaNumeric(c) && len(w.pending) <= maxEntityNameLength:
+			w.pending = append(w.pending, c)
+			return
+		}
+		w.flushPending()
+	}
+	if c == '&' {
+		w.pending = append(w.pending, c)
+		return
+	}
+	_ = w.BufWriter.WriteByte(c)
+}
+
+func (w *numericReferenceWriter) Write(p []byte) (int, error) {
+	for _, c := range p {
+		w.writeByte(c)
+	}
+	return len(p), nil
+}
+
+func (w *numericReferenceWriter) WriteString(s string) (int, error) {
+	for i := 0; i < len(s); i++ {
+		w.writeByte(s[i])
+	}
+	return len(s), nil
+}
+
+func (w *numericReferenceWriter) WriteByte(c byte) error {
+	w.writeByte(c)
+	return nil
+}
+
+func (w *numericReferenceWriter) WriteRune(r rune) (int, error) {
+	buf := make([]byte, utf8.UTFMax)
+	n := utf8.EncodeRune(buf, r)
+	return w.Write(buf[:n])
+}
+
+func (w *numericReferenceWriter) Buffered() int {
+	return w.BufWriter.Buffered() + len(w.pending)
+}
+
+func (w *numericReferenceWriter) Flush() error {
+	w.flushPending()
+	return w.BufWriter.Flush()
+}
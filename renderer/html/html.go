@@ -1,30 +1,77 @@
 package html
 
 import (
+	"bufio"
 	"bytes"
+	"errors"
 	"fmt"
 	"strconv"
 
 	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
 	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/text"
 	"github.com/yuin/goldmark/util"
 )
 
 // A Config struct has configurations for the HTML based renderers.
 type Config struct {
-	Writer    Writer
-	HardWraps bool
-	XHTML     bool
-	Unsafe    bool
+	Writer              Writer
+	HardWraps           bool
+	XHTML               bool
+	Unsafe              bool
+	CodeRenderer        CodeRenderFunc
+	DiagnosticHandler   DiagnosticHandler
+	RawHTMLRewriter     RawHTMLRewriter
+	Accessibility       bool
+	DataAttributePolicy DataAttributePolicy
+	ElementMappings     map[ast.NodeKind]ElementMapping
+	MissingAltText      MissingAltText
+	UnwrapParagraph     bool
+	SourcePos           bool
+	Indent              string
+	EPUB                bool
+	TagFilter           bool
+	ImageResolver       ImageResolver
+	LazyImages          bool
+	AsyncImageDecoding  bool
+	EagerImage          EagerImageFunc
+	EmailObfuscation    EmailObfuscation
+	Deterministic       bool
 }
 
 // NewConfig returns a new Config with defaults.
 func NewConfig() Config {
 	return Config{
-		Writer:    DefaultWriter,
-		HardWraps: false,
-		XHTML:     false,
-		Unsafe:    false,
+		Writer:              DefaultWriter,
+		HardWraps:           false,
+		XHTML:               false,
+		Unsafe:              false,
+		CodeRenderer:        nil,
+		DiagnosticHandler:   nil,
+		RawHTMLRewriter:     nil,
+		Accessibility:       false,
+		DataAttributePolicy: nil,
+		ElementMappings:     nil,
+		MissingAltText:      MissingAltTextEmpty,
+		UnwrapParagraph:     false,
+		SourcePos:           false,
+		Indent:              "",
+		EPUB:                false,
+		TagFilter:           false,
+		ImageResolver:       nil,
+		LazyImages:          false,
+		AsyncImageDecoding:  false,
+		EagerImage:          nil,
+		EmailObfuscation:    EmailObfuscationNone,
+	}
+}
+
+// ReportDiagnostic reports the given diagnostic to the DiagnosticHandler.
+// ReportDiagnostic does nothing if no handlers are set.
+func (c *Config) ReportDiagnostic(node ast.Node, err error) {
+	if c.DiagnosticHandler != nil {
+		c.DiagnosticHandler(Diagnostic{Node: node, Err: err})
 	}
 }
 
@@ -39,6 +86,42 @@ func (c *Config) SetOption(name renderer.OptionName, value interface{}) {
 		c.Unsafe = value.(bool)
 	case optTextWriter:
 		c.Writer = value.(Writer)
+	case optCodeRenderer:
+		c.CodeRenderer = value.(CodeRenderFunc)
+	case optDiagnosticHandler:
+		c.DiagnosticHandler = value.(DiagnosticHandler)
+	case optRawHTMLRewriter:
+		c.RawHTMLRewriter = value.(RawHTMLRewriter)
+	case optAccessibility:
+		c.Accessibility = value.(bool)
+	case optDataAttributePolicy:
+		c.DataAttributePolicy = value.(DataAttributePolicy)
+	case optElementMappings:
+		c.ElementMappings = value.(map[ast.NodeKind]ElementMapping)
+	case optMissingAltText:
+		c.MissingAltText = value.(MissingAltText)
+	case optUnwrapParagraph:
+		c.UnwrapParagraph = value.(bool)
+	case optSourcePos:
+		c.SourcePos = value.(bool)
+	case renderer.OptDeterministic:
+		c.Deterministic = value.(bool)
+	case optIndent:
+		c.Indent = value.(string)
+	case optEPUB:
+		c.EPUB = value.(bool)
+	case optTagFilter:
+		c.TagFilter = value.(bool)
+	case optImageResolver:
+		c.ImageResolver = value.(ImageResolver)
+	case optLazyImages:
+		c.LazyImages = value.(bool)
+	case optAsyncImageDecoding:
+		c.AsyncImageDecoding = value.(bool)
+	case optEagerImage:
+		c.EagerImage = value.(EagerImageFunc)
+	case optEmailObfuscation:
+		c.EmailObfuscation = value.(EmailObfuscation)
 	}
 }
 
@@ -85,6 +168,17 @@ func (o *withHardWraps) SetHTMLOption(c *Config) {
 	c.HardWraps = true
 }
 
+// hardWrapsOption is a document option key used in WithDocumentHardWraps.
+var hardWrapsOption = ast.NewDocumentOptionKey()
+
+// WithDocumentHardWraps is a functional option for Parse and Convert that
+// overrides the WithHardWraps option for the parsed document.
+// This is useful for rendering documents that need different line break
+// semantics(i.e. chat messages and articles) with the same Markdown.
+func WithDocumentHardWraps(enabled bool) parser.ParseOption {
+	return parser.WithDocumentOption(hardWrapsOption, enabled)
+}
+
 // WithHardWraps is a functional option that indicates whether softline breaks
 // should be rendered as '<br>'.
 func WithHardWraps() interface {
@@ -140,6 +234,137 @@ func WithUnsafe() interface {
 	return &withUnsafe{}
 }
 
+// Accessibility is an option name used in WithAccessibility.
+const optAccessibility renderer.OptionName = "Accessibility"
+
+type withAccessibility struct {
+}
+
+func (o *withAccessibility) SetConfig(c *renderer.Config) {
+	c.Options[optAccessibility] = true
+}
+
+func (o *withAccessibility) SetHTMLOption(c *Config) {
+	c.Accessibility = true
+}
+
+// WithAccessibility is a functional option that renders ARIA roles and
+// labels on structures generated by renderers.
+// Currently only footnotes are covered: the list gets an aria-label,
+// footnotes get role="doc-footnote", and back references are rendered with
+// role="doc-backlink" and an aria-label. goldmark does not render tables of
+// contents or heading permalinks, so nav wrappers and labels for them are
+// left to extensions that render these structures.
+func WithAccessibility() interface {
+	renderer.Option
+	Option
+} {
+	return &withAccessibility{}
+}
+
+// UnwrapParagraph is an option name used in WithUnwrapParagraph.
+const optUnwrapParagraph renderer.OptionName = "UnwrapParagraph"
+
+type withUnwrapParagraph struct {
+}
+
+func (o *withUnwrapParagraph) SetConfig(c *renderer.Config) {
+	c.Options[optUnwrapParagraph] = true
+}
+
+func (o *withUnwrapParagraph) SetHTMLOption(c *Config) {
+	c.UnwrapParagraph = true
+}
+
+// WithUnwrapParagraph is a functional option that renders a document
+// consisting of a single paragraph without '<p>' tags.
+// This is useful for UI labels and tooltips where block elements break
+// layouts.
+func WithUnwrapParagraph() interface {
+	renderer.Option
+	Option
+} {
+	return &withUnwrapParagraph{}
+}
+
+// A Diagnostic struct represents a non-fatal problem that has been found
+// while rendering.
+type Diagnostic struct {
+	// Node is a node that caused this problem.
+	Node ast.Node
+
+	// Err is an error that describes this problem.
+	Err error
+}
+
+// DiagnosticHandler is a function that will be called when renderers
+// find a non-fatal problem.
+type DiagnosticHandler func(Diagnostic)
+
+// DiagnosticHandler is an option name used in WithDiagnosticHandler.
+const optDiagnosticHandler renderer.OptionName = "DiagnosticHandler"
+
+type withDiagnosticHandler struct {
+	value DiagnosticHandler
+}
+
+func (o *withDiagnosticHandler) SetConfig(c *renderer.Config) {
+	c.Options[optDiagnosticHandler] = o.value
+}
+
+func (o *withDiagnosticHandler) SetHTMLOption(c *Config) {
+	c.DiagnosticHandler = o.value
+}
+
+// WithDiagnosticHandler is a functional option that allow you to
+// receive non-fatal problems found while rendering.
+func WithDiagnosticHandler(handler DiagnosticHandler) interface {
+	renderer.Option
+	Option
+} {
+	return &withDiagnosticHandler{handler}
+}
+
+// CodeRenderFunc is a function that renders a whole code block
+// (CodeBlock and FencedCodeBlock) like syntax highlighters and
+// diagram renderers do.
+// language is nil if the code block does not have an info string.
+//
+// If CodeRenderFunc returns an error, contents written by the function
+// are discarded and the code block will be rendered as plain escaped
+// code. The error will be reported as a Diagnostic unless it is
+// ErrCodeNotHandled.
+type CodeRenderFunc func(w util.BufWriter, source []byte, n ast.Node, language []byte) error
+
+// ErrCodeNotHandled is returned by CodeRenderFuncs when they do not render
+// the given code block(i.e. languages that they do not support), so that
+// the code block is rendered as plain escaped code.
+var ErrCodeNotHandled = errors.New("code not handled")
+
+// CodeRenderer is an option name used in WithCodeRenderer.
+const optCodeRenderer renderer.OptionName = "CodeRenderer"
+
+type withCodeRenderer struct {
+	value CodeRenderFunc
+}
+
+func (o *withCodeRenderer) SetConfig(c *renderer.Config) {
+	c.Options[optCodeRenderer] = o.value
+}
+
+func (o *withCodeRenderer) SetHTMLOption(c *Config) {
+	c.CodeRenderer = o.value
+}
+
+// WithCodeRenderer is a functional option that allow you to render
+// code blocks with the given function.
+func WithCodeRenderer(f CodeRenderFunc) interface {
+	renderer.Option
+	Option
+} {
+	return &withCodeRenderer{f}
+}
+
 // A Renderer struct is an implementation of renderer.NodeRenderer that renders
 // nodes as (X)HTML.
 type Renderer struct {
@@ -158,6 +383,38 @@ func NewRenderer(opts ...Option) renderer.NodeRenderer {
 	return r
 }
 
+// WrapWriter implements renderer.WriterWrapper.
+func (r *Renderer) WrapWriter(w util.BufWriter) util.BufWriter {
+	if r.EPUB {
+		w = newNumericReferenceWriter(w)
+	}
+	if len(r.Indent) != 0 {
+		w = newIndentWriter(w, r.Indent)
+	}
+	return &documentWriter{BufWriter: w}
+}
+
+// documentWriter is a writer that holds options of the document being
+// rendered, so that they are not looked up for each node.
+type documentWriter struct {
+	util.BufWriter
+	document  *ast.Document
+	source    []byte
+	lineIndex *text.LineIndex
+}
+
+// document returns the Document that contains the given node, or nil if
+// the node is not in a document.
+func document(w util.BufWriter, n ast.Node) *ast.Document {
+	if dw, ok := w.(*documentWriter); ok && dw.document != nil {
+		return dw.document
+	}
+	for ; n.Parent() != nil; n = n.Parent() {
+	}
+	doc, _ := n.(*ast.Document)
+	return doc
+}
+
 // RegisterFuncs implements NodeRenderer.RegisterFuncs .
 func (r *Renderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
 	// blocks
@@ -184,6 +441,10 @@ func (r *Renderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
 	reg.Register(ast.KindRawHTML, r.renderRawHTML)
 	reg.Register(ast.KindText, r.renderText)
 	reg.Register(ast.KindString, r.renderString)
+
+	for kind := range r.ElementMappings {
+		reg.Register(kind, r.renderMappedElement)
+	}
 }
 
 func (r *Renderer) writeLines(w util.BufWriter, source []byte, n ast.Node) {
@@ -195,7 +456,16 @@ func (r *Renderer) writeLines(w util.BufWriter, source []byte, n ast.Node) {
 }
 
 func (r *Renderer) renderDocument(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
-	// nothing to do
+	if dw, ok := w.(*documentWriter); ok {
+		if entering {
+			dw.document = node.(*ast.Document)
+			dw.source = source
+		} else {
+			dw.document = nil
+			dw.source = nil
+			dw.lineIndex = nil
+		}
+	}
 	return ast.WalkContinue, nil
 }
 
@@ -204,7 +474,7 @@ func (r *Renderer) renderHeading(w util.BufWriter, source []byte, node ast.Node,
 	if entering {
 		_, _ = w.WriteString("<h")
 		_ = w.WriteByte("0123456"[n.Level])
-		if n.Attributes() != nil {
+		if n.Attributes() != nil || r.SourcePos {
 			r.RenderAttributes(w, node)
 		}
 		_ = w.WriteByte('>')
@@ -218,58 +488,97 @@ func (r *Renderer) renderHeading(w util.BufWriter, source []byte, node ast.Node,
 
 func (r *Renderer) renderBlockquote(w util.BufWriter, source []byte, n ast.Node, entering bool) (ast.WalkStatus, error) {
 	if entering {
-		_, _ = w.WriteString("<blockquote>\n")
+		if n.Attributes() != nil || r.SourcePos {
+			_, _ = w.WriteString("<blockquote")
+			r.RenderAttributes(w, n)
+			_, _ = w.WriteString(">\n")
+		} else {
+			_, _ = w.WriteString("<blockquote>\n")
+		}
 	} else {
 		_, _ = w.WriteString("</blockquote>\n")
 	}
 	return ast.WalkContinue, nil
 }
 
+// renderCodeWithRenderer renders the given code block with the CodeRenderer.
+// renderCodeWithRenderer returns false if the code block should be rendered
+// as plain escaped code.
+func (r *Renderer) renderCodeWithRenderer(w util.BufWriter, source []byte, n ast.Node, language []byte) bool {
+	if r.CodeRenderer == nil {
+		return false
+	}
+	var buf bytes.Buffer
+	bw := bufio.NewWriter(&buf)
+	err := r.CodeRenderer(bw, source, n, language)
+	if err == nil {
+		err = bw.Flush()
+	}
+	if err != nil {
+		if err != ErrCodeNotHandled {
+			r.ReportDiagnostic(n, err)
+		}
+		return false
+	}
+	_, _ = w.Write(buf.Bytes())
+	return true
+}
+
 func (r *Renderer) renderCodeBlock(w util.BufWriter, source []byte, n ast.Node, entering bool) (ast.WalkStatus, error) {
-	if entering {
-		_, _ = w.WriteString("<pre><code>")
-		r.writeLines(w, source, n)
-	} else {
-		_, _ = w.WriteString("</code></pre>\n")
+	if !entering {
+		return ast.WalkContinue, nil
 	}
-	return ast.WalkContinue, nil
+	if r.renderCodeWithRenderer(w, source, n, nil) {
+		return ast.WalkSkipChildren, nil
+	}
+	_, _ = w.WriteString("<pre")
+	if n.Attributes() != nil || r.SourcePos {
+		r.RenderAttributes(w, n)
+	}
+	_, _ = w.WriteString("><code>")
+	r.writeLines(w, source, n)
+	_, _ = w.WriteString("</code></pre>\n")
+	return ast.WalkSkipChildren, nil
 }
 
 func (r *Renderer) renderFencedCodeBlock(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
 	n := node.(*ast.FencedCodeBlock)
-	if entering {
-		_, _ = w.WriteString("<pre><code")
-		language := n.Language(source)
-		if language != nil {
-			_, _ = w.WriteString(" class=\"language-")
-			r.Writer.Write(w, language)
-			_, _ = w.WriteString("\"")
-		}
-		_ = w.WriteByte('>')
-		r.writeLines(w, source, n)
-	} else {
-		_, _ = w.WriteString("</code></pre>\n")
+	language := n.Language(source)
+	if r.renderCodeWithRenderer(w, source, n, language) {
+		return ast.WalkSkipChildren, nil
 	}
-	return ast.WalkContinue, nil
+	_, _ = w.WriteString("<pre")
+	if n.Attributes() != nil || r.SourcePos {
+		r.RenderAttributes(w, n)
+	}
+	_, _ = w.WriteString("><code")
+	if language != nil {
+		_, _ = w.WriteString(" class=\"language-")
+		r.Writer.Write(w, language)
+		_, _ = w.WriteString("\"")
+	}
+	_ = w.WriteByte('>')
+	r.writeLines(w, source, n)
+	_, _ = w.WriteString("</code></pre>\n")
+	return ast.WalkSkipChildren, nil
 }
 
 func (r *Renderer) renderHTMLBlock(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
 	n := node.(*ast.HTMLBlock)
 	if entering {
-		if r.Unsafe {
-			l := n.Lines().Len()
-			for i := 0; i < l; i++ {
-				line := n.Lines().At(i)
-				_, _ = w.Write(line.Value(source))
-			}
+		if r.Unsafe || r.RawHTMLRewriter != nil {
+			r.writeRawHTML(w, n.Lines().Value(source))
 		} else {
 			_, _ = w.WriteString("<!-- raw HTML omitted -->\n")
 		}
 	} else {
 		if n.HasClosure() {
-			if r.Unsafe {
+			if r.Unsafe || r.RawHTMLRewriter != nil {
 				closure := n.ClosureLine
-				_, _ = w.Write(closure.Value(source))
+				r.writeRawHTML(w, closure.Value(source))
 			} else {
 				_, _ = w.WriteString("<!-- raw HTML omitted -->\n")
 			}
@@ -288,10 +597,12 @@ func (r *Renderer) renderList(w util.BufWriter, source []byte, node ast.Node, en
 		_ = w.WriteByte('<')
 		_, _ = w.WriteString(tag)
 		if n.IsOrdered() && n.Start != 1 {
-			fmt.Fprintf(w, " start=\"%d\">\n", n.Start)
-		} else {
-			_, _ = w.WriteString(">\n")
+			fmt.Fprintf(w, " start=\"%d\"", n.Start)
 		}
+		if n.Attributes() != nil || r.SourcePos {
+			r.RenderAttributes(w, n)
+		}
+		_, _ = w.WriteString(">\n")
 	} else {
 		_, _ = w.WriteString("</")
 		_, _ = w.WriteString(tag)
@@ -302,7 +613,13 @@ func (r *Renderer) renderList(w util.BufWriter, source []byte, node ast.Node, en
 
 func (r *Renderer) renderListItem(w util.BufWriter, source []byte, n ast.Node, entering bool) (ast.WalkStatus, error) {
 	if entering {
-		_, _ = w.WriteString("<li>")
+		if n.Attributes() != nil || r.SourcePos {
+			_, _ = w.WriteString("<li")
+			r.RenderAttributes(w, n)
+			_ = w.WriteByte('>')
+		} else {
+			_, _ = w.WriteString("<li>")
+		}
 		fc := n.FirstChild()
 		if fc != nil {
 			if _, ok := fc.(*ast.TextBlock); !ok {
@@ -316,14 +633,29 @@ func (r *Renderer) renderListItem(w util.BufWriter, source []byte, n ast.Node, e
 }
 
 func (r *Renderer) renderParagraph(w util.BufWriter, source []byte, n ast.Node, entering bool) (ast.WalkStatus, error) {
+	if r.UnwrapParagraph && isSoleParagraph(n) {
+		return ast.WalkContinue, nil
+	}
 	if entering {
-		_, _ = w.WriteString("<p>")
+		if n.Attributes() != nil || r.SourcePos {
+			_, _ = w.WriteString("<p")
+			r.RenderAttributes(w, n)
+			_ = w.WriteByte('>')
+		} else {
+			_, _ = w.WriteString("<p>")
+		}
 	} else {
 		_, _ = w.WriteString("</p>\n")
 	}
 	return ast.WalkContinue, nil
 }
 
+func isSoleParagraph(n ast.Node) bool {
+	parent := n.Parent()
+	return parent != nil && parent.Kind() == ast.KindDocument &&
+		n.PreviousSibling() == nil && n.NextSibling() == nil
+}
+
 func (r *Renderer) renderTextBlock(w util.BufWriter, source []byte, n ast.Node, entering bool) (ast.WalkStatus, error) {
 	if !entering {
 		if _, ok := n.NextSibling().(ast.Node); ok && n.FirstChild() != nil {
@@ -337,10 +669,14 @@ func (r *Renderer) renderThemanticBreak(w util.BufWriter, source []byte, n ast.N
 	if !entering {
 		return ast.WalkContinue, nil
 	}
+	_, _ = w.WriteString("<hr")
+	if n.Attributes() != nil || r.SourcePos {
+		r.RenderAttributes(w, n)
+	}
 	if r.XHTML {
-		_, _ = w.WriteString("<hr />\n")
+		_, _ = w.WriteString(" />\n")
 	} else {
-		_, _ = w.WriteString("<hr>\n")
+		_, _ = w.WriteString(">\n")
 	}
 	return ast.WalkContinue, nil
 }
@@ -353,19 +689,47 @@ func (r *Renderer) renderAutoLink(w util.BufWriter, source []byte, node ast.Node
 	_, _ = w.WriteString(`<a href="`)
 	url := n.URL(source)
 	label := n.Label(source)
+	isEmail := n.AutoLinkType == ast.AutoLinkEmail || bytes.HasPrefix(bytes.ToLower(url), []byte("mailto:"))
+	obfuscation := EmailObfuscationNone
+	if isEmail {
+		obfuscation = r.emailObfuscation(w, n)
+	}
 	if n.AutoLinkType == ast.AutoLinkEmail && !bytes.HasPrefix(bytes.ToLower(url), []byte("mailto:")) {
-		_, _ = w.WriteString("mailto:")
+		url = append([]byte("mailto:"), url...)
+	}
+	if obfuscation == EmailObfuscationNone {
+		_, _ = w.Write(util.EscapeHTML(util.URLEscape(url, false)))
+	} else {
+		writeCharacterReferences(w, util.URLEscape(url, false))
+	}
+	_ = w.WriteByte('"')
+	if n.Attributes() != nil {
+		r.RenderAttributes(w, n)
+	}
+	_ = w.WriteByte('>')
+	switch obfuscation {
+	case EmailObfuscationEntities:
+		writeCharacterReferences(w, label)
+	case EmailObfuscationReverse:
+		_, _ = w.WriteString(`<span style="unicode-bidi:bidi-override;direction:rtl">`)
+		_, _ = w.Write(util.EscapeHTML(reverseRunes(label)))
+		_, _ = w.WriteString(`</span>`)
+	default:
+		_, _ = w.Write(util.EscapeHTML(label))
 	}
-	_, _ = w.Write(util.EscapeHTML(util.URLEscape(url, false)))
-	_, _ = w.WriteString(`">`)
-	_, _ = w.Write(util.EscapeHTML(label))
 	_, _ = w.WriteString(`</a>`)
 	return ast.WalkContinue, nil
 }
 
 func (r *Renderer) renderCodeSpan(w util.BufWriter, source []byte, n ast.Node, entering bool) (ast.WalkStatus, error) {
 	if entering {
-		_, _ = w.WriteString("<code>")
+		if n.Attributes() != nil {
+			_, _ = w.WriteString("<code")
+			r.RenderAttributes(w, n)
+			_ = w.WriteByte('>')
+		} else {
+			_, _ = w.WriteString("<code>")
+		}
 		for c := n.FirstChild(); c != nil; c = c.NextSibling() {
 			segment := c.(*ast.Text).Segment
 			value := segment.Value(source)
@@ -393,6 +757,9 @@ func (r *Renderer) renderEmphasis(w util.BufWriter, source []byte, node ast.Node
 	if entering {
 		_ = w.WriteByte('<')
 		_, _ = w.WriteString(tag)
+		if n.Attributes() != nil {
+			r.RenderAttributes(w, n)
+		}
 		_ = w.WriteByte('>')
 	} else {
 		_, _ = w.WriteString("</")
@@ -415,6 +782,9 @@ func (r *Renderer) renderLink(w util.BufWriter, source []byte, node ast.Node, en
 			r.Writer.Write(w, n.Title)
 			_ = w.WriteByte('"')
 		}
+		if n.Attributes() != nil {
+			r.RenderAttributes(w, n)
+		}
 		_ = w.WriteByte('>')
 	} else {
 		_, _ = w.WriteString("</a>")
@@ -426,23 +796,57 @@ func (r *Renderer) renderImage(w util.BufWriter, source []byte, node ast.Node, e
 		return ast.WalkContinue, nil
 	}
 	n := node.(*ast.Image)
+	resolved := r.resolveImage(n)
+	if resolved != nil && len(resolved.Sources) != 0 {
+		r.writePictureStart(w, resolved)
+	}
+	src := n.Destination
+	if resolved != nil && resolved.Src != nil {
+		src = resolved.Src
+	}
 	_, _ = w.WriteString("<img src=\"")
-	if r.Unsafe || !IsDangerousURL(n.Destination) {
-		_, _ = w.Write(util.EscapeHTML(util.URLEscape(n.Destination, true)))
+	if r.Unsafe || !IsDangerousURL(src) {
+		_, _ = w.Write(util.EscapeHTML(util.URLEscape(src, true)))
 	}
 	_, _ = w.WriteString(`" alt="`)
-	_, _ = w.Write(n.Text(source))
+	decorative := IsDecorativeImage(n)
+	if !decorative {
+		alt := n.Text(source)
+		if len(alt) == 0 {
+			switch r.MissingAltText {
+			case MissingAltTextFilename:
+				alt = util.EscapeHTML(imageFilename(n.Destination))
+			case MissingAltTextWarning:
+				r.ReportDiagnostic(n, ErrMissingAltText)
+			}
+		}
+		_, _ = w.Write(alt)
+	}
 	_ = w.WriteByte('"')
 	if n.Title != nil {
 		_, _ = w.WriteString(` title="`)
 		r.Writer.Write(w, n.Title)
 		_ = w.WriteByte('"')
 	}
+	if resolved != nil {
+		r.writeImageSourceAttribute(w, "srcset", resolved.Srcset)
+		r.writeImageSourceAttribute(w, "sizes", resolved.Sizes)
+	}
+	if n.Attributes() != nil {
+		r.RenderAttributes(w, n)
+	}
+	if _, ok := n.AttributeString("role"); decorative && !ok {
+		_, _ = w.WriteString(` role="presentation"`)
+	}
+	r.writeImageLoadingAttributes(w, n)
 	if r.XHTML {
 		_, _ = w.WriteString(" />")
 	} else {
 		_, _ = w.WriteString(">")
 	}
+	if resolved != nil && len(resolved.Sources) != 0 {
+		_, _ = w.WriteString("</picture>")
+	}
 	return ast.WalkSkipChildren, nil
 }
 
@@ -450,13 +854,9 @@ func (r *Renderer) renderRawHTML(w util.BufWriter, source []byte, node ast.Node,
 	if !entering {
 		return ast.WalkSkipChildren, nil
 	}
-	if r.Unsafe {
+	if r.Unsafe || r.RawHTMLRewriter != nil {
 		n := node.(*ast.RawHTML)
-		l := n.Segments.Len()
-		for i := 0; i < l; i++ {
-			segment := n.Segments.At(i)
-			_, _ = w.Write(segment.Value(source))
-		}
+		r.writeRawHTML(w, n.Segments.Value(source))
 		return ast.WalkSkipChildren, nil
 	}
 	_, _ = w.WriteString("<!-- raw HTML omitted -->")
@@ -473,7 +873,7 @@ func (r *Renderer) renderText(w util.BufWriter, source []byte, node ast.Node, en
 		r.Writer.RawWrite(w, segment.Value(source))
 	} else {
 		r.Writer.Write(w, segment.Value(source))
-		if n.HardLineBreak() || (n.SoftLineBreak() && r.HardWraps) {
+		if n.HardLineBreak() || (n.SoftLineBreak() && r.hardWraps(w, n)) {
 			if r.XHTML {
 				_, _ = w.WriteString("<br />\n")
 			} else {
@@ -486,6 +886,17 @@ func (r *Renderer) renderText(w util.BufWriter, source []byte, node ast.Node, en
 	return ast.WalkContinue, nil
 }
 
+// hardWraps returns true if soft line breaks in the document that contains
+// the given node should be rendered as hard line breaks.
+func (r *Renderer) hardWraps(w util.BufWriter, n ast.Node) bool {
+	if doc := document(w, n); doc != nil {
+		if v, ok := doc.Option(hardWrapsOption).(bool); ok {
+			return v
+		}
+	}
+	return r.HardWraps
+}
+
 func (r *Renderer) renderString(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
 	if !entering {
 		return ast.WalkContinue, nil
@@ -504,7 +915,9 @@ func (r *Renderer) renderString(w util.BufWriter, source []byte, node ast.Node,
 }
 
 // RenderAttributes renders given node's attributes.
-func (r *Renderer) RenderAttributes(w util.BufWriter, node ast.Node) {
+// This function renders all attributes regardless of policies like
+// DataAttributePolicy; use Config.RenderAttributes to respect them.
+func RenderAttributes(w util.BufWriter, node ast.Node) {
 	for _, attr := range node.Attributes() {
 		_, _ = w.WriteString(" ")
 		_, _ = w.Write(attr.Name)
@@ -0,0 +1,91 @@
+package html
+
+import (
+	"bytes"
+	"errors"
+	"path"
+	"strings"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/renderer"
+)
+
+// ErrMissingAltText is reported as a Diagnostic when images without alt
+// texts are rendered with MissingAltTextWarning.
+var ErrMissingAltText = errors.New("missing alt text")
+
+// MissingAltText is a behavior of renderers when images do not have
+// alt texts.
+type MissingAltText int
+
+const (
+	// MissingAltTextEmpty renders an empty alt text.
+	MissingAltTextEmpty MissingAltText = iota
+
+	// MissingAltTextFilename renders a file name of the image without
+	// its extension as an alt text.
+	MissingAltTextFilename
+
+	// MissingAltTextWarning renders an empty alt text and reports
+	// ErrMissingAltText as a Diagnostic.
+	MissingAltTextWarning
+)
+
+// MissingAltText is an option name used in WithMissingAltText.
+const optMissingAltText renderer.OptionName = "MissingAltText"
+
+type withMissingAltText struct {
+	value MissingAltText
+}
+
+func (o *withMissingAltText) SetConfig(c *renderer.Config) {
+	c.Options[optMissingAltText] = o.value
+}
+
+func (o *withMissingAltText) SetHTMLOption(c *Config) {
+	c.MissingAltText = o.value
+}
+
+// WithMissingAltText is a functional option that sets a behavior of the
+// renderer when images do not have alt texts.
+// Decorative images are not affected by this option.
+func WithMissingAltText(v MissingAltText) interface {
+	renderer.Option
+	Option
+} {
+	return &withMissingAltText{v}
+}
+
+var decorativeImageClass = []byte("decorative")
+
+// IsDecorativeImage returns true if the given image is marked as
+// decorative by a 'decorative' class like '![](border.png){.decorative}'.
+// Decorative images are rendered with an empty alt text and
+// role="presentation".
+func IsDecorativeImage(n ast.Node) bool {
+	class, ok := n.AttributeString("class")
+	if !ok {
+		return false
+	}
+	for _, c := range bytes.Fields(class) {
+		if bytes.Equal(c, decorativeImageClass) {
+			return true
+		}
+	}
+	return false
+}
+
+// imageFilename returns a file name of the given destination without
+// its extension.
+func imageFilename(destination []byte) []byte {
+	p := string(destination)
+	if i := strings.IndexAny(p, "?#"); i > -1 {
+		p = p[:i]
+	}
+	name := path.Base(p)
+	name = strings.TrimSuffix(name, path.Ext(name))
+	if name == "." || name == "/" {
+		return nil
+	}
+	return []byte(name)
+}
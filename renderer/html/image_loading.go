@@ -0,0 +1,132 @@
+package html
+
+import (
+	"errors"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/util"
+)
+
+// An EagerImageFunc function returns true if the given image should be
+// loaded eagerly like images above the fold.
+type EagerImageFunc func(n *ast.Image) bool
+
+var errImageFound = errors.New("image found")
+
+// FirstImages returns an EagerImageFunc that loads first n images of the
+// document eagerly.
+func FirstImages(n int) EagerImageFunc {
+	return func(image *ast.Image) bool {
+		var root ast.Node = image
+		for root.Parent() != nil {
+			root = root.Parent()
+		}
+		count := 0
+		err := ast.Walk(root, func(c ast.Node, entering bool) (ast.WalkStatus, error) {
+			if !entering || c.Kind() != ast.KindImage {
+				return ast.WalkContinue, nil
+			}
+			if c == image {
+				return ast.WalkStop, errImageFound
+			}
+			count++
+			if count >= n {
+				return ast.WalkStop, errImageFound
+			}
+			return ast.WalkSkipChildren, nil
+		})
+		return err == errImageFound && count < n
+	}
+}
+
+// LazyImages is an option name used in WithLazyImages.
+const optLazyImages renderer.OptionName = "LazyImages"
+
+type withLazyImages struct {
+}
+
+func (o *withLazyImages) SetConfig(c *renderer.Config) {
+	c.Options[optLazyImages] = true
+}
+
+func (o *withLazyImages) SetHTMLOption(c *Config) {
+	c.LazyImages = true
+}
+
+// WithLazyImages is a functional option that renders images with
+// loading="lazy".
+func WithLazyImages() interface {
+	renderer.Option
+	Option
+} {
+	return &withLazyImages{}
+}
+
+// AsyncImageDecoding is an option name used in WithAsyncImageDecoding.
+const optAsyncImageDecoding renderer.OptionName = "AsyncImageDecoding"
+
+type withAsyncImageDecoding struct {
+}
+
+func (o *withAsyncImageDecoding) SetConfig(c *renderer.Config) {
+	c.Options[optAsyncImageDecoding] = true
+}
+
+func (o *withAsyncImageDecoding) SetHTMLOption(c *Config) {
+	c.AsyncImageDecoding = true
+}
+
+// WithAsyncImageDecoding is a functional option that renders images with
+// decoding="async".
+func WithAsyncImageDecoding() interface {
+	renderer.Option
+	Option
+} {
+	return &withAsyncImageDecoding{}
+}
+
+// EagerImage is an option name used in WithEagerImageFunc.
+const optEagerImage renderer.OptionName = "EagerImage"
+
+type withEagerImageFunc struct {
+	value EagerImageFunc
+}
+
+func (o *withEagerImageFunc) SetConfig(c *renderer.Config) {
+	c.Options[optEagerImage] = o.value
+}
+
+func (o *withEagerImageFunc) SetHTMLOption(c *Config) {
+	c.EagerImage = o.value
+}
+
+// WithEagerImageFunc is a functional option that exempts images from
+// WithLazyImages and WithAsyncImageDecoding, i.e. images above the fold
+// like FirstImages(1).
+func WithEagerImageFunc(f EagerImageFunc) interface {
+	renderer.Option
+	Option
+} {
+	return &withEagerImageFunc{f}
+}
+
+var attrNameLoading = []byte("loading")
+var attrNameDecoding = []byte("decoding")
+
+// writeImageLoadingAttributes writes loading and decoding attributes of the
+// given image unless the image has these attributes or is loaded eagerly.
+func (r *Renderer) writeImageLoadingAttributes(w util.BufWriter, n *ast.Image) {
+	if !r.LazyImages && !r.AsyncImageDecoding {
+		return
+	}
+	if r.EagerImage != nil && r.EagerImage(n) {
+		return
+	}
+	if _, ok := n.Attribute(attrNameLoading); r.LazyImages && !ok {
+		_, _ = w.WriteString(` loading="lazy"`)
+	}
+	if _, ok := n.Attribute(attrNameDecoding); r.AsyncImageDecoding && !ok {
+		_, _ = w.WriteString(` decoding="async"`)
+	}
+}
@@ -0,0 +1,137 @@
+package html
+
+import (
+	"bytes"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/util"
+)
+
+// An ImageSource struct is an alternative source of a responsive image,
+// rendered as a <source> element of a <picture> element.
+type ImageSource struct {
+	// Srcset is a value of the srcset attribute like
+	// 'a-480.webp 480w, a-800.webp 800w'.
+	Srcset []byte
+
+	// Sizes is a value of the sizes attribute like
+	// '(max-width: 600px) 480px, 800px'.
+	Sizes []byte
+
+	// Type is a MIME type of the source like 'image/webp'.
+	Type []byte
+
+	// Media is a media query of the source like '(min-width: 800px)'.
+	Media []byte
+}
+
+// A ResolvedImage struct is a result of ImageResolvers.
+type ResolvedImage struct {
+	// Src is a final URL of the image. nil means the destination of the
+	// image.
+	Src []byte
+
+	// Srcset is a value of the srcset attribute of the <img> element.
+	Srcset []byte
+
+	// Sizes is a value of the sizes attribute of the <img> element.
+	Sizes []byte
+
+	// Sources is a list of alternative sources. If Sources is not empty,
+	// the image is rendered in a <picture> element.
+	Sources []ImageSource
+}
+
+// An ImageResolver function resolves final URLs and responsive sources of
+// the given image, i.e. from an image CDN.
+// If ImageResolver returns nil, the image is rendered as it is.
+// If ImageResolver returns an error, the image is rendered as it is and the
+// error is reported as a Diagnostic.
+type ImageResolver func(n *ast.Image) (*ResolvedImage, error)
+
+// ImageResolver is an option name used in WithImageResolver.
+const optImageResolver renderer.OptionName = "ImageResolver"
+
+type withImageResolver struct {
+	value ImageResolver
+}
+
+func (o *withImageResolver) SetConfig(c *renderer.Config) {
+	c.Options[optImageResolver] = o.value
+}
+
+func (o *withImageResolver) SetHTMLOption(c *Config) {
+	c.ImageResolver = o.value
+}
+
+// WithImageResolver is a functional option that resolves images with the
+// given function, so that images are rendered with srcset and sizes
+// attributes or as <picture> elements.
+func WithImageResolver(f ImageResolver) interface {
+	renderer.Option
+	Option
+} {
+	return &withImageResolver{f}
+}
+
+// resolveImage calls the ImageResolver, and returns nil if the image should
+// be rendered as it is.
+func (c *Config) resolveImage(n *ast.Image) *ResolvedImage {
+	if c.ImageResolver == nil {
+		return nil
+	}
+	resolved, err := c.ImageResolver(n)
+	if err != nil {
+		c.ReportDiagnostic(n, err)
+		return nil
+	}
+	return resolved
+}
+
+// isDangerousSrcset returns true if any of URLs in the given srcset is
+// potentially dangerous.
+func isDangerousSrcset(srcset []byte) bool {
+	for _, candidate := range bytes.Split(srcset, []byte{','}) {
+		fields := bytes.Fields(candidate)
+		if len(fields) != 0 && IsDangerousURL(fields[0]) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Renderer) writeImageSourceAttribute(w util.BufWriter, name string, value []byte) {
+	if len(value) == 0 {
+		return
+	}
+	if name == "srcset" && !r.Unsafe && isDangerousSrcset(value) {
+		return
+	}
+	_ = w.WriteByte(' ')
+	_, _ = w.WriteString(name)
+	_, _ = w.WriteString(`="`)
+	_, _ = w.Write(util.EscapeHTML(value))
+	_ = w.WriteByte('"')
+}
+
+// writePictureStart writes a <picture> start tag and <source> elements of
+// the given image.
+func (r *Renderer) writePictureStart(w util.BufWriter, resolved *ResolvedImage) {
+	_, _ = w.WriteString("<picture>")
+	for _, s := range resolved.Sources {
+		if !r.Unsafe && isDangerousSrcset(s.Srcset) {
+			continue
+		}
+		_, _ = w.WriteString("<source")
+		r.writeImageSourceAttribute(w, "srcset", s.Srcset)
+		r.writeImageSourceAttribute(w, "sizes", s.Sizes)
+		r.writeImageSourceAttribute(w, "type", s.Type)
+		r.writeImageSourceAttribute(w, "media", s.Media)
+		if r.XHTML {
+			_, _ = w.WriteString(" />")
+		} else {
+			_ = w.WriteByte('>')
+		}
+	}
+}
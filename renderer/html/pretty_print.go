@@ -0,0 +1,177 @@
+package html
+
+import (
+	"bytes"
+	"unicode/utf8"
+
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/util"
+)
+
+// Indent is an option name used in WithIndent.
+const optIndent renderer.OptionName = "Indent"
+
+type withIndent struct {
+	value string
+}
+
+func (o *withIndent) SetConfig(c *renderer.Config) {
+	c.Options[optIndent] = o.value
+}
+
+func (o *withIndent) SetHTMLOption(c *Config) {
+	c.Indent = o.value
+}
+
+// WithIndent is a functional option that indents lines in nested block
+// elements like lists and blockquotes with the given string.
+// Contents of preformatted elements like code blocks are not indented.
+// This is useful for human inspection and golden-file diffs. Note that
+// continuation lines of paragraphs are indented too.
+func WithIndent(indent string) interface {
+	renderer.Option
+	Option
+} {
+	return &withIndent{indent}
+}
+
+// indentContainers is a set of elements whose contents are indented.
+var indentContainers = map[string]bool{
+	"article":    true,
+	"aside":      true,
+	"blockquote": true,
+	"dd":         true,
+	"details":    true,
+	"div":        true,
+	"dl":         true,
+	"figure":     true,
+	"footer":     true,
+	"header":     true,
+	"li":         true,
+	"main":       true,
+	"nav":        true,
+	"ol":         true,
+	"section":    true,
+	"table":      true,
+	"tbody":      true,
+	"tfoot":      true,
+	"thead":      true,
+	"tr":         true,
+	"ul":         true,
+}
+
+// preformattedElements is a set of elements whose contents are written as
+// they are.
+var preformattedElements = []string{"pre", "textarea", "script", "style"}
+
+// An indentWriter is a util.BufWriter that indents lines by depths of
+// container elements that start or end at the beginning of lines.
+type indentWriter struct {
+	w      util.BufWriter
+	indent string
+	line   []byte
+	depth  int
+	pre    string
+}
+
+func newIndentWriter(w util.BufWriter, indent string) *indentWriter {
+	return &indentWriter{
+		w:      w,
+		indent: indent,
+	}
+}
+
+// tagName returns a name of the tag at the beginning of the given line and
+// true if the tag is a closing tag.
+func tagName(line []byte) (string, bool) {
+	if len(line) < 2 || line[0] != '<' {
+		return "", false
+	}
+	closing := line[1] == '/'
+	i := 1
+	if closing {
+		i++
+	}
+	start := i
+	for i < len(line) && util.IsAlphaNumeric(line[i]) {
+		i++
+	}
+	return string(bytes.ToLower(line[start:i])), closing
+}
+
+func (w *indentWriter) writeLine(line []byte) {
+	if len(w.pre) != 0 {
+		_, _ = w.w.Write(line)
+		if bytes.Contains(bytes.ToLower(line), []byte("</"+w.pre)) {
+			w.pre = ""
+		}
+		return
+	}
+	name, closing := tagName(line)
+	if closing && indentContainers[name] && w.depth > 0 {
+		w.depth--
+	}
+	if len(bytes.TrimSpace(line)) != 0 {
+		for i := 0; i < w.depth; i++ {
+			_, _ = w.w.WriteString(w.indent)
+		}
+	}
+	_, _ = w.w.Write(line)
+	lower := bytes.ToLower(line)
+	if !closing && indentContainers[name] && !bytes.Contains(lower, []byte("</"+name+">")) {
+		w.depth++
+	}
+	for _, pre := range preformattedElements {
+		if i := bytes.LastIndex(lower, []byte("<"+pre)); i > -1 &&
+			!bytes.Contains(lower[i:], []byte("</"+pre)) {
+			w.pre = pre
+		}
+	}
+}
+
+func (w *indentWriter) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) != 0 {
+		i := bytes.IndexByte(p, '\n')
+		if i < 0 {
+			w.line = append(w.line, p...)
+			break
+		}
+		w.line = append(w.line, p[:i+1]...)
+		w.writeLine(w.line)
+		w.line = w.line[:0]
+		p = p[i+1:]
+	}
+	return n, nil
+}
+
+func (w *indentWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+func (w *indentWriter) WriteByte(c byte) error {
+	_, err := w.Write([]byte{c})
+	return err
+}
+
+func (w *indentWriter) WriteRune(r rune) (int, error) {
+	buf := make([]byte, utf8.UTFMax)
+	n := utf8.EncodeRune(buf, r)
+	return w.Write(buf[:n])
+}
+
+func (w *indentWriter) Available() int {
+	return w.w.Available()
+}
+
+func (w *indentWriter) Buffered() int {
+	return w.w.Buffered() + len(w.line)
+}
+
+func (w *indentWriter) Flush() error {
+	if len(w.line) != 0 {
+		w.writeLine(w.line)
+		w.line = w.line[:0]
+	}
+	return w.w.Flush()
+}
@@ -0,0 +1,250 @@
+package html
+
+import (
+	"bytes"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/util"
+)
+
+// An HTMLTag struct represents a start tag or an end tag in raw HTML.
+type HTMLTag struct {
+	// Name is a lower-cased name of this tag.
+	Name []byte
+
+	// Attributes is a list of attributes of this tag.
+	// Attribute values are kept as written in the source, so character
+	// references are not decoded.
+	// A nil value means an attribute without values like 'disabled'.
+	Attributes []ast.Attribute
+
+	// IsEnd is true if this tag is an end tag like '</a>'.
+	IsEnd bool
+
+	// IsSelfClosing is true if this tag is closed by '/>'.
+	IsSelfClosing bool
+}
+
+// Attribute returns a (attribute value, true) if an attribute
+// associated with the given name is found, otherwise (nil, false).
+func (t *HTMLTag) Attribute(name string) ([]byte, bool) {
+	for _, attr := range t.Attributes {
+		if string(attr.Name) == name {
+			return attr.Value, true
+		}
+	}
+	return nil, false
+}
+
+// SetAttribute sets the given value to the attribute.
+func (t *HTMLTag) SetAttribute(name string, value []byte) {
+	for i, attr := range t.Attributes {
+		if string(attr.Name) == name {
+			t.Attributes[i].Value = value
+			return
+		}
+	}
+	t.Attributes = append(t.Attributes, ast.Attribute{Name: []byte(name), Value: value})
+}
+
+// RemoveAttribute removes the attribute associated with the given name.
+func (t *HTMLTag) RemoveAttribute(name string) {
+	attrs := t.Attributes[:0]
+	for _, attr := range t.Attributes {
+		if string(attr.Name) != name {
+			attrs = append(attrs, attr)
+		}
+	}
+	t.Attributes = attrs
+}
+
+// RawHTMLRewriter is a function that rewrites tags in raw HTML.
+// The given tag can be modified in place.
+// RawHTMLRewriter returns false if the tag should be removed.
+type RawHTMLRewriter func(tag *HTMLTag) bool
+
+// RawHTMLRewriter is an option name used in WithRawHTMLRewriter.
+const optRawHTMLRewriter renderer.OptionName = "RawHTMLRewriter"
+
+type withRawHTMLRewriter struct {
+	value RawHTMLRewriter
+}
+
+func (o *withRawHTMLRewriter) SetConfig(c *renderer.Config) {
+	c.Options[optRawHTMLRewriter] = o.value
+}
+
+func (o *withRawHTMLRewriter) SetHTMLOption(c *Config) {
+	c.RawHTMLRewriter = o.value
+}
+
+// WithRawHTMLRewriter is a functional option that allow you to rewrite
+// tags in raw HTML with the given function.
+// If this option is set, raw HTML is rendered even if WithUnsafe is not
+// set, so the function is responsible for removing dangerous contents
+// like event handler attributes and script tags.
+func WithRawHTMLRewriter(f RawHTMLRewriter) interface {
+	renderer.Option
+	Option
+} {
+	return &withRawHTMLRewriter{f}
+}
+
+// writeRawHTML writes the given raw HTML with rewriting tags.
+func (r *Renderer) writeRawHTML(w util.BufWriter, source []byte) {
+	if r.RawHTMLRewriter == nil && !r.EPUB {
+		if r.TagFilter {
+			writeFilteredHTML(w, source)
+		} else {
+			_, _ = w.Write(source)
+		}
+		return
+	}
+	for len(source) != 0 {
+		i := bytes.IndexByte(source, '<')
+		if i < 0 {
+			_, _ = w.Write(source)
+			return
+		}
+		_, _ = w.Write(source[:i])
+		source = source[i:]
+		tag, n := parseHTMLTag(source)
+		if tag == nil {
+			if r.TagFilter && isFilteredTag(source) {
+				_, _ = w.WriteString("&lt;")
+			} else {
+				_ = w.WriteByte('<')
+			}
+			source = source[1:]
+			continue
+		}
+		filtered := r.TagFilter && isFilteredTag(source)
+		source = source[n:]
+		if r.EPUB && !rewriteXHTMLTag(tag) {
+			continue
+		}
+		if r.RawHTMLRewriter == nil || r.RawHTMLRewriter(tag) {
+			if filtered {
+				_, _ = w.WriteString("&lt;")
+			} else {
+				_ = w.WriteByte('<')
+			}
+			writeHTMLTagBody(w, tag)
+		}
+	}
+}
+
+// writeHTMLTagBody writes the given tag without the leading '<'.
+func writeHTMLTagBody(w util.BufWriter, tag *HTMLTag) {
+	if tag.IsEnd {
+		_ = w.WriteByte('/')
+	}
+	_, _ = w.Write(tag.Name)
+	for _, attr := range tag.Attributes {
+		_ = w.WriteByte(' ')
+		_, _ = w.Write(attr.Name)
+		if attr.Value != nil {
+			_, _ = w.WriteString(`="`)
+			_, _ = w.Write(bytes.Replace(attr.Value, []byte{'"'}, []byte("&quot;"), -1))
+			_ = w.WriteByte('"')
+		}
+	}
+	if tag.IsSelfClosing {
+		_, _ = w.WriteString(" />")
+	} else {
+		_ = w.WriteByte('>')
+	}
+}
+
+func isHTMLTagNameChar(c byte, first bool) bool {
+	if (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') {
+		return true
+	}
+	return !first && ((c >= '0' && c <= '9') || c == '-')
+}
+
+func isHTMLAttributeNameChar(c byte) bool {
+	return !util.IsSpace(c) && c != '"' && c != '\'' && c != '>' && c != '/' && c != '=' && c != '<'
+}
+
+func skipHTMLSpaces(source []byte, i int) int {
+	for i < len(source) && util.IsSpace(source[i]) {
+		i++
+	}
+	return i
+}
+
+// parseHTMLTag parses a start tag or an end tag at the head of the given
+// source. parseHTMLTag returns nil if the source does not start with a tag.
+func parseHTMLTag(source []byte) (*HTMLTag, int) {
+	i := 1
+	tag := &HTMLTag{}
+	if i < len(source) && source[i] == '/' {
+		tag.IsEnd = true
+		i++
+	}
+	start := i
+	for i < len(source) && isHTMLTagNameChar(source[i], i == start) {
+		i++
+	}
+	if i == start {
+		return nil, 0
+	}
+	tag.Name = bytes.ToLower(source[start:i])
+	for {
+		j := skipHTMLSpaces(source, i)
+		if j >= len(source) {
+			return nil, 0
+		}
+		switch source[j] {
+		case '>':
+			return tag, j + 1
+		case '/':
+			if !tag.IsEnd && j+1 < len(source) && source[j+1] == '>' {
+				tag.IsSelfClosing = true
+				return tag, j + 2
+			}
+			return nil, 0
+		}
+		if j == i || tag.IsEnd {
+			// attributes must be separated by spaces
+			return nil, 0
+		}
+		i = j
+		for i < len(source) && isHTMLAttributeNameChar(source[i]) {
+			i++
+		}
+		if i == j {
+			return nil, 0
+		}
+		attr := ast.Attribute{Name: bytes.ToLower(source[j:i])}
+		k := skipHTMLSpaces(source, i)
+		if k < len(source) && source[k] == '=' {
+			k = skipHTMLSpaces(source, k+1)
+			if k >= len(source) {
+				return nil, 0
+			}
+			switch c := source[k]; c {
+			case '"', '\'':
+				end := bytes.IndexByte(source[k+1:], c)
+				if end < 0 {
+					return nil, 0
+				}
+				attr.Value = source[k+1 : k+1+end]
+				i = k + end + 2
+			default:
+				l := k
+				for l < len(source) && !util.IsSpace(source[l]) && bytes.IndexByte([]byte("\"'=<>`"), source[l]) < 0 {
+					l++
+				}
+				if l == k {
+					return nil, 0
+				}
+				attr.Value = source[k:l]
+				i = l
+			}
+		}
+		tag.Attributes = append(tag.Attributes, attr)
+	}
+}
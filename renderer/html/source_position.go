@@ -0,0 +1,110 @@
+package html
+
+import (
+	"fmt"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// SourcePos is an option name used in WithSourcePos.
+const optSourcePos renderer.OptionName = "SourcePos"
+
+type withSourcePos struct {
+}
+
+func (o *withSourcePos) SetConfig(c *renderer.Config) {
+	c.Options[optSourcePos] = true
+}
+
+func (o *withSourcePos) SetHTMLOption(c *Config) {
+	c.SourcePos = true
+}
+
+// WithSourcePos is a functional option that renders block elements with
+// a 'data-sourcepos="line:column-line:column"' attribute like cmark-gfm.
+// Lines and columns are 1-based, and columns are counted in bytes.
+// Positions are ranges of the contents of blocks, so markers like '#' of
+// headings are not included. Blocks that do not hold their contents like
+// thematic breaks are rendered without positions.
+// Positions are computed when attributes are rendered by
+// Config.RenderAttributes, and nodes are not modified.
+// This is useful for synchronizing scroll positions of editors and
+// previews.
+func WithSourcePos() interface {
+	renderer.Option
+	Option
+} {
+	return &withSourcePos{}
+}
+
+var attrNameSourcePos = []byte("data-sourcepos")
+
+// sourcePosition returns a value of the data-sourcepos attribute of the
+// given node in the document being rendered to the given writer, or nil if
+// the node should be rendered without positions.
+func sourcePosition(w util.BufWriter, n ast.Node) []byte {
+	dw, ok := w.(*documentWriter)
+	if !ok || dw.source == nil || n.Type() != ast.TypeBlock {
+		return nil
+	}
+	if n.Kind() == ast.KindTextBlock || n.Kind() == ast.KindHTMLBlock {
+		return nil
+	}
+	start, stop, ok := BlockRange(n, dw.source)
+	if !ok {
+		return nil
+	}
+	if dw.lineIndex == nil {
+		dw.lineIndex = text.NewLineIndex(dw.source)
+	}
+	startLine, startColumn := dw.lineIndex.Position(start)
+	stopLine, stopColumn := dw.lineIndex.Position(stop - 1)
+	return []byte(fmt.Sprintf("%d:%d-%d:%d", startLine, startColumn, stopLine, stopColumn))
+}
+
+// BlockRange returns a range of the contents of the given block in the
+// source. Trailing spaces are not included in the range.
+// BlockRange returns false if the block does not hold its contents like
+// thematic breaks.
+func BlockRange(n ast.Node, source []byte) (int, int, bool) {
+	start, stop := -1, -1
+	add := func(s text.Segment) {
+		if s.IsEmpty() {
+			return
+		}
+		if start < 0 || s.Start < start {
+			start = s.Start
+		}
+		if s.Stop > stop {
+			stop = s.Stop
+		}
+	}
+	_ = ast.Walk(n, func(c ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		switch v := c.(type) {
+		case *ast.Text:
+			add(v.Segment)
+		case *ast.RawHTML:
+			for i := 0; i < v.Segments.Len(); i++ {
+				add(v.Segments.At(i))
+			}
+		default:
+			if c.Type() != ast.TypeInline {
+				lines := c.Lines()
+				for i := 0; i < lines.Len(); i++ {
+					add(lines.At(i))
+				}
+			}
+		}
+		return ast.WalkContinue, nil
+	})
+	for stop > start && util.IsSpace(source[stop-1]) {
+		stop--
+	}
+	return start, stop, start >= 0 && stop > start
+}
@@ -0,0 +1,84 @@
+package html
+
+import (
+	"bytes"
+
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/util"
+)
+
+// TagFilter is an option name used in WithTagFilter.
+const optTagFilter renderer.OptionName = "TagFilter"
+
+type withTagFilter struct {
+}
+
+func (o *withTagFilter) SetConfig(c *renderer.Config) {
+	c.Options[optTagFilter] = true
+}
+
+func (o *withTagFilter) SetHTMLOption(c *Config) {
+	c.TagFilter = true
+}
+
+// WithTagFilter is a functional option that escapes tags disallowed by
+// the GFM tagfilter extension like '<script>' and '<iframe>' in raw HTML.
+// Other raw HTML is rendered as is.
+// This option takes effect only if WithUnsafe or WithRawHTMLRewriter is
+// set.
+func WithTagFilter() interface {
+	renderer.Option
+	Option
+} {
+	return &withTagFilter{}
+}
+
+var filteredTags = map[string]bool{
+	"title":     true,
+	"textarea":  true,
+	"style":     true,
+	"xmp":       true,
+	"iframe":    true,
+	"noembed":   true,
+	"noframes":  true,
+	"script":    true,
+	"plaintext": true,
+}
+
+// isFilteredTag returns true if the given source starts with a start tag
+// or an end tag disallowed by the GFM tagfilter extension.
+func isFilteredTag(source []byte) bool {
+	if len(source) < 3 || source[0] != '<' {
+		return false
+	}
+	i := 1
+	if source[i] == '/' {
+		i++
+	}
+	start := i
+	for i < len(source) && isHTMLTagNameChar(source[i], i == start) {
+		i++
+	}
+	if i == start || i == len(source) {
+		return false
+	}
+	if !filteredTags[string(bytes.ToLower(source[start:i]))] {
+		return false
+	}
+	c := source[i]
+	return util.IsSpace(c) || c == '>' || (c == '/' && i+1 < len(source) && source[i+1] == '>')
+}
+
+// writeFilteredHTML writes the given raw HTML with escaping tags
+// disallowed by the GFM tagfilter extension.
+func writeFilteredHTML(w util.BufWriter, source []byte) {
+	start := 0
+	for i := 0; i < len(source); i++ {
+		if source[i] == '<' && isFilteredTag(source[i:]) {
+			_, _ = w.Write(source[start:i])
+			_, _ = w.WriteString("&lt;")
+			start = i + 1
+		}
+	}
+	_, _ = w.Write(source[start:])
+}
@@ -0,0 +1,234 @@
+// Package json implements renderer that outputs an AST as JSON.
+package json
+
+import (
+	"bufio"
+	ejson "encoding/json"
+	"io"
+	"reflect"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/text"
+)
+
+// A Segment struct is a JSON representation of a text.Segment.
+type Segment struct {
+	// Start is a start position of the segment in the source.
+	Start int `json:"start"`
+
+	// Stop is a stop position of the segment in the source.
+	Stop int `json:"stop"`
+
+	// Padding is a number of leading spaces of the segment.
+	Padding int `json:"padding,omitempty"`
+
+	// Value is a value of the segment.
+	Value string `json:"value"`
+}
+
+// An Attribute struct is a JSON representation of an ast.Attribute.
+type Attribute struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// A Node struct is a JSON representation of an ast.Node.
+// Fields are serialized in the order of declaration, and properties are
+// serialized in the order of names, so outputs are stable.
+type Node struct {
+	// Kind is a kind of the node like "Paragraph".
+	Kind string `json:"kind"`
+
+	// Type is a type of the node: "document", "block" or "inline".
+	Type string `json:"type"`
+
+	// Attributes is a list of attributes of the node.
+	Attributes []Attribute `json:"attributes,omitempty"`
+
+	// Lines is a list of lines of the block node.
+	Lines []Segment `json:"lines,omitempty"`
+
+	// Segment is a segment of the text node.
+	Segment *Segment `json:"segment,omitempty"`
+
+	// Properties is a set of exported fields of the node like
+	// "Level" of headings. Fields that have complex types are omitted.
+	Properties map[string]interface{} `json:"properties,omitempty"`
+
+	// Children is a list of child nodes.
+	Children []*Node `json:"children,omitempty"`
+}
+
+// NewNode returns a new Node that represents the given node and its
+// descendants.
+func NewNode(n ast.Node, source []byte) *Node {
+	v := &Node{
+		Kind: n.Kind().String(),
+		Type: nodeType(n.Type()),
+	}
+	for _, attr := range n.Attributes() {
+		v.Attributes = append(v.Attributes, Attribute{Name: string(attr.Name), Value: string(attr.Value)})
+	}
+	if n.Type() != ast.TypeInline {
+		lines := n.Lines()
+		for i := 0; i < lines.Len(); i++ {
+			v.Lines = append(v.Lines, newSegment(lines.At(i), source))
+		}
+	}
+	v.Properties = properties(n, source)
+	if t, ok := n.(*ast.Text); ok {
+		s := newSegment(t.Segment, source)
+		v.Segment = &s
+		if v.Properties == nil {
+			v.Properties = map[string]interface{}{}
+		}
+		v.Properties["SoftLineBreak"] = t.SoftLineBreak()
+		v.Properties["HardLineBreak"] = t.HardLineBreak()
+		v.Properties["Raw"] = t.IsRaw()
+	}
+	for c := n.FirstChild(); c != nil; c = c.NextSibling() {
+		v.Children = append(v.Children, NewNode(c, source))
+	}
+	return v
+}
+
+func nodeType(t ast.NodeType) string {
+	switch t {
+	case ast.TypeDocument:
+		return "document"
+	case ast.TypeInline:
+		return "inline"
+	default:
+		return "block"
+	}
+}
+
+func newSegment(s text.Segment, source []byte) Segment {
+	return Segment{
+		Start:   s.Start,
+		Stop:    s.Stop,
+		Padding: s.Padding,
+		Value:   string(s.Value(source)),
+	}
+}
+
+var segmentType = reflect.TypeOf(text.Segment{})
+var textType = reflect.TypeOf((*ast.Text)(nil))
+
+// properties returns exported fields of the given node that have simple
+// types. Byte fields like markers are converted into strings.
+func properties(n ast.Node, source []byte) map[string]interface{} {
+	rv := reflect.ValueOf(n)
+	for rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil
+	}
+	m := map[string]interface{}{}
+	structProperties(m, n, rv, source)
+	if len(m) == 0 {
+		return nil
+	}
+	return m
+}
+
+func structProperties(m map[string]interface{}, n ast.Node, rv reflect.Value, source []byte) {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		f := rt.Field(i)
+		if f.Anonymous && f.Type.Kind() == reflect.Struct {
+			// fields of embedded structs like Destination of links
+			structProperties(m, n, rv.Field(i), source)
+			continue
+		}
+		if f.PkgPath != "" {
+			continue
+		}
+		fv := rv.Field(i)
+		switch {
+		case f.Type == segmentType:
+			// segments like ClosureLine of unclosed HTML blocks are {-1, -1}
+			s := fv.Interface().(text.Segment)
+			if _, ok := n.(*ast.Text); !ok && s.Start >= 0 && s.Stop > s.Start {
+				m[f.Name] = newSegment(s, source)
+			}
+		case f.Type == textType:
+			if !fv.IsNil() {
+				m[f.Name] = string(fv.Interface().(*ast.Text).Text(source))
+			}
+		case f.Type.Kind() == reflect.Slice && f.Type.Elem().Kind() == reflect.Uint8:
+			if !fv.IsNil() {
+				m[f.Name] = string(fv.Bytes())
+			}
+		case f.Type.Kind() == reflect.Uint8:
+			m[f.Name] = string([]byte{byte(fv.Uint())})
+		case f.Type.Kind() == reflect.Bool || f.Type.Kind() == reflect.String ||
+			(f.Type.Kind() >= reflect.Int && f.Type.Kind() <= reflect.Float64):
+			m[f.Name] = fv.Interface()
+		}
+	}
+}
+
+// A Config struct has configurations for the JSON renderer.
+type Config struct {
+	// Indent is an indent string for each level of outputs.
+	// If Indent is empty, outputs are not indented.
+	Indent string
+}
+
+// An Option interface sets options for the JSON renderer.
+type Option interface {
+	SetJSONOption(*Config)
+}
+
+type withIndent struct {
+	value string
+}
+
+func (o *withIndent) SetJSONOption(c *Config) {
+	c.Indent = o.value
+}
+
+// WithIndent is a functional option that indents outputs with the given
+// string.
+func WithIndent(indent string) Option {
+	return &withIndent{indent}
+}
+
+type jsonRenderer struct {
+	config Config
+}
+
+// NewRenderer returns a new renderer.Renderer that renders ASTs as JSON.
+// It can be used with goldmark.WithRenderer.
+func NewRenderer(opts ...Option) renderer.Renderer {
+	r := &jsonRenderer{}
+	for _, opt := range opts {
+		opt.SetJSONOption(&r.config)
+	}
+	return r
+}
+
+// AddOptions implements renderer.Renderer.
+// Options for HTML based renderers are ignored.
+func (r *jsonRenderer) AddOptions(opts ...renderer.Option) {
+}
+
+// Render implements renderer.Renderer.
+func (r *jsonRenderer) Render(w io.Writer, source []byte, n ast.Node) error {
+	bw := bufio.NewWriter(w)
+	enc := ejson.NewEncoder(bw)
+	enc.SetEscapeHTML(false)
+	if len(r.config.Indent) != 0 {
+		enc.SetIndent("", r.config.Indent)
+	}
+	if err := enc.Encode(NewNode(n, source)); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,43 @@
+package json_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/renderer/json"
+)
+
+func TestRender(t *testing.T) {
+	markdown := goldmark.New(goldmark.WithRenderer(json.NewRenderer()))
+	var b bytes.Buffer
+	if err := markdown.Convert([]byte("## Hi *there*\n\n- [a](/b \"T\")\n"), &b); err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"kind":"Document","type":"document","children":[` +
+		`{"kind":"Heading","type":"block","lines":[{"start":3,"stop":13,"value":"Hi *there*"}],"properties":{"Level":2},"children":[` +
+		`{"kind":"Text","type":"inline","segment":{"start":3,"stop":6,"value":"Hi "},"properties":{"HardLineBreak":false,"Raw":false,"SoftLineBreak":false}},` +
+		`{"kind":"Emphasis","type":"inline","properties":{"Level":1},"children":[` +
+		`{"kind":"Text","type":"inline","segment":{"start":7,"stop":12,"value":"there"},"properties":{"HardLineBreak":false,"Raw":false,"SoftLineBreak":false}}]}]},` +
+		`{"kind":"List","type":"block","properties":{"IsTight":true,"Marker":"-","Start":0},"children":[` +
+		`{"kind":"ListItem","type":"block","properties":{"Offset":2},"children":[` +
+		`{"kind":"TextBlock","type":"block","lines":[{"start":17,"stop":28,"value":"[a](/b \"T\")"}],"children":[` +
+		`{"kind":"Link","type":"inline","properties":{"Destination":"/b","Title":"T"},"children":[` +
+		`{"kind":"Text","type":"inline","segment":{"start":18,"stop":19,"value":"a"},"properties":{"HardLineBreak":false,"Raw":false,"SoftLineBreak":false}}]}]}]}]}]}` + "\n"
+	if b.String() != expected {
+		t.Errorf("unexpected output:\n%s", b.String())
+	}
+}
+
+func TestRenderHTMLBlock(t *testing.T) {
+	markdown := goldmark.New(goldmark.WithRenderer(json.NewRenderer()))
+	var b bytes.Buffer
+	if err := markdown.Convert([]byte("<div>\n*foo*\n"), &b); err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"kind":"Document","type":"document","children":[` +
+		`{"kind":"HTMLBlock","type":"block","lines":[{"start":0,"stop":6,"value":"<div>\n"},{"start":6,"stop":12,"value":"*foo*\n"}],"properties":{"HTMLBlockType":6}}]}` + "\n"
+	if b.String() != expected {
+		t.Errorf("unexpected output:\n%s", b.String())
+	}
+}
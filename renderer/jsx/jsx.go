@@ -0,0 +1,402 @@
+// Package jsx implements renderer that outputs React/Preact elements as
+// JSX or a JSON element tree.
+package jsx
+
+import (
+	"bufio"
+	"bytes"
+	ejson "encoding/json"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/yuin/goldmark/ast"
+	east "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/util"
+)
+
+// An Element struct represents an element like React.createElement(type,
+// props, ...children).
+type Element struct {
+	// Type is a tag name like "p" or a component name like "CodeBlock".
+	Type string `json:"type"`
+
+	// Props is a set of properties of the element.
+	Props map[string]interface{} `json:"props,omitempty"`
+
+	// Children is a list of child elements. Each child is a string or
+	// an *Element.
+	Children []interface{} `json:"children,omitempty"`
+}
+
+func (e *Element) appendChild(c interface{}) {
+	if s, ok := c.(string); ok && len(e.Children) != 0 {
+		if prev, ok := e.Children[len(e.Children)-1].(string); ok {
+			e.Children[len(e.Children)-1] = prev + s
+			return
+		}
+	}
+	e.Children = append(e.Children, c)
+}
+
+func (e *Element) setProp(name string, value interface{}) {
+	if e.Props == nil {
+		e.Props = map[string]interface{}{}
+	}
+	e.Props[name] = value
+}
+
+// A Format is a format of outputs.
+type Format int
+
+const (
+	// FormatJSON renders elements as a JSON array of element trees.
+	FormatJSON Format = iota
+
+	// FormatJSX renders elements as a JSX fragment.
+	FormatJSX
+)
+
+// A Config struct has configurations for the JSX renderer.
+type Config struct {
+	// Format is a format of outputs.
+	Format Format
+
+	// Components is a mapping from node kinds to component names.
+	// Elements of mapped nodes are rendered as the components instead
+	// of HTML tags.
+	Components map[ast.NodeKind]string
+}
+
+// NewConfig returns a new Config with defaults.
+func NewConfig() Config {
+	return Config{
+		Format:     FormatJSON,
+		Components: map[ast.NodeKind]string{},
+	}
+}
+
+// An Option interface sets options for the JSX renderer.
+type Option interface {
+	SetJSXOption(*Config)
+}
+
+type withFormat struct {
+	value Format
+}
+
+func (o *withFormat) SetJSXOption(c *Config) {
+	c.Format = o.value
+}
+
+// WithFormat is a functional option that sets a format of outputs.
+func WithFormat(f Format) Option {
+	return &withFormat{f}
+}
+
+type withComponent struct {
+	kind ast.NodeKind
+	name string
+}
+
+func (o *withComponent) SetJSXOption(c *Config) {
+	c.Components[o.kind] = o.name
+}
+
+// WithComponent is a functional option that renders nodes of the given
+// kind as the given component like '<Heading level={2}>'.
+// Mapped headings and lists have additional "level" and "ordered" props.
+func WithComponent(kind ast.NodeKind, name string) Option {
+	return &withComponent{kind, name}
+}
+
+type jsxRenderer struct {
+	config Config
+}
+
+// NewRenderer returns a new renderer.Renderer that renders documents as
+// React/Preact elements. It can be used with goldmark.WithRenderer.
+// Raw HTML is omitted.
+func NewRenderer(opts ...Option) renderer.Renderer {
+	r := &jsxRenderer{
+		config: NewConfig(),
+	}
+	for _, opt := range opts {
+		opt.SetJSXOption(&r.config)
+	}
+	return r
+}
+
+// AddOptions implements renderer.Renderer.
+// Options for HTML based renderers are ignored.
+func (r *jsxRenderer) AddOptions(opts ...renderer.Option) {
+}
+
+// Render implements renderer.Renderer.
+func (r *jsxRenderer) Render(w io.Writer, source []byte, n ast.Node) error {
+	root := &Element{}
+	r.appendNode(root, n, source)
+	bw := bufio.NewWriter(w)
+	if r.config.Format == FormatJSX {
+		_, _ = bw.WriteString("<>")
+		for _, c := range root.Children {
+			writeJSX(bw, c)
+		}
+		_, _ = bw.WriteString("</>\n")
+		return bw.Flush()
+	}
+	children := root.Children
+	if children == nil {
+		children = []interface{}{}
+	}
+	enc := ejson.NewEncoder(bw)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(children); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// NewElements returns elements that represent the given node with
+// the given options.
+func NewElements(n ast.Node, source []byte, opts ...Option) []interface{} {
+	r := NewRenderer(opts...).(*jsxRenderer)
+	root := &Element{}
+	r.appendNode(root, n, source)
+	return root.Children
+}
+
+// element returns a new element of the given node, or nil if the node
+// should be flattened into its parent.
+func (r *jsxRenderer) element(n ast.Node, source []byte) *Element {
+	e := &Element{}
+	switch v := n.(type) {
+	case *ast.Document, *ast.TextBlock:
+		return nil
+	case *ast.Paragraph:
+		e.Type = "p"
+	case *ast.Heading:
+		e.Type = "h" + strconv.Itoa(v.Level)
+	case *ast.Blockquote:
+		e.Type = "blockquote"
+	case *ast.List:
+		e.Type = "ul"
+		if v.IsOrdered() {
+			e.Type = "ol"
+			if v.Start != 1 {
+				e.setProp("start", v.Start)
+			}
+		}
+	case *ast.ListItem:
+		e.Type = "li"
+	case *ast.ThemanticBreak:
+		e.Type = "hr"
+	case *ast.CodeBlock, *ast.FencedCodeBlock:
+		e.Type = "pre"
+		code := &Element{Type: "code"}
+		if fcb, ok := n.(*ast.FencedCodeBlock); ok {
+			if lang := fcb.Language(source); lang != nil {
+				code.setProp("className", "language-"+string(lang))
+			}
+		}
+		code.appendChild(string(n.Lines().Value(source)))
+		e.appendChild(code)
+	case *ast.Emphasis:
+		e.Type = "em"
+		if v.Level == 2 {
+			e.Type = "strong"
+		}
+	case *ast.CodeSpan:
+		e.Type = "code"
+	case *ast.Link:
+		e.Type = "a"
+		e.setProp("href", string(util.URLEscape(v.Destination, true)))
+		if v.Title != nil {
+			e.setProp("title", string(unescape(v.Title)))
+		}
+	case *ast.Image:
+		e.Type = "img"
+		e.setProp("src", string(util.URLEscape(v.Destination, true)))
+		e.setProp("alt", string(v.Text(source)))
+		if v.Title != nil {
+			e.setProp("title", string(unescape(v.Title)))
+		}
+	case *ast.AutoLink:
+		e.Type = "a"
+		url := v.URL(source)
+		if v.AutoLinkType == ast.AutoLinkEmail && !bytes.HasPrefix(bytes.ToLower(url), []byte("mailto:")) {
+			url = append([]byte("mailto:"), url...)
+		}
+		e.setProp("href", string(util.URLEscape(url, false)))
+		e.appendChild(string(v.Label(source)))
+	case *east.Strikethrough:
+		e.Type = "del"
+	case *east.Insert:
+		e.Type = "ins"
+	case *east.Highlight:
+		e.Type = "mark"
+	case *east.Spoiler:
+		e.Type = "span"
+		e.setProp("className", "spoiler")
+	case *east.Underline:
+		e.Type = "u"
+	case *east.Table:
+		e.Type = "table"
+	case *east.TableHeader:
+		e.Type = "tr"
+	case *east.TableRow:
+		e.Type = "tr"
+	case *east.TableFooter:
+		e.Type = "tfoot"
+	case *east.TableCell:
+		e.Type = "td"
+		if _, ok := n.Parent().(*east.TableHeader); ok {
+			e.Type = "th"
+		}
+		if v.Alignment != east.AlignNone && v.Alignment != 0 {
+			e.setProp("style", map[string]interface{}{"textAlign": v.Alignment.String()})
+		}
+		if v.ColSpan > 1 {
+			e.setProp("colSpan", v.ColSpan)
+		}
+		if v.RowSpan > 1 {
+			e.setProp("rowSpan", v.RowSpan)
+		}
+	case *east.TaskCheckBox:
+		e.Type = "input"
+		e.setProp("type", "checkbox")
+		e.setProp("checked", v.IsChecked)
+		e.setProp("disabled", true)
+	default:
+		if _, ok := r.config.Components[n.Kind()]; !ok {
+			return nil
+		}
+	}
+	for _, attr := range n.Attributes() {
+		name := string(attr.Name)
+		if name == "class" {
+			name = "className"
+		}
+		e.setProp(name, string(attr.Value))
+	}
+	if name, ok := r.config.Components[n.Kind()]; ok {
+		e.Type = name
+		switch v := n.(type) {
+		case *ast.Heading:
+			e.setProp("level", v.Level)
+		case *ast.List:
+			e.setProp("ordered", v.IsOrdered())
+		}
+	}
+	return e
+}
+
+func (r *jsxRenderer) appendNode(parent *Element, n ast.Node, source []byte) {
+	switch v := n.(type) {
+	case *ast.Text:
+		if v.IsRaw() {
+			parent.appendChild(string(v.Segment.Value(source)))
+		} else {
+			parent.appendChild(string(unescape(v.Segment.Value(source))))
+		}
+		if v.HardLineBreak() {
+			parent.appendChild(&Element{Type: "br"})
+		} else if v.SoftLineBreak() {
+			parent.appendChild("\n")
+		}
+		return
+	case *ast.String:
+		if v.IsRaw() || v.IsCode() {
+			parent.appendChild(string(v.Value))
+		} else {
+			parent.appendChild(string(unescape(v.Value)))
+		}
+		return
+	case *ast.RawHTML, *ast.HTMLBlock:
+		return
+	}
+	e := r.element(n, source)
+	if e == nil {
+		e = parent
+	} else {
+		parent.appendChild(e)
+	}
+	switch n.(type) {
+	case *ast.CodeBlock, *ast.FencedCodeBlock, *ast.AutoLink, *ast.Image:
+		// children are already rendered
+	case *ast.CodeSpan:
+		for c := n.FirstChild(); c != nil; c = c.NextSibling() {
+			if t, ok := c.(*ast.Text); ok {
+				e.appendChild(strings.Replace(string(t.Segment.Value(source)), "\n", " ", -1))
+			}
+		}
+	default:
+		for c := n.FirstChild(); c != nil; c = c.NextSibling() {
+			r.appendNode(e, c, source)
+		}
+	}
+}
+
+func writeJSX(w *bufio.Writer, v interface{}) {
+	switch c := v.(type) {
+	case string:
+		writeJSXText(w, c)
+	case *Element:
+		_ = w.WriteByte('<')
+		_, _ = w.WriteString(c.Type)
+		names := make([]string, 0, len(c.Props))
+		for name := range c.Props {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			_ = w.WriteByte(' ')
+			_, _ = w.WriteString(name)
+			_ = w.WriteByte('=')
+			if s, ok := c.Props[name].(string); ok && !strings.ContainsAny(s, "\"&{}\\\n") {
+				_ = w.WriteByte('"')
+				_, _ = w.WriteString(s)
+				_ = w.WriteByte('"')
+			} else {
+				b, _ := ejson.Marshal(c.Props[name])
+				_ = w.WriteByte('{')
+				_, _ = w.Write(b)
+				_ = w.WriteByte('}')
+			}
+		}
+		if len(c.Children) == 0 {
+			_, _ = w.WriteString(" />")
+			return
+		}
+		_ = w.WriteByte('>')
+		for _, child := range c.Children {
+			writeJSX(w, child)
+		}
+		_, _ = w.WriteString("</")
+		_, _ = w.WriteString(c.Type)
+		_ = w.WriteByte('>')
+	}
+}
+
+// writeJSXText writes the given text. Texts that have characters
+// special in JSX or line breaks that JSX trims are written as string
+// literals like '{"text"}'.
+func writeJSXText(w *bufio.Writer, s string) {
+	if !strings.ContainsAny(s, "{}<>&\n") {
+		_, _ = w.WriteString(s)
+		return
+	}
+	var buf bytes.Buffer
+	enc := ejson.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(s)
+	_ = w.WriteByte('{')
+	_, _ = w.Write(bytes.TrimRight(buf.Bytes(), "\n"))
+	_ = w.WriteByte('}')
+}
+
+func unescape(v []byte) []byte {
+	return util.ResolveEntityNames(util.ResolveNumericReferences(util.UnescapePunctuations(v)))
+}
@@ -0,0 +1,42 @@
+package jsx_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/renderer/jsx"
+)
+
+func TestRenderJSON(t *testing.T) {
+	markdown := goldmark.New(goldmark.WithRenderer(jsx.NewRenderer(
+		jsx.WithComponent(ast.KindHeading, "Heading"),
+	)))
+	var b bytes.Buffer
+	if err := markdown.Convert([]byte("## Hi *there*\n\n- [a](/b \"T\")\n"), &b); err != nil {
+		t.Fatal(err)
+	}
+	expected := `[{"type":"Heading","props":{"level":2},"children":["Hi ",{"type":"em","children":["there"]}]},` +
+		`{"type":"ul","children":[{"type":"li","children":[{"type":"a","props":{"href":"/b","title":"T"},"children":["a"]}]}]}]` + "\n"
+	if b.String() != expected {
+		t.Errorf("unexpected output:\n%s", b.String())
+	}
+}
+
+func TestRenderJSX(t *testing.T) {
+	markdown := goldmark.New(goldmark.WithRenderer(jsx.NewRenderer(
+		jsx.WithFormat(jsx.FormatJSX),
+		jsx.WithComponent(ast.KindFencedCodeBlock, "CodeBlock"),
+	)))
+	var b bytes.Buffer
+	source := []byte("a {b}\nc  \nd ![i](/i.png)\n\n```go\nx\n```\n")
+	if err := markdown.Convert(source, &b); err != nil {
+		t.Fatal(err)
+	}
+	expected := `<><p>{"a {b}\nc"}<br />d <img alt="i" src="/i.png" /></p>` +
+		`<CodeBlock><code className="language-go">{"x\n"}</code></CodeBlock></>` + "\n"
+	if b.String() != expected {
+		t.Errorf("unexpected output:\n%s", b.String())
+	}
+}
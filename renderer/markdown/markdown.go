@@ -0,0 +1,1411 @@
+// Package markdown implements renderer that outputs Markdown text.
+package markdown
+
+import (
+	"bytes"
+	"strconv"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/yuin/goldmark/ast"
+	east "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/util"
+)
+
+// A Config struct has configurations for the Markdown based renderers.
+type Config struct {
+	// BulletMarker is a marker character for bullet lists: '-', '*' or '+'.
+	BulletMarker byte
+
+	// EmphasisMarker is a marker character for emphasis: '*' or '_'.
+	EmphasisMarker byte
+
+	// FenceChar is a fence character for fenced code blocks: '`' or '~'.
+	FenceChar byte
+
+	// WrapWidth is a width that lines in paragraphs are wrapped at.
+	// If WrapWidth is less than or equal to 0, soft line breaks are kept as
+	// they are.
+	WrapWidth int
+
+	// FencedCodeBlocks indicates that indented code blocks should be
+	// rendered as fenced code blocks.
+	FencedCodeBlocks bool
+
+	// TablePadding indicates that table cells should be padded with spaces
+	// so that columns are aligned.
+	TablePadding bool
+
+	// PreserveMarkers indicates that bullet list markers, emphasis markers
+	// and fence characters written in the source should be used rather than
+	// BulletMarker, EmphasisMarker and FenceChar where possible.
+	PreserveMarkers bool
+
+	// Deterministic indicates that attributes should be written sorted by
+	// their names.
+	Deterministic bool
+}
+
+// NewConfig returns a new Config with defaults.
+func NewConfig() Config {
+	return Config{
+		BulletMarker:     '-',
+		EmphasisMarker:   '*',
+		FenceChar:        '`',
+		WrapWidth:        0,
+		FencedCodeBlocks: false,
+		TablePadding:     false,
+		PreserveMarkers:  false,
+	}
+}
+
+// SetOption implements renderer.SetOptioner.
+func (c *Config) SetOption(name renderer.OptionName, value interface{}) {
+	switch name {
+	case optBulletMarker:
+		c.BulletMarker = value.(byte)
+	case optEmphasisMarker:
+		c.EmphasisMarker = value.(byte)
+	case optFenceChar:
+		c.FenceChar = value.(byte)
+	case optWrapWidth:
+		c.WrapWidth = value.(int)
+	case optFencedCodeBlocks:
+		c.FencedCodeBlocks = value.(bool)
+	case optPreserveMarkers:
+		c.PreserveMarkers = true
+	case optTablePadding:
+		c.TablePadding = value.(bool)
+	case renderer.OptDeterministic:
+		c.Deterministic = value.(bool)
+	}
+}
+
+// An Option interface sets options for Markdown based renderers.
+type Option interface {
+	SetMarkdownOption(*Config)
+}
+
+// BulletMarker is an option name used in WithBulletMarker.
+const optBulletMarker renderer.OptionName = "MarkdownBulletMarker"
+
+type withBulletMarker struct {
+	value byte
+}
+
+func (o *withBulletMarker) SetConfig(c *renderer.Config) {
+	c.Options[optBulletMarker] = o.value
+}
+
+func (o *withBulletMarker) SetMarkdownOption(c *Config) {
+	c.BulletMarker = o.value
+}
+
+// WithBulletMarker is a functional option that sets a marker character for
+// bullet lists.
+func WithBulletMarker(marker byte) interface {
+	renderer.Option
+	Option
+} {
+	return &withBulletMarker{marker}
+}
+
+// EmphasisMarker is an option name used in WithEmphasisMarker.
+const optEmphasisMarker renderer.OptionName = "MarkdownEmphasisMarker"
+
+type withEmphasisMarker struct {
+	value byte
+}
+
+func (o *withEmphasisMarker) SetConfig(c *renderer.Config) {
+	c.Options[optEmphasisMarker] = o.value
+}
+
+func (o *withEmphasisMarker) SetMarkdownOption(c *Config) {
+	c.EmphasisMarker = o.value
+}
+
+// WithEmphasisMarker is a functional option that sets a marker character for
+// emphasis.
+func WithEmphasisMarker(marker byte) interface {
+	renderer.Option
+	Option
+} {
+	return &withEmphasisMarker{marker}
+}
+
+// FenceChar is an option name used in WithFenceChar.
+const optFenceChar renderer.OptionName = "MarkdownFenceChar"
+
+type withFenceChar struct {
+	value byte
+}
+
+func (o *withFenceChar) SetConfig(c *renderer.Config) {
+	c.Options[optFenceChar] = o.value
+}
+
+func (o *withFenceChar) SetMarkdownOption(c *Config) {
+	c.FenceChar = o.value
+}
+
+// WithFenceChar is a functional option that sets a fence character for
+// fenced code blocks.
+func WithFenceChar(c byte) interface {
+	renderer.Option
+	Option
+} {
+	return &withFenceChar{c}
+}
+
+// WrapWidth is an option name used in WithWrapWidth.
+const optWrapWidth renderer.OptionName = "MarkdownWrapWidth"
+
+type withWrapWidth struct {
+	value int
+}
+
+func (o *withWrapWidth) SetConfig(c *renderer.Config) {
+	c.Options[optWrapWidth] = o.value
+}
+
+func (o *withWrapWidth) SetMarkdownOption(c *Config) {
+	c.WrapWidth = o.value
+}
+
+// WithWrapWidth is a functional option that wraps lines in paragraphs
+// at the given width.
+func WithWrapWidth(width int) interface {
+	renderer.Option
+	Option
+} {
+	return &withWrapWidth{width}
+}
+
+// FencedCodeBlocks is an option name used in WithFencedCodeBlocks.
+const optFencedCodeBlocks renderer.OptionName = "MarkdownFencedCodeBlocks"
+
+type withFencedCodeBlocks struct {
+}
+
+func (o *withFencedCodeBlocks) SetConfig(c *renderer.Config) {
+	c.Options[optFencedCodeBlocks] = true
+}
+
+func (o *withFencedCodeBlocks) SetMarkdownOption(c *Config) {
+	c.FencedCodeBlocks = true
+}
+
+// WithFencedCodeBlocks is a functional option that renders indented code
+// blocks as fenced code blocks.
+func WithFencedCodeBlocks() interface {
+	renderer.Option
+	Option
+} {
+	return &withFencedCodeBlocks{}
+}
+
+// TablePadding is an option name used in WithTablePadding.
+const optTablePadding renderer.OptionName = "MarkdownTablePadding"
+
+type withTablePadding struct {
+}
+
+func (o *withTablePadding) SetConfig(c *renderer.Config) {
+	c.Options[optTablePadding] = true
+}
+
+func (o *withTablePadding) SetMarkdownOption(c *Config) {
+	c.TablePadding = true
+}
+
+// WithTablePadding is a functional option that pads table cells with spaces
+// so that columns are aligned.
+func WithTablePadding() interface {
+	renderer.Option
+	Option
+} {
+	return &withTablePadding{}
+}
+
+// PreserveMarkers is an option name used in WithPreserveMarkers.
+const optPreserveMarkers renderer.OptionName = "MarkdownPreserveMarkers"
+
+type withPreserveMarkers struct {
+}
+
+func (o *withPreserveMarkers) SetConfig(c *renderer.Config) {
+	c.Options[optPreserveMarkers] = true
+}
+
+func (o *withPreserveMarkers) SetMarkdownOption(c *Config) {
+	c.PreserveMarkers = true
+}
+
+// WithPreserveMarkers is a functional option that renders bullet list
+// markers, emphasis markers and fence characters as they are written in
+// the source where possible, so that documents are changed as little as
+// possible when they are written back.
+func WithPreserveMarkers() interface {
+	renderer.Option
+	Option
+} {
+	return &withPreserveMarkers{}
+}
+
+// referencesOption is a document option key used in
+// WithReferenceDefinitions.
+var referencesOption = ast.NewDocumentOptionKey()
+
+// WithReferenceDefinitions is a functional option for Parse and Convert
+// that keeps reference links as they are and writes link reference
+// definitions collected in the given Context at the end of the document.
+// The Context must also be given with parser.WithContext.
+// Without this option, reference links are rendered as inline links.
+func WithReferenceDefinitions(pc parser.Context) parser.ParseOption {
+	return parser.WithDocumentOption(referencesOption, pc)
+}
+
+// references returns a Context that holds link reference definitions of
+// the document that contains the given node, or nil if it is not given.
+func references(n ast.Node) parser.Context {
+	for ; n.Parent() != nil; n = n.Parent() {
+	}
+	if doc, ok := n.(*ast.Document); ok {
+		if pc, ok := doc.Option(referencesOption).(parser.Context); ok {
+			return pc
+		}
+	}
+	return nil
+}
+
+// A Renderer struct is an implementation of renderer.NodeRenderer that renders
+// nodes as Markdown text.
+type Renderer struct {
+	Config
+}
+
+// NewRenderer returns a new Renderer with given options.
+func NewRenderer(opts ...Option) renderer.NodeRenderer {
+	r := &Renderer{
+		Config: NewConfig(),
+	}
+	for _, opt := range opts {
+		opt.SetMarkdownOption(&r.Config)
+	}
+	return r
+}
+
+// WrapWriter implements renderer.WriterWrapper.
+func (r *Renderer) WrapWriter(w util.BufWriter) util.BufWriter {
+	return NewWriter(w)
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *Renderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	// blocks
+
+	reg.Register(ast.KindDocument, r.renderDocument)
+	reg.Register(ast.KindHeading, r.renderHeading)
+	reg.Register(ast.KindBlockquote, r.renderBlockquote)
+	reg.Register(ast.KindCodeBlock, r.renderCodeBlock)
+	reg.Register(ast.KindFencedCodeBlock, r.renderFencedCodeBlock)
+	reg.Register(ast.KindHTMLBlock, r.renderHTMLBlock)
+	reg.Register(ast.KindList, r.renderList)
+	reg.Register(ast.KindListItem, r.renderListItem)
+	reg.Register(ast.KindParagraph, r.renderParagraph)
+	reg.Register(ast.KindTextBlock, r.renderParagraph)
+	reg.Register(ast.KindThemanticBreak, r.renderThemanticBreak)
+
+	// inlines
+
+	reg.Register(ast.KindAutoLink, r.renderAutoLink)
+	reg.Register(ast.KindCodeSpan, r.renderCodeSpan)
+	reg.Register(ast.KindEmphasis, r.renderEmphasis)
+	reg.Register(ast.KindImage, r.renderLink)
+	reg.Register(ast.KindLink, r.renderLink)
+	reg.Register(ast.KindRawHTML, r.renderRawHTML)
+	reg.Register(ast.KindText, r.renderText)
+	reg.Register(ast.KindString, r.renderString)
+
+	// extensions
+
+	reg.Register(east.KindStrikethrough, r.renderStrikethrough)
+	reg.Register(east.KindTaskCheckBox, r.renderTaskCheckBox)
+	reg.Register(east.KindTable, r.renderTable)
+	reg.Register(east.KindTableHeader, r.renderTableRow)
+	reg.Register(east.KindTableRow, r.renderTableRow)
+	reg.Register(east.KindTableFooter, r.renderTableFooter)
+	reg.Register(east.KindTableCell, r.renderTableCell)
+	reg.Register(east.KindEmbed, r.renderEmbed)
+	reg.Register(east.KindInsert, r.renderInsert)
+	reg.Register(east.KindHighlight, r.renderHighlight)
+	reg.Register(east.KindSpoiler, r.renderSpoiler)
+	reg.Register(east.KindUnderline, r.renderUnderline)
+	reg.Register(east.KindFigure, r.renderFigure)
+	reg.Register(east.KindMedia, r.renderLink)
+	reg.Register(east.KindFigureCaption, r.renderFigureCaption)
+	reg.Register(east.KindCriticAddition, r.renderCriticAddition)
+	reg.Register(east.KindCriticDeletion, r.renderCriticDeletion)
+	reg.Register(east.KindCriticSubstitution, r.renderCriticSubstitution)
+	reg.Register(east.KindCriticComment, r.renderCriticComment)
+}
+
+func writer(w util.BufWriter) *Writer {
+	if mw, ok := w.(*Writer); ok {
+		return mw
+	}
+	return NewWriter(w)
+}
+
+func isEmptyBlock(n ast.Node) bool {
+	return n.Kind() == ast.KindTextBlock && n.Lines().Len() == 0 && !n.HasChildren()
+}
+
+// hasContents returns true if the given node has children other than
+// empty blocks left by link reference definitions.
+func hasContents(n ast.Node) bool {
+	for c := n.FirstChild(); c != nil; c = c.NextSibling() {
+		if !isEmptyBlock(c) {
+			return true
+		}
+	}
+	return false
+}
+
+func isTight(n ast.Node) bool {
+	parent := n.Parent()
+	if parent == nil {
+		return false
+	}
+	if list, ok := parent.(*ast.List); ok {
+		return list.IsTight
+	}
+	if _, ok := parent.(*ast.ListItem); ok {
+		if list, ok := parent.Parent().(*ast.List); ok {
+			return list.IsTight
+		}
+	}
+	return false
+}
+
+// writeSeparator writes a blank line between the given block and
+// its previous block if needed.
+func writeSeparator(w *Writer, n ast.Node) {
+	prev := n.PreviousSibling()
+	for prev != nil && isEmptyBlock(prev) {
+		prev = prev.PreviousSibling()
+	}
+	if prev == nil || isTight(n) {
+		return
+	}
+	_ = w.WriteByte('\n')
+}
+
+func (r *Renderer) renderDocument(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if entering {
+		return ast.WalkContinue, nil
+	}
+	pc := references(node)
+	if pc == nil {
+		return ast.WalkContinue, nil
+	}
+	for i, ref := range pc.References() {
+		if i == 0 && hasContents(node) {
+			_ = w.WriteByte('\n')
+		}
+		_ = w.WriteByte('[')
+		_, _ = w.Write(bytes.Replace(ref.Label(), []byte{'\n'}, []byte{' '}, -1))
+		_, _ = w.WriteString("]: ")
+		writeLinkDestination(w, ref.Destination())
+		if ref.Title() != nil {
+			_ = w.WriteByte(' ')
+			writeLinkTitle(w, ref.Title())
+		}
+		_ = w.WriteByte('\n')
+	}
+	return ast.WalkContinue, nil
+}
+
+func (r *Renderer) renderHeading(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	n := node.(*ast.Heading)
+	mw := writer(w)
+	if entering {
+		writeSeparator(mw, n)
+		mw.Capture()
+		return ast.WalkContinue, nil
+	}
+	contents := mw.Release()
+	if i := bytes.LastIndexByte(contents, '\n'); i > -1 && n.Level <= 2 {
+		// ATX headings can not have multiple lines
+		mw.WriteWrapped(contents, 0)
+		_ = mw.WriteByte('\n')
+		underline := byte('=')
+		if n.Level == 2 {
+			underline = '-'
+		}
+		length := utf8.RuneCount(contents[i+1:])
+		if length < 3 {
+			length = 3
+		}
+		_, _ = mw.Write(bytes.Repeat([]byte{underline}, length))
+		_ = mw.WriteByte('\n')
+		return ast.WalkContinue, nil
+	}
+	_, _ = mw.WriteString("######"[:n.Level])
+	_ = mw.WriteByte(' ')
+	if len(contents) == 0 {
+		// '##' without closing sequences can not be parsed as an empty
+		// heading in some parsers
+		_, _ = mw.WriteString("######"[:n.Level])
+	}
+	_, _ = mw.Write(bytes.Replace(contents, []byte{'\n'}, []byte{' '}, -1))
+	_ = mw.WriteByte('\n')
+	return ast.WalkContinue, nil
+}
+
+func (r *Renderer) renderBlockquote(w util.BufWriter, source []byte, n ast.Node, entering bool) (ast.WalkStatus, error) {
+	mw := writer(w)
+	if entering {
+		writeSeparator(mw, n)
+		mw.PushPrefix("> ", "> ")
+		if !hasContents(n) {
+			_ = mw.WriteByte('\n')
+		}
+	} else {
+		if n.Attributes() != nil && n.LastChild() != nil && n.LastChild().Kind() == ast.KindParagraph {
+			writeAttributes(mw, r.attributes(n))
+			_ = mw.WriteByte('\n')
+		}
+		mw.PopPrefix()
+	}
+	return ast.WalkContinue, nil
+}
+
+func (r *Renderer) renderFigure(w util.BufWriter, source []byte, n ast.Node, entering bool) (ast.WalkStatus, error) {
+	if entering {
+		writeSeparator(writer(w), n)
+	}
+	return ast.WalkContinue, nil
+}
+
+func (r *Renderer) renderFigureCaption(w util.BufWriter, source []byte, n ast.Node, entering bool) (ast.WalkStatus, error) {
+	mw := writer(w)
+	if entering {
+		mw.Capture()
+	} else {
+		mw.WriteWrapped(mw.Release(), r.WrapWidth)
+		_ = mw.WriteByte('\n')
+	}
+	return ast.WalkContinue, nil
+}
+
+// sourceFenceChar returns a fence character of the given fenced code block
+// written in the source, or 0 if it is not found.
+func sourceFenceChar(n *ast.FencedCodeBlock, source []byte) byte {
+	pos := -1
+	if n.Info != nil {
+		pos = n.Info.Segment.Start - 1
+	} else if n.Lines().Len() != 0 {
+		pos = n.Lines().At(0).Start - 1
+	}
+	for ; pos >= 0 && util.IsSpace(source[pos]); pos-- {
+	}
+	if pos >= 0 && (source[pos] == '`' || source[pos] == '~') {
+		return source[pos]
+	}
+	return 0
+}
+
+func (r *Renderer) writeFencedCode(w *Writer, source []byte, n ast.Node, info []byte) {
+	fc := r.FenceChar
+	if fcb, ok := n.(*ast.FencedCodeBlock); ok && r.PreserveMarkers {
+		if c := sourceFenceChar(fcb, source); c != 0 {
+			fc = c
+		}
+	}
+	if fc != '~' && bytes.IndexByte(info, '`') > -1 {
+		fc = '~'
+	}
+	length := 3
+	lines := n.Lines()
+	for i := 0; i < lines.Len(); i++ {
+		line := lines.At(i)
+		value := util.TrimLeftSpace(line.Value(source))
+		j := 0
+		for ; j < len(value) && value[j] == fc; j++ {
+		}
+		if j >= length {
+			length = j + 1
+		}
+	}
+	fence := bytes.Repeat([]byte{fc}, length)
+	_, _ = w.Write(fence)
+	_, _ = w.Write(info)
+	_ = w.WriteByte('\n')
+	for i := 0; i < lines.Len(); i++ {
+		line := lines.At(i)
+		_, _ = w.Write(line.Value(source))
+	}
+	_, _ = w.Write(fence)
+	_ = w.WriteByte('\n')
+}
+
+func (r *Renderer) renderCodeBlock(w util.BufWriter, source []byte, n ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+	mw := writer(w)
+	writeSeparator(mw, n)
+	if r.FencedCodeBlocks {
+		r.writeFencedCode(mw, source, n, nil)
+		return ast.WalkSkipChildren, nil
+	}
+	mw.PushPrefix("    ", "    ")
+	lines := n.Lines()
+	for i := 0; i < lines.Len(); i++ {
+		line := lines.At(i)
+		_, _ = mw.Write(line.Value(source))
+	}
+	mw.PopPrefix()
+	return ast.WalkSkipChildren, nil
+}
+
+func (r *Renderer) renderFencedCodeBlock(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+	n := node.(*ast.FencedCodeBlock)
+	mw := writer(w)
+	writeSeparator(mw, n)
+	var info []byte
+	if n.Info != nil {
+		info = n.Info.Text(source)
+	}
+	r.writeFencedCode(mw, source, n, info)
+	return ast.WalkSkipChildren, nil
+}
+
+func (r *Renderer) renderHTMLBlock(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+	n := node.(*ast.HTMLBlock)
+	mw := writer(w)
+	writeSeparator(mw, n)
+	lines := n.Lines()
+	for i := 0; i < lines.Len(); i++ {
+		line := lines.At(i)
+		_, _ = mw.Write(line.Value(source))
+	}
+	if n.HasClosure() {
+		closure := n.ClosureLine
+		_, _ = mw.Write(closure.Value(source))
+	}
+	return ast.WalkSkipChildren, nil
+}
+
+func (r *Renderer) renderList(w util.BufWriter, source []byte, n ast.Node, entering bool) (ast.WalkStatus, error) {
+	if entering {
+		writeSeparator(writer(w), n)
+	}
+	return ast.WalkContinue, nil
+}
+
+// listMarker returns a marker of the given list.
+// Adjacent lists must have different markers, otherwise they will be
+// merged into one list.
+func (r *Renderer) listMarker(list *ast.List) byte {
+	marker := r.BulletMarker
+	if r.PreserveMarkers && !list.IsOrdered() {
+		marker = list.Marker
+	}
+	alternative := byte('*')
+	if marker != '-' {
+		alternative = '-'
+	}
+	if list.IsOrdered() {
+		marker = list.Marker
+		alternative = '.'
+		if marker != ')' {
+			alternative = ')'
+		}
+	}
+	if prev, ok := list.PreviousSibling().(*ast.List); ok && prev.IsOrdered() == list.IsOrdered() {
+		if r.listMarker(prev) == marker {
+			return alternative
+		}
+	}
+	return marker
+}
+
+func (r *Renderer) renderListItem(w util.BufWriter, source []byte, n ast.Node, entering bool) (ast.WalkStatus, error) {
+	mw := writer(w)
+	if !entering {
+		mw.PopPrefix()
+		return ast.WalkContinue, nil
+	}
+	writeSeparator(mw, n)
+	list := n.Parent().(*ast.List)
+	marker := r.listMarker(list)
+	var prefix string
+	if list.IsOrdered() {
+		index := 0
+		for c := list.FirstChild(); c != nil && c != n; c = c.NextSibling() {
+			index++
+		}
+		prefix = strconv.Itoa(list.Start+index) + string(marker) + " "
+	} else {
+		prefix = string(marker) + " "
+	}
+	mw.PushPrefix(prefix, string(bytes.Repeat([]byte{' '}, len(prefix))))
+	if !hasContents(n) {
+		_ = mw.WriteByte('\n')
+	}
+	return ast.WalkContinue, nil
+}
+
+func (r *Renderer) renderParagraph(w util.BufWriter, source []byte, n ast.Node, entering bool) (ast.WalkStatus, error) {
+	if isEmptyBlock(n) {
+		return ast.WalkSkipChildren, nil
+	}
+	mw := writer(w)
+	if entering {
+		writeSeparator(mw, n)
+		mw.Capture()
+	} else {
+		mw.WriteWrapped(mw.Release(), r.WrapWidth)
+		_ = mw.WriteByte('\n')
+	}
+	return ast.WalkContinue, nil
+}
+
+func (r *Renderer) renderThemanticBreak(w util.BufWriter, source []byte, n ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+	mw := writer(w)
+	writeSeparator(mw, n)
+	if _, ok := n.Parent().(*ast.ListItem); ok && n.PreviousSibling() == nil {
+		// '- ---' is a thematic break, not a list item
+		_, _ = mw.WriteString("___\n")
+	} else {
+		_, _ = mw.WriteString("---\n")
+	}
+	return ast.WalkContinue, nil
+}
+
+func (r *Renderer) renderAutoLink(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+	n := node.(*ast.AutoLink)
+	if n.Protocol != nil {
+		// autolinks found by the linkify extension like 'www.example.com'
+		_, _ = w.Write(n.Label(source))
+		return ast.WalkContinue, nil
+	}
+	_ = w.WriteByte('<')
+	_, _ = w.Write(n.Label(source))
+	_ = w.WriteByte('>')
+	return ast.WalkContinue, nil
+}
+
+func (r *Renderer) renderCodeSpan(w util.BufWriter, source []byte, n ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+	var buf bytes.Buffer
+	for c := n.FirstChild(); c != nil; c = c.NextSibling() {
+		segment := c.(*ast.Text).Segment
+		value := segment.Value(source)
+		if bytes.HasSuffix(value, []byte("\n")) {
+			buf.Write(value[:len(value)-1])
+			if c != n.LastChild() {
+				buf.WriteByte(' ')
+			}
+		} else {
+			buf.Write(value)
+		}
+	}
+	value := buf.Bytes()
+	length := 1
+	for i := 0; i < len(value); {
+		j := i
+		for ; j < len(value) && value[j] == '`'; j++ {
+		}
+		if j-i >= length {
+			length = j - i + 1
+		}
+		if j == i {
+			j++
+		}
+		i = j
+	}
+	fence := bytes.Repeat([]byte{'`'}, length)
+	padding := len(value) != 0 && (value[0] == '`' || value[len(value)-1] == '`' ||
+		(value[0] == ' ' && value[len(value)-1] == ' ' && !util.IsBlank(value)))
+	_, _ = w.Write(fence)
+	if padding {
+		_ = w.WriteByte(' ')
+	}
+	_, _ = w.Write(value)
+	if padding {
+		_ = w.WriteByte(' ')
+	}
+	_, _ = w.Write(fence)
+	return ast.WalkSkipChildren, nil
+}
+
+// emphasisMarker returns a marker of the given emphasis.
+// Markers must not be adjacent to the same characters, otherwise they will
+// be parsed as a different delimiter run.
+// sourceEmphasisMarker returns a marker of the given emphasis written in
+// the source, or 0 if it is not found.
+func sourceEmphasisMarker(n *ast.Emphasis, source []byte) byte {
+	// markers of nested emphasis are written before the first text
+	width := n.Level
+	for c := n.FirstChild(); c != nil; c = c.FirstChild() {
+		switch c := c.(type) {
+		case *ast.Emphasis:
+			width += c.Level
+			continue
+		case *ast.Text:
+			if pos := c.Segment.Start - width; pos >= 0 && (source[pos] == '*' || source[pos] == '_') {
+				return source[pos]
+			}
+		}
+		break
+	}
+	return 0
+}
+
+func (r *Renderer) emphasisMarker(n *ast.Emphasis, source []byte) byte {
+	marker := r.EmphasisMarker
+	if r.PreserveMarkers {
+		if m := sourceEmphasisMarker(n, source); m != 0 {
+			marker = m
+		}
+	}
+	// '**foo**' is a strong emphasis, not an emphasis in an emphasis
+	if p, ok := n.Parent().(*ast.Emphasis); ok && p.FirstChild() == n && p.LastChild() == n &&
+		n.Level == 1 && p.Level == 1 && r.emphasisMarker(p, source) == marker {
+		if marker == '_' {
+			marker = '*'
+		} else {
+			marker = '_'
+		}
+	}
+	// '_' can not open or close intraword emphasis
+	if marker == '_' && !(isFlanking(adjacentRune(n, source, false)) &&
+		isFlanking(adjacentRune(n, source, true))) {
+		marker = '*'
+	}
+	return marker
+}
+
+// adjacentRune returns a rune written just before (or after if next is
+// true) the given inline node, ' ' if the node is at the beginning (or end)
+// of a block, or -1 if it is unknown.
+func adjacentRune(n ast.Node, source []byte, next bool) rune {
+	sibling := n.PreviousSibling()
+	if next {
+		sibling = n.NextSibling()
+	}
+	if sibling == nil {
+		if n.Parent() == nil || n.Parent().Type() == ast.TypeBlock {
+			return ' '
+		}
+		if n.Parent().Kind() == ast.KindEmphasis {
+			// markers of nested emphasis are a part of the same run
+			return adjacentRune(n.Parent(), source, next)
+		}
+		// other inline parents are written with punctuation markers
+		return '*'
+	}
+	t, ok := sibling.(*ast.Text)
+	if !ok {
+		return -1
+	}
+	value := t.Segment.Value(source)
+	if (!next || len(value) == 0) && (t.SoftLineBreak() || t.HardLineBreak()) {
+		return '\n'
+	}
+	if len(value) == 0 {
+		return -1
+	}
+	if next {
+		r, _ := utf8.DecodeRune(value)
+		return r
+	}
+	r, _ := utf8.DecodeLastRune(value)
+	return r
+}
+
+// isFlanking returns true if emphasis markers next to the given rune are
+// not a part of words.
+func isFlanking(r rune) bool {
+	if r < 0 {
+		return false
+	}
+	if r < utf8.RuneSelf && util.IsPunct(byte(r)) {
+		return true
+	}
+	return unicode.IsSpace(r) || unicode.IsPunct(r) || unicode.IsSymbol(r)
+}
+
+func (r *Renderer) renderEmphasis(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	n := node.(*ast.Emphasis)
+	_, _ = w.Write(bytes.Repeat([]byte{r.emphasisMarker(n, source)}, n.Level))
+	return ast.WalkContinue, nil
+}
+
+func writeLinkDestination(w util.BufWriter, destination []byte) {
+	depth := 0
+	angled := false
+	for _, c := range destination {
+		if c == '(' {
+			depth++
+		} else if c == ')' {
+			depth--
+		}
+		if util.IsSpace(c) || c == '<' || c == '>' || depth < 0 {
+			angled = true
+		}
+	}
+	if depth != 0 || len(destination) == 0 {
+		angled = true
+	}
+	if !angled {
+		_, _ = w.Write(destination)
+		return
+	}
+	_ = w.WriteByte('<')
+	for _, c := range destination {
+		if c == '<' || c == '>' {
+			_ = w.WriteByte('\\')
+		}
+		_ = w.WriteByte(c)
+	}
+	_ = w.WriteByte('>')
+}
+
+// writeLinkTitle writes the given link title in double quotes.
+func writeLinkTitle(w util.BufWriter, title []byte) {
+	// titles are kept escaped in the AST
+	_ = w.WriteByte('"')
+	for i := 0; i < len(title); i++ {
+		c := title[i]
+		if c == '\\' && i < len(title)-1 && title[i+1] != '\n' {
+			_, _ = w.Write(title[i : i+2])
+			i++
+			continue
+		}
+		switch c {
+		case '"':
+			_, _ = w.WriteString(`\"`)
+		case '\\':
+			_, _ = w.WriteString(`\\`)
+		case '\n':
+			// continuation lines of titles would be indented by prefixes
+			_, _ = w.WriteString("&#10;")
+		default:
+			_ = w.WriteByte(c)
+		}
+	}
+	_ = w.WriteByte('"')
+}
+
+// linkReference returns a label of the link reference definition that
+// the given link refers, or nil if the link should be written as an inline
+// link.
+func linkReference(n ast.Node) []byte {
+	var label []byte
+	switch n := n.(type) {
+	case *ast.Link:
+		label = n.Reference
+	case *ast.Image:
+		label = n.Reference
+	}
+	if label == nil || references(n) == nil {
+		return nil
+	}
+	return label
+}
+
+func (r *Renderer) renderLink(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	var destination, title []byte
+	switch n := node.(type) {
+	case *ast.Link:
+		destination, title = n.Destination, n.Title
+	case *ast.Image:
+		destination, title = n.Destination, n.Title
+	case *east.Media:
+		destination, title = n.Destination, n.Title
+	}
+	isImage := node.Kind() == ast.KindImage || node.Kind() == east.KindMedia
+	label := linkReference(node)
+	mw := writer(w)
+	if entering {
+		if isImage {
+			_ = w.WriteByte('!')
+		}
+		_ = w.WriteByte('[')
+		if label != nil {
+			mw.Capture()
+		}
+		return ast.WalkContinue, nil
+	}
+	if label != nil {
+		text := mw.Release()
+		_, _ = mw.Write(text)
+		_, _ = mw.WriteString("][")
+		label = bytes.Replace(label, []byte{'\n'}, []byte{' '}, -1)
+		text = bytes.Replace(text, []byte{breakableSpace}, []byte{' '}, -1)
+		text = bytes.Replace(text, []byte{'\n'}, []byte{' '}, -1)
+		if !bytes.Equal(text, label) {
+			_, _ = mw.Write(label)
+		}
+		_ = mw.WriteByte(']')
+	} else {
+		_, _ = w.WriteString("](")
+		writeLinkDestination(w, destination)
+		if title != nil {
+			_ = w.WriteByte(' ')
+			writeLinkTitle(w, title)
+		}
+		_ = w.WriteByte(')')
+	}
+	if isImage {
+		// dimensions and variants can not be written in plain Markdown
+		var dimensions []ast.Attribute
+		for _, name := range []string{"width", "height"} {
+			if value, ok := node.AttributeString(name); ok {
+				dimensions = append(dimensions, ast.Attribute{Name: []byte(name), Value: value})
+			}
+		}
+		if picture, ok := node.Parent().(*east.Picture); ok {
+			for _, source := range picture.Sources {
+				if bytes.Equal(source.Media, east.PrefersDarkColorScheme) {
+					dimensions = append(dimensions, ast.Attribute{Name: []byte("dark"), Value: source.Srcset})
+				}
+			}
+		}
+		if dimensions != nil {
+			writeAttributes(w, dimensions)
+		}
+	}
+	return ast.WalkContinue, nil
+}
+
+func (r *Renderer) renderRawHTML(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkSkipChildren, nil
+	}
+	n := node.(*ast.RawHTML)
+	l := n.Segments.Len()
+	for i := 0; i < l; i++ {
+		segment := n.Segments.At(i)
+		_, _ = w.Write(segment.Value(source))
+	}
+	return ast.WalkSkipChildren, nil
+}
+
+func (r *Renderer) renderText(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+	n := node.(*ast.Text)
+	value := n.Segment.Value(source)
+	if endsLine(n, source) {
+		value = util.TrimRightSpace(value)
+	}
+	wrap := r.WrapWidth > 0 && isWrappable(n)
+	for i := 0; i < len(value); {
+		c := value[i]
+		switch {
+		case c == '\\' && i < len(value)-1 && util.IsPunct(value[i+1]):
+			_, _ = w.Write(value[i : i+2])
+			i += 2
+			continue
+		case c == '`':
+			_, _ = w.WriteString("\\`")
+		case c == '*' || c == '_':
+			j := i
+			for ; j < len(value) && value[j] == c; j++ {
+			}
+			pos := n.Segment.Start
+			if isLiteralDelimiter(c, source, pos+i-1, pos+j) {
+				_, _ = w.Write(value[i:j])
+			} else {
+				for k := i; k < j; k++ {
+					_ = w.WriteByte('\\')
+					_ = w.WriteByte(c)
+				}
+			}
+			i = j
+			continue
+		case c == '[' && i == 0 && n.PreviousSibling() == nil && n.Parent().Type() == ast.TypeBlock:
+			// '[foo]: /url' at the beginning of paragraphs is a link
+			// reference definition
+			_, _ = w.WriteString("\\[")
+		case c == ' ' && wrap && (i == 0 || value[i-1] != '\\'):
+			// '\\' at the end of lines is a hard line break
+			_ = w.WriteByte(breakableSpace)
+		default:
+			_ = w.WriteByte(c)
+		}
+		i++
+	}
+	if n.HardLineBreak() {
+		_, _ = w.WriteString("\\\n")
+	} else if n.SoftLineBreak() {
+		if wrap {
+			_ = w.WriteByte(breakableSpace)
+		} else {
+			_ = w.WriteByte('\n')
+		}
+	}
+	return ast.WalkContinue, nil
+}
+
+// isWrappable returns true if lines can be wrapped at spaces in the given
+// text.
+func isWrappable(n ast.Node) bool {
+	for p := n.Parent(); p != nil; p = p.Parent() {
+		switch p.Kind() {
+		case ast.KindImage, east.KindMedia:
+			return false
+		case ast.KindParagraph, ast.KindTextBlock, east.KindFigureCaption:
+			return true
+		}
+		if p.Type() == ast.TypeBlock {
+			return false
+		}
+	}
+	return false
+}
+
+// isLiteralDelimiter returns true if a run of the given delimiter
+// character between source[before] and source[after] can neither open
+// nor close emphasis.
+func isLiteralDelimiter(c byte, source []byte, before, after int) bool {
+	space := func(pos int) bool {
+		return pos < 0 || pos >= len(source) || util.IsSpace(source[pos])
+	}
+	if space(before) && space(after) {
+		return true
+	}
+	if c != '_' || space(before) || space(after) {
+		return false
+	}
+	b, _ := utf8.DecodeLastRune(source[:before+1])
+	a, _ := utf8.DecodeRune(source[after:])
+	return !isFlanking(b) && !isFlanking(a)
+}
+
+// endsLine returns true if the given text node is followed by a line break
+// with only blank texts between them.
+func endsLine(n *ast.Text, source []byte) bool {
+	for {
+		if n.SoftLineBreak() || n.HardLineBreak() {
+			return true
+		}
+		next, ok := n.NextSibling().(*ast.Text)
+		if !ok || !util.IsBlank(next.Segment.Value(source)) {
+			return false
+		}
+		n = next
+	}
+}
+
+func (r *Renderer) renderString(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+	n := node.(*ast.String)
+	_, _ = w.Write(n.Value)
+	return ast.WalkContinue, nil
+}
+
+func (r *Renderer) renderStrikethrough(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	_, _ = w.WriteString("~~")
+	return ast.WalkContinue, nil
+}
+
+func (r *Renderer) renderInsert(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	_, _ = w.WriteString("++")
+	return ast.WalkContinue, nil
+}
+
+func (r *Renderer) renderSpoiler(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	_, _ = w.WriteString("||")
+	return ast.WalkContinue, nil
+}
+
+func (r *Renderer) renderHighlight(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	_, _ = w.WriteString("==")
+	if !entering && node.Attributes() != nil {
+		writeAttributes(w, r.attributes(node))
+	}
+	return ast.WalkContinue, nil
+}
+
+// attributes returns attributes of the given node in the order they should
+// be written.
+func (r *Renderer) attributes(n ast.Node) []ast.Attribute {
+	if r.Deterministic {
+		return renderer.SortedAttributes(n.Attributes())
+	}
+	return n.Attributes()
+}
+
+// writeAttributes writes the given attributes as an attribute list
+// like '{#id .class key="value"}'.
+func writeAttributes(w util.BufWriter, attrs []ast.Attribute) {
+	_ = w.WriteByte('{')
+	for i, attr := range attrs {
+		if i != 0 {
+			_ = w.WriteByte(' ')
+		}
+		switch string(attr.Name) {
+		case "id":
+			_ = w.WriteByte('#')
+			_, _ = w.Write(attr.Value)
+		case "class":
+			for j, class := range bytes.Fields(attr.Value) {
+				if j != 0 {
+					_ = w.WriteByte(' ')
+				}
+				_ = w.WriteByte('.')
+				_, _ = w.Write(class)
+			}
+		default:
+			_, _ = w.Write(attr.Name)
+			_, _ = w.WriteString(`="`)
+			for _, c := range attr.Value {
+				if c == '"' || c == '\\' {
+					_ = w.WriteByte('\\')
+				}
+				_ = w.WriteByte(c)
+			}
+			_ = w.WriteByte('"')
+		}
+	}
+	_ = w.WriteByte('}')
+}
+
+func (r *Renderer) renderUnderline(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	_, _ = w.WriteString("__")
+	return ast.WalkContinue, nil
+}
+
+func (r *Renderer) renderCriticAddition(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if node.Parent().Kind() == east.KindCriticSubstitution {
+		if !entering {
+			_, _ = w.WriteString("~~}")
+		}
+	} else if entering {
+		_, _ = w.WriteString("{++")
+	} else {
+		_, _ = w.WriteString("++}")
+	}
+	return ast.WalkContinue, nil
+}
+
+func (r *Renderer) renderCriticDeletion(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if node.Parent().Kind() == east.KindCriticSubstitution {
+		if entering {
+			_, _ = w.WriteString("{~~")
+		} else {
+			_, _ = w.WriteString("~>")
+		}
+	} else if entering {
+		_, _ = w.WriteString("{--")
+	} else {
+		_, _ = w.WriteString("--}")
+	}
+	return ast.WalkContinue, nil
+}
+
+func (r *Renderer) renderCriticSubstitution(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	return ast.WalkContinue, nil
+}
+
+func (r *Renderer) renderCriticComment(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if entering {
+		_, _ = w.WriteString("{>>")
+		for c := node.FirstChild(); c != nil; c = c.NextSibling() {
+			_, _ = w.Write(c.Text(source))
+		}
+		_, _ = w.WriteString("<<}")
+	}
+	return ast.WalkSkipChildren, nil
+}
+
+func (r *Renderer) renderTaskCheckBox(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+	n := node.(*east.TaskCheckBox)
+	if n.State != 0 && n.State != ' ' && !n.IsChecked {
+		_, _ = w.WriteString("[" + string(n.State) + "] ")
+	} else if n.IsChecked {
+		_, _ = w.WriteString("[x] ")
+	} else {
+		_, _ = w.WriteString("[ ] ")
+	}
+	return ast.WalkContinue, nil
+}
+
+type tableState struct {
+	alignments []east.Alignment
+	rows       [][][]byte
+	// footer is an index of the first footer row, or -1.
+	footer int
+}
+
+func (r *Renderer) renderTable(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	n := node.(*east.Table)
+	mw := writer(w)
+	if entering {
+		writeSeparator(mw, n)
+		mw.tables = append(mw.tables, &tableState{alignments: n.Alignments, footer: -1})
+		return ast.WalkContinue, nil
+	}
+	table := mw.tables[len(mw.tables)-1]
+	mw.tables = mw.tables[:len(mw.tables)-1]
+	columns := len(table.alignments)
+	for _, row := range table.rows {
+		if len(row) > columns {
+			columns = len(row)
+		}
+	}
+	widths := make([]int, columns)
+	for i := range widths {
+		widths[i] = 3
+	}
+	if r.TablePadding {
+		for _, row := range table.rows {
+			for i, cell := range row {
+				if l := utf8.RuneCount(cell); l > widths[i] {
+					widths[i] = l
+				}
+			}
+		}
+	}
+	for i, row := range table.rows {
+		if i == table.footer {
+			r.writeTableDelimiter(mw, widths, table.alignments)
+		}
+		r.writeTableRow(mw, row, widths, table.alignments)
+		if i == 0 {
+			r.writeTableDelimiter(mw, widths, table.alignments)
+		}
+	}
+	return ast.WalkContinue, nil
+}
+
+func alignmentAt(alignments []east.Alignment, i int) east.Alignment {
+	if i < len(alignments) {
+		return alignments[i]
+	}
+	return east.AlignNone
+}
+
+func (r *Renderer) writeTableRow(w *Writer, row [][]byte, widths []int, alignments []east.Alignment) {
+	_ = w.WriteByte('|')
+	for i, width := range widths {
+		var cell []byte
+		if i < len(row) {
+			cell = row[i]
+		}
+		_ = w.WriteByte(' ')
+		if !r.TablePadding {
+			_, _ = w.Write(cell)
+			_, _ = w.WriteString(" |")
+			continue
+		}
+		padding := width - utf8.RuneCount(cell)
+		left := 0
+		switch alignmentAt(alignments, i) {
+		case east.AlignRight:
+			left = padding
+		case east.AlignCenter:
+			left = padding / 2
+		}
+		_, _ = w.Write(bytes.Repeat([]byte{' '}, left))
+		_, _ = w.Write(cell)
+		_, _ = w.Write(bytes.Repeat([]byte{' '}, padding-left))
+		_, _ = w.WriteString(" |")
+	}
+	_ = w.WriteByte('\n')
+}
+
+func (r *Renderer) writeTableDelimiter(w *Writer, widths []int, alignments []east.Alignment) {
+	_ = w.WriteByte('|')
+	for i, width := range widths {
+		delimiter := bytes.Repeat([]byte{'-'}, width)
+		switch alignmentAt(alignments, i) {
+		case east.AlignLeft:
+			delimiter[0] = ':'
+		case east.AlignRight:
+			delimiter[width-1] = ':'
+		case east.AlignCenter:
+			delimiter[0] = ':'
+			delimiter[width-1] = ':'
+		}
+		_ = w.WriteByte(' ')
+		_, _ = w.Write(delimiter)
+		_, _ = w.WriteString(" |")
+	}
+	_ = w.WriteByte('\n')
+}
+
+func (r *Renderer) renderTableRow(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	mw := writer(w)
+	if entering && len(mw.tables) != 0 {
+		table := mw.tables[len(mw.tables)-1]
+		table.rows = append(table.rows, [][]byte{})
+	}
+	return ast.WalkContinue, nil
+}
+
+func (r *Renderer) renderTableFooter(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	mw := writer(w)
+	if entering && len(mw.tables) != 0 {
+		table := mw.tables[len(mw.tables)-1]
+		table.footer = len(table.rows)
+	}
+	return ast.WalkContinue, nil
+}
+
+func (r *Renderer) renderTableCell(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	mw := writer(w)
+	if entering {
+		mw.Capture()
+		return ast.WalkContinue, nil
+	}
+	cell := bytes.TrimSpace(bytes.Replace(mw.Release(), []byte{'\n'}, []byte{' '}, -1))
+	if len(mw.tables) != 0 {
+		table := mw.tables[len(mw.tables)-1]
+		if l := len(table.rows); l != 0 {
+			table.rows[l-1] = append(table.rows[l-1], cell)
+		}
+	}
+	return ast.WalkContinue, nil
+}
+
+func (r *Renderer) renderEmbed(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+	n := node.(*east.Embed)
+	mw := writer(w)
+	writeSeparator(mw, n)
+	_, _ = mw.Write(n.URL)
+	_ = mw.WriteByte('\n')
+	return ast.WalkSkipChildren, nil
+}
@@ -0,0 +1,273 @@
+package markdown
+
+import (
+	"bytes"
+	"unicode/utf8"
+
+	"github.com/yuin/goldmark/util"
+)
+
+type linePrefix struct {
+	first string
+	rest  string
+	used  bool
+}
+
+// A Writer struct is a util.BufWriter that writes Markdown text.
+// Writer inserts line prefixes like '> ' and list item indentations
+// at the beginning of each line.
+type Writer struct {
+	w           util.BufWriter
+	prefixes    []linePrefix
+	atLineStart bool
+	buffers     []*bytes.Buffer
+	tables      []*tableState
+}
+
+// NewWriter returns a new Writer that writes contents to the given writer.
+func NewWriter(w util.BufWriter) *Writer {
+	return &Writer{
+		w:           w,
+		atLineStart: true,
+	}
+}
+
+// PushPrefix pushes a new line prefix.
+// first is used only for the first line written after this call,
+// rest is used for subsequent lines.
+func (w *Writer) PushPrefix(first, rest string) {
+	w.prefixes = append(w.prefixes, linePrefix{first: first, rest: rest})
+}
+
+// PopPrefix pops the last line prefix.
+func (w *Writer) PopPrefix() {
+	if len(w.prefixes) != 0 {
+		w.prefixes = w.prefixes[:len(w.prefixes)-1]
+	}
+}
+
+// PrefixWidth returns a total width of current line prefixes.
+func (w *Writer) PrefixWidth() int {
+	width := 0
+	for _, p := range w.prefixes {
+		width += len(p.rest)
+	}
+	return width
+}
+
+// AtLineStart returns true if nothing has been written on the current line.
+func (w *Writer) AtLineStart() bool {
+	return w.atLineStart
+}
+
+// Capture starts capturing written contents.
+// Contents written after this call will be returned by Release.
+func (w *Writer) Capture() {
+	w.buffers = append(w.buffers, &bytes.Buffer{})
+}
+
+// Release stops capturing written contents and returns captured contents.
+func (w *Writer) Release() []byte {
+	l := len(w.buffers)
+	if l == 0 {
+		return nil
+	}
+	buf := w.buffers[l-1]
+	w.buffers = w.buffers[:l-1]
+	return buf.Bytes()
+}
+
+// Write implements io.Writer.
+func (w *Writer) Write(p []byte) (int, error) {
+	if l := len(w.buffers); l != 0 {
+		return w.buffers[l-1].Write(p)
+	}
+	for _, c := range p {
+		if err := w.writeByte(c); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+func (w *Writer) writeByte(c byte) error {
+	if w.atLineStart {
+		w.writePrefix(c == '\n')
+		w.atLineStart = false
+	}
+	if c == '\n' {
+		w.atLineStart = true
+	}
+	return w.w.WriteByte(c)
+}
+
+func (w *Writer) writePrefix(blank bool) {
+	var buf []byte
+	for i := range w.prefixes {
+		p := &w.prefixes[i]
+		if p.used {
+			buf = append(buf, p.rest...)
+		} else {
+			buf = append(buf, p.first...)
+			p.used = true
+		}
+	}
+	if blank {
+		buf = util.TrimRightSpace(buf)
+	}
+	_, _ = w.w.Write(buf)
+}
+
+// WriteByte implements util.BufWriter.WriteByte.
+func (w *Writer) WriteByte(c byte) error {
+	_, err := w.Write([]byte{c})
+	return err
+}
+
+// WriteRune implements util.BufWriter.WriteRune.
+func (w *Writer) WriteRune(r rune) (int, error) {
+	return w.Write([]byte(string(r)))
+}
+
+// WriteString implements util.BufWriter.WriteString.
+func (w *Writer) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+// Available implements util.BufWriter.Available.
+func (w *Writer) Available() int {
+	return w.w.Available()
+}
+
+// Buffered implements util.BufWriter.Buffered.
+func (w *Writer) Buffered() int {
+	return w.w.Buffered()
+}
+
+// Flush implements util.BufWriter.Flush.
+func (w *Writer) Flush() error {
+	return w.w.Flush()
+}
+
+// breakableSpace is a space that lines can be wrapped at. Renderers write
+// breakableSpace instead of ' ' for spaces in texts, so that spaces in
+// link destinations, raw HTML and so on are never replaced with new lines.
+// breakableSpace is an invalid byte in UTF-8 texts.
+const breakableSpace = '\xff'
+
+// lineIndent is an indentation for lines that would start a new block.
+// Paragraph continuation lines can be indented with any spaces, and lines
+// indented with 4 or more spaces never start a new block in paragraphs.
+const lineIndent = "    "
+
+// WriteWrapped writes the given inline contents with wrapping lines
+// at the given width. Lines are wrapped only at breakableSpaces that are
+// followed by words that can be safely placed at the beginning of lines.
+// Lines that would start a new block are indented.
+// If width is less than or equal to 0, WriteWrapped writes contents as it is.
+func (w *Writer) WriteWrapped(contents []byte, width int) {
+	available := width - w.PrefixWidth()
+	if available < 1 {
+		available = 1
+	}
+	for i, line := range bytes.Split(contents, []byte{'\n'}) {
+		if i != 0 {
+			_ = w.WriteByte('\n')
+			if !canStartLine(line) {
+				_, _ = w.WriteString(lineIndent)
+			}
+		}
+		column := 0
+		first := i == 0
+		head := line
+		for len(line) != 0 {
+			j := bytes.IndexByte(line, breakableSpace)
+			if j < 0 {
+				j = len(line)
+			}
+			word := line[:j]
+			_, _ = w.Write(word)
+			column += utf8.RuneCount(word)
+			line = line[j:]
+			k := 0
+			for ; k < len(line) && line[k] == breakableSpace; k++ {
+			}
+			if k == 0 {
+				break
+			}
+			line = line[k:]
+			if column == 0 || len(line) == 0 {
+				// spaces at the beginning and the end of lines are not
+				// contents
+				continue
+			}
+			next := line
+			if l := bytes.IndexByte(next, breakableSpace); l > -1 {
+				next = next[:l]
+			}
+			// the first line of paragraphs can start a new block by itself
+			if width > 0 && column+k+utf8.RuneCount(next) > available && canStartLine(next) &&
+				(!first || canEndLine(head[:len(head)-len(line)-k])) {
+				_ = w.WriteByte('\n')
+				column = 0
+				first = false
+				continue
+			}
+			_, _ = w.Write(bytes.Repeat([]byte{' '}, k))
+			column += k
+		}
+	}
+}
+
+// canEndLine returns true if the given first line of a paragraph does not
+// start a new block when it is followed by a new line.
+func canEndLine(line []byte) bool {
+	line = bytes.Replace(line, []byte{breakableSpace}, []byte{' '}, -1)
+	if len(line) != 0 && line[0] == '<' && line[len(line)-1] == '>' {
+		return false
+	}
+	count := 0
+	for _, c := range line {
+		if c == '*' || c == '-' || c == '_' {
+			if c != line[0] {
+				return true
+			}
+			count++
+		} else if c != ' ' {
+			return true
+		}
+	}
+	return count < 3
+}
+
+// canStartLine returns true if the given line does not start a new block
+// when it is placed at the beginning of a line in paragraphs.
+func canStartLine(line []byte) bool {
+	word := line
+	if i := bytes.IndexAny(word, " \t\xff"); i > -1 {
+		word = word[:i]
+	}
+	if len(word) == 0 {
+		return len(line) == 0
+	}
+	switch word[0] {
+	case '>', '<', '|':
+		return false
+	case '`', '~':
+		return len(word) < 3 || word[1] != word[0] || word[2] != word[0]
+	case '-', '+', '*', '=', '#', '_':
+		for _, c := range word {
+			if c != word[0] {
+				return true
+			}
+		}
+		return false
+	}
+	i := 0
+	for ; i < len(word) && util.IsNumeric(word[i]); i++ {
+	}
+	if i != 0 && i < len(word) && (word[i] == '.' || word[i] == ')') {
+		return false
+	}
+	return true
+}
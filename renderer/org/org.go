@@ -0,0 +1,268 @@
+// Package org implements renderer that outputs Emacs Org mode text.
+package org
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/yuin/goldmark/ast"
+	east "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/util"
+)
+
+type orgRenderer struct {
+}
+
+// NewRenderer returns a new renderer.Renderer that renders documents as
+// Org mode text. It can be used with goldmark.WithRenderer.
+//
+// Headings are rendered with stars, code blocks as '#+BEGIN_SRC' blocks,
+// blockquotes as '#+BEGIN_QUOTE' blocks and links like '[[url][desc]]'.
+// Raw HTML is rendered as HTML export blocks and '@@html:...@@' snippets.
+func NewRenderer() renderer.Renderer {
+	return &orgRenderer{}
+}
+
+// AddOptions implements renderer.Renderer.
+// Options for HTML based renderers are ignored.
+func (r *orgRenderer) AddOptions(opts ...renderer.Option) {
+}
+
+// Render implements renderer.Renderer.
+func (r *orgRenderer) Render(w io.Writer, source []byte, n ast.Node) error {
+	bw := bufio.NewWriter(w)
+	for _, line := range renderBlock(n, source) {
+		_, _ = bw.WriteString(strings.TrimRight(line, " "))
+		_ = bw.WriteByte('\n')
+	}
+	return bw.Flush()
+}
+
+func renderBlock(n ast.Node, source []byte) []string {
+	switch v := n.(type) {
+	case *ast.Document:
+		return renderBlocks(n, source, true)
+	case *ast.Heading:
+		text := strings.Replace(renderInlines(n, source), "\n", " ", -1)
+		return []string{strings.Repeat("*", v.Level) + " " + text}
+	case *ast.Paragraph, *ast.TextBlock:
+		return strings.Split(renderInlines(n, source), "\n")
+	case *ast.Blockquote:
+		lines := []string{"#+BEGIN_QUOTE"}
+		lines = append(lines, renderBlocks(n, source, true)...)
+		return append(lines, "#+END_QUOTE")
+	case *ast.List:
+		var lines []string
+		i := v.Start
+		for c := n.FirstChild(); c != nil; c = c.NextSibling() {
+			marker := "- "
+			if v.IsOrdered() {
+				marker = strconv.Itoa(i) + ". "
+				i++
+			}
+			if !v.IsTight && len(lines) != 0 {
+				lines = append(lines, "")
+			}
+			lines = append(lines, renderListItem(c, source, marker)...)
+		}
+		return lines
+	case *ast.CodeBlock, *ast.FencedCodeBlock:
+		var lines []string
+		if fcb, ok := n.(*ast.FencedCodeBlock); ok && fcb.Info != nil {
+			lines = append(lines, "#+BEGIN_SRC "+string(fcb.Info.Text(source)))
+		} else {
+			lines = append(lines, "#+BEGIN_EXAMPLE")
+		}
+		lines = append(lines, codeLines(n, source)...)
+		if strings.HasPrefix(lines[0], "#+BEGIN_SRC") {
+			return append(lines, "#+END_SRC")
+		}
+		return append(lines, "#+END_EXAMPLE")
+	case *ast.ThemanticBreak:
+		return []string{"-----"}
+	case *ast.HTMLBlock:
+		lines := []string{"#+BEGIN_EXPORT html"}
+		lines = append(lines, codeLines(n, source)...)
+		if v.HasClosure() {
+			closure := v.ClosureLine
+			lines = append(lines, strings.TrimRight(string(closure.Value(source)), "\r\n"))
+		}
+		return append(lines, "#+END_EXPORT")
+	case *east.Table:
+		return renderTable(n, source)
+	}
+	if fc := n.FirstChild(); fc != nil && fc.Type() == ast.TypeInline {
+		return strings.Split(renderInlines(n, source), "\n")
+	}
+	return renderBlocks(n, source, true)
+}
+
+// renderBlocks renders children of the given node. If separate is true,
+// blocks are separated by blank lines.
+func renderBlocks(n ast.Node, source []byte, separate bool) []string {
+	var lines []string
+	for c := n.FirstChild(); c != nil; c = c.NextSibling() {
+		b := renderBlock(c, source)
+		if len(b) == 0 {
+			continue
+		}
+		if separate && len(lines) != 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, b...)
+	}
+	return lines
+}
+
+// renderListItem renders a list item. Lines except the first line are
+// indented by the width of the marker.
+func renderListItem(n ast.Node, source []byte, marker string) []string {
+	list, _ := n.Parent().(*ast.List)
+	lines := renderBlocks(n, source, list != nil && !list.IsTight)
+	if len(lines) == 0 {
+		return []string{marker}
+	}
+	indent := strings.Repeat(" ", len(marker))
+	for i := range lines {
+		if i == 0 {
+			lines[i] = marker + lines[i]
+		} else if len(lines[i]) != 0 {
+			lines[i] = indent + lines[i]
+		}
+	}
+	return lines
+}
+
+func codeLines(n ast.Node, source []byte) []string {
+	var lines []string
+	for i := 0; i < n.Lines().Len(); i++ {
+		line := n.Lines().At(i)
+		lines = append(lines, strings.TrimRight(string(line.Value(source)), "\r\n"))
+	}
+	return lines
+}
+
+func renderTable(n ast.Node, source []byte) []string {
+	var lines []string
+	for row := n.FirstChild(); row != nil; row = row.NextSibling() {
+		var cells []string
+		for cell := row.FirstChild(); cell != nil; cell = cell.NextSibling() {
+			text := strings.Replace(renderInlines(cell, source), "\n", " ", -1)
+			cells = append(cells, strings.Replace(text, "|", "\\vert{}", -1))
+		}
+		lines = append(lines, "| "+strings.Join(cells, " | ")+" |")
+		if row.Kind() == east.KindTableHeader {
+			separators := make([]string, len(cells))
+			for i, cell := range cells {
+				separators[i] = strings.Repeat("-", len(cell)+2)
+			}
+			lines = append(lines, "|"+strings.Join(separators, "+")+"|")
+		}
+	}
+	return lines
+}
+
+func renderInlines(n ast.Node, source []byte) string {
+	var buf bytes.Buffer
+	writeInlines(&buf, n, source)
+	return strings.TrimSpace(buf.String())
+}
+
+// writeMarkup writes children of the given node enclosed by the given
+// Org mode markup character like '*'.
+func writeMarkup(buf *bytes.Buffer, n ast.Node, source []byte, markup byte) {
+	_ = buf.WriteByte(markup)
+	writeInlines(buf, n, source)
+	_ = buf.WriteByte(markup)
+}
+
+func writeLink(buf *bytes.Buffer, url, desc string) {
+	_, _ = buf.WriteString("[[")
+	_, _ = buf.WriteString(url)
+	if len(desc) != 0 && desc != url {
+		_, _ = buf.WriteString("][")
+		_, _ = buf.WriteString(desc)
+	}
+	_, _ = buf.WriteString("]]")
+}
+
+func writeInlines(buf *bytes.Buffer, n ast.Node, source []byte) {
+	for c := n.FirstChild(); c != nil; c = c.NextSibling() {
+		switch v := c.(type) {
+		case *ast.Text:
+			if v.IsRaw() {
+				_, _ = buf.Write(v.Segment.Value(source))
+			} else {
+				_, _ = buf.Write(unescape(v.Segment.Value(source)))
+			}
+			if v.HardLineBreak() {
+				// trailing spaces may be written by preceding nodes
+				buf.Truncate(len(bytes.TrimRight(buf.Bytes(), " ")))
+				_, _ = buf.WriteString(" \\\\\n")
+			} else if v.SoftLineBreak() {
+				_ = buf.WriteByte('\n')
+			}
+		case *ast.String:
+			if v.IsRaw() || v.IsCode() {
+				_, _ = buf.Write(v.Value)
+			} else {
+				_, _ = buf.Write(unescape(v.Value))
+			}
+		case *ast.CodeSpan:
+			_ = buf.WriteByte('~')
+			for t := c.FirstChild(); t != nil; t = t.NextSibling() {
+				if text, ok := t.(*ast.Text); ok {
+					_, _ = buf.Write(bytes.Replace(text.Segment.Value(source), []byte{'\n'}, []byte{' '}, -1))
+				}
+			}
+			_ = buf.WriteByte('~')
+		case *ast.Emphasis:
+			if v.Level == 2 {
+				writeMarkup(buf, c, source, '*')
+			} else {
+				writeMarkup(buf, c, source, '/')
+			}
+		case *east.Strikethrough:
+			writeMarkup(buf, c, source, '+')
+		case *east.Underline:
+			writeMarkup(buf, c, source, '_')
+		case *ast.RawHTML:
+			_, _ = buf.WriteString("@@html:")
+			_, _ = buf.Write(v.Segments.Value(source))
+			_, _ = buf.WriteString("@@")
+		case *ast.Link:
+			var desc bytes.Buffer
+			writeInlines(&desc, c, source)
+			writeLink(buf, string(util.URLEscape(v.Destination, true)), flatten(desc.String()))
+		case *ast.Image:
+			// links without descriptions are displayed as inline images
+			writeLink(buf, string(util.URLEscape(v.Destination, true)), "")
+		case *ast.AutoLink:
+			url := v.URL(source)
+			if v.AutoLinkType == ast.AutoLinkEmail && !bytes.HasPrefix(bytes.ToLower(url), []byte("mailto:")) {
+				url = append([]byte("mailto:"), url...)
+			}
+			writeLink(buf, string(url), "")
+		case *east.TaskCheckBox:
+			if v.IsChecked {
+				_, _ = buf.WriteString("[X] ")
+			} else {
+				_, _ = buf.WriteString("[ ] ")
+			}
+		default:
+			writeInlines(buf, c, source)
+		}
+	}
+}
+
+func flatten(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+func unescape(v []byte) []byte {
+	return util.ResolveEntityNames(util.ResolveNumericReferences(util.UnescapePunctuations(v)))
+}
@@ -0,0 +1,65 @@
+package org_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/renderer/org"
+)
+
+func TestRender(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithRenderer(org.NewRenderer()),
+		goldmark.WithExtensions(extension.GFM),
+	)
+	source := []byte(`# Title
+
+Some **bold**, *italic*, ~~deleted~~ and ` + "`code`" + `
+with a [link](https://example.com) and ![img](a.png) image  
+next line.
+
+> quoted
+
+- [x] done
+- item
+  1. nested
+     text
+
+| a | b |
+|---|---|
+| c | d |
+
+` + "```go\nfunc main() {}\n```\n")
+	var b bytes.Buffer
+	if err := markdown.Convert(source, &b); err != nil {
+		t.Fatal(err)
+	}
+	expected := `* Title
+
+Some *bold*, /italic/, +deleted+ and ~code~
+with a [[https://example.com][link]] and [[a.png]] image \\
+next line.
+
+#+BEGIN_QUOTE
+quoted
+#+END_QUOTE
+
+- [X] done
+- item
+  1. nested
+     text
+
+| a | b |
+|---+---|
+| c | d |
+
+#+BEGIN_SRC go
+func main() {}
+#+END_SRC
+`
+	if b.String() != expected {
+		t.Errorf("unexpected output:\n%s", b.String())
+	}
+}
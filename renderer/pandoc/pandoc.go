@@ -0,0 +1,361 @@
+// Package pandoc implements converters between goldmark ASTs and Pandoc
+// JSON ASTs, so goldmark can be used as a reader or a writer in Pandoc
+// pipelines like 'pandoc -t json | ... | pandoc -f json'.
+package pandoc
+
+import (
+	"bufio"
+	"bytes"
+	ejson "encoding/json"
+	"io"
+	"strings"
+
+	"github.com/yuin/goldmark/ast"
+	east "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/util"
+)
+
+// APIVersion is a version of the Pandoc JSON AST written by this package.
+var APIVersion = []int{1, 23, 1}
+
+// An Element struct represents a Pandoc AST element like
+// {"t":"Str","c":"text"}.
+type Element struct {
+	// T is a type of the element like "Para".
+	T string `json:"t"`
+
+	// C is contents of the element. Elements like "Space" have no contents.
+	C interface{} `json:"c,omitempty"`
+}
+
+// A Document struct represents a Pandoc JSON AST document.
+type Document struct {
+	// APIVersion is a version of the pandoc-types API.
+	APIVersion []int `json:"pandoc-api-version"`
+
+	// Meta is metadata of the document.
+	Meta map[string]interface{} `json:"meta"`
+
+	// Blocks is a list of block elements.
+	Blocks []*Element `json:"blocks"`
+}
+
+// NewDocument returns a new Document converted from the given goldmark AST.
+//
+// Nodes that do not have Pandoc equivalents are converted into their
+// children, and task list checkboxes are converted into '☐' and '☒'
+// as Pandoc does.
+func NewDocument(n ast.Node, source []byte) *Document {
+	return &Document{
+		APIVersion: APIVersion,
+		Meta:       map[string]interface{}{},
+		Blocks:     exportBlocks(n, source),
+	}
+}
+
+type pandocRenderer struct {
+}
+
+// NewRenderer returns a new renderer.Renderer that renders documents as
+// Pandoc JSON ASTs. It can be used with goldmark.WithRenderer.
+func NewRenderer() renderer.Renderer {
+	return &pandocRenderer{}
+}
+
+// AddOptions implements renderer.Renderer.
+// Options for HTML based renderers are ignored.
+func (r *pandocRenderer) AddOptions(opts ...renderer.Option) {
+}
+
+// Render implements renderer.Renderer.
+func (r *pandocRenderer) Render(w io.Writer, source []byte, n ast.Node) error {
+	bw := bufio.NewWriter(w)
+	enc := ejson.NewEncoder(bw)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(NewDocument(n, source)); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+func newAttr(n ast.Node) []interface{} {
+	id := ""
+	classes := []string{}
+	values := [][]string{}
+	for _, attr := range n.Attributes() {
+		switch string(attr.Name) {
+		case "id":
+			id = string(attr.Value)
+		case "class":
+			classes = append(classes, strings.Fields(string(attr.Value))...)
+		default:
+			values = append(values, []string{string(attr.Name), string(attr.Value)})
+		}
+	}
+	return []interface{}{id, classes, values}
+}
+
+func emptyAttr() []interface{} {
+	return []interface{}{"", []string{}, [][]string{}}
+}
+
+func exportBlocks(n ast.Node, source []byte) []*Element {
+	blocks := []*Element{}
+	for c := n.FirstChild(); c != nil; c = c.NextSibling() {
+		blocks = append(blocks, exportBlock(c, source)...)
+	}
+	return blocks
+}
+
+func exportBlock(n ast.Node, source []byte) []*Element {
+	switch v := n.(type) {
+	case *ast.Paragraph:
+		return []*Element{{T: "Para", C: exportInlines(n, source)}}
+	case *ast.TextBlock:
+		return []*Element{{T: "Plain", C: exportInlines(n, source)}}
+	case *ast.Heading:
+		return []*Element{{T: "Header", C: []interface{}{v.Level, newAttr(n), exportInlines(n, source)}}}
+	case *ast.ThemanticBreak:
+		return []*Element{{T: "HorizontalRule"}}
+	case *ast.CodeBlock, *ast.FencedCodeBlock:
+		attr := emptyAttr()
+		if fcb, ok := n.(*ast.FencedCodeBlock); ok {
+			if lang := fcb.Language(source); lang != nil {
+				attr[1] = []string{string(lang)}
+			}
+		}
+		code := strings.TrimRight(string(lines(n, source)), "\n")
+		return []*Element{{T: "CodeBlock", C: []interface{}{attr, code}}}
+	case *ast.Blockquote:
+		return []*Element{{T: "BlockQuote", C: exportBlocks(n, source)}}
+	case *ast.List:
+		items := [][]*Element{}
+		for c := n.FirstChild(); c != nil; c = c.NextSibling() {
+			items = append(items, exportBlocks(c, source))
+		}
+		if !v.IsOrdered() {
+			return []*Element{{T: "BulletList", C: items}}
+		}
+		delim := "Period"
+		if v.Marker == ')' {
+			delim = "OneParen"
+		}
+		attrs := []interface{}{v.Start, &Element{T: "Decimal"}, &Element{T: delim}}
+		return []*Element{{T: "OrderedList", C: []interface{}{attrs, items}}}
+	case *ast.HTMLBlock:
+		value := lines(n, source)
+		if v.HasClosure() {
+			value = append(value, v.ClosureLine.Value(source)...)
+		}
+		return []*Element{{T: "RawBlock", C: []interface{}{"html", string(value)}}}
+	case *east.Table:
+		return []*Element{exportTable(v, source)}
+	}
+	if fc := n.FirstChild(); fc != nil && fc.Type() == ast.TypeInline {
+		return []*Element{{T: "Para", C: exportInlines(n, source)}}
+	}
+	return exportBlocks(n, source)
+}
+
+func lines(n ast.Node, source []byte) []byte {
+	var buf bytes.Buffer
+	for i := 0; i < n.Lines().Len(); i++ {
+		line := n.Lines().At(i)
+		_, _ = buf.Write(line.Value(source))
+	}
+	return buf.Bytes()
+}
+
+var alignments = map[east.Alignment]string{
+	east.AlignLeft:   "AlignLeft",
+	east.AlignRight:  "AlignRight",
+	east.AlignCenter: "AlignCenter",
+	east.AlignNone:   "AlignDefault",
+}
+
+func exportTable(n *east.Table, source []byte) *Element {
+	colSpecs := []interface{}{}
+	for _, alignment := range n.Alignments {
+		colSpecs = append(colSpecs, []interface{}{&Element{T: alignments[alignment]}, &Element{T: "ColWidthDefault"}})
+	}
+	head := []interface{}{}
+	body := []interface{}{}
+	foot := []interface{}{}
+	var rows []ast.Node
+	for row := n.FirstChild(); row != nil; row = row.NextSibling() {
+		if row.Kind() == east.KindTableFooter {
+			for r := row.FirstChild(); r != nil; r = r.NextSibling() {
+				rows = append(rows, r)
+			}
+			continue
+		}
+		rows = append(rows, row)
+	}
+	for _, row := range rows {
+		cells := []interface{}{}
+		for cell := row.FirstChild(); cell != nil; cell = cell.NextSibling() {
+			blocks := []*Element{}
+			if cell.HasChildren() {
+				blocks = append(blocks, &Element{T: "Plain", C: exportInlines(cell, source)})
+			}
+			// alignments of cells are specified by columns
+			cells = append(cells, []interface{}{emptyAttr(), &Element{T: "AlignDefault"}, 1, 1, blocks})
+		}
+		r := []interface{}{emptyAttr(), cells}
+		if row.Kind() == east.KindTableHeader {
+			head = append(head, r)
+		} else if row.Parent().Kind() == east.KindTableFooter {
+			foot = append(foot, r)
+		} else {
+			body = append(body, r)
+		}
+	}
+	caption := []interface{}{nil, []interface{}{}}
+	return &Element{T: "Table", C: []interface{}{
+		newAttr(n),
+		caption,
+		colSpecs,
+		[]interface{}{emptyAttr(), head},
+		[]interface{}{[]interface{}{emptyAttr(), 0, []interface{}{}, body}},
+		[]interface{}{emptyAttr(), foot},
+	}}
+}
+
+// An inlines is a list of inline elements. Texts are split into "Str"
+// and "Space" elements.
+type inlines []*Element
+
+func (l *inlines) appendText(s string) {
+	for len(s) != 0 {
+		i := strings.IndexAny(s, " \t")
+		if i < 0 {
+			i = len(s)
+		}
+		if i != 0 {
+			l.appendStr(s[:i])
+		}
+		if i < len(s) {
+			l.appendSpace()
+			i++
+		}
+		s = s[i:]
+	}
+}
+
+func (l *inlines) appendStr(s string) {
+	if last := l.last(); last != nil && last.T == "Str" {
+		last.C = last.C.(string) + s
+		return
+	}
+	*l = append(*l, &Element{T: "Str", C: s})
+}
+
+func (l *inlines) appendSpace() {
+	if last := l.last(); last == nil || last.T == "Space" || last.T == "SoftBreak" || last.T == "LineBreak" {
+		return
+	}
+	*l = append(*l, &Element{T: "Space"})
+}
+
+func (l *inlines) appendBreak(t string) {
+	l.trimSpace()
+	*l = append(*l, &Element{T: t})
+}
+
+func (l *inlines) trimSpace() {
+	if last := l.last(); last != nil && last.T == "Space" {
+		*l = (*l)[:len(*l)-1]
+	}
+}
+
+func (l *inlines) last() *Element {
+	if len(*l) == 0 {
+		return nil
+	}
+	return (*l)[len(*l)-1]
+}
+
+func exportInlines(n ast.Node, source []byte) []*Element {
+	l := inlines{}
+	writeInlines(&l, n, source)
+	l.trimSpace()
+	return l
+}
+
+func writeInlines(l *inlines, n ast.Node, source []byte) {
+	for c := n.FirstChild(); c != nil; c = c.NextSibling() {
+		switch v := c.(type) {
+		case *ast.Text:
+			if v.IsRaw() {
+				l.appendText(string(v.Segment.Value(source)))
+			} else {
+				l.appendText(string(unescape(v.Segment.Value(source))))
+			}
+			if v.HardLineBreak() {
+				l.appendBreak("LineBreak")
+			} else if v.SoftLineBreak() {
+				l.appendBreak("SoftBreak")
+			}
+		case *ast.String:
+			if v.IsRaw() || v.IsCode() {
+				l.appendText(string(v.Value))
+			} else {
+				l.appendText(string(unescape(v.Value)))
+			}
+		case *ast.CodeSpan:
+			var buf bytes.Buffer
+			for t := c.FirstChild(); t != nil; t = t.NextSibling() {
+				if text, ok := t.(*ast.Text); ok {
+					_, _ = buf.Write(bytes.Replace(text.Segment.Value(source), []byte{'\n'}, []byte{' '}, -1))
+				}
+			}
+			*l = append(*l, &Element{T: "Code", C: []interface{}{newAttr(c), buf.String()}})
+		case *ast.Emphasis:
+			t := "Emph"
+			if v.Level == 2 {
+				t = "Strong"
+			}
+			*l = append(*l, &Element{T: t, C: exportInlines(c, source)})
+		case *east.Strikethrough:
+			*l = append(*l, &Element{T: "Strikeout", C: exportInlines(c, source)})
+		case *east.Underline:
+			*l = append(*l, &Element{T: "Underline", C: exportInlines(c, source)})
+		case *ast.RawHTML:
+			*l = append(*l, &Element{T: "RawInline", C: []interface{}{"html", string(v.Segments.Value(source))}})
+		case *ast.Link:
+			target := []interface{}{string(unescape(v.Destination)), string(unescape(v.Title))}
+			*l = append(*l, &Element{T: "Link", C: []interface{}{newAttr(c), exportInlines(c, source), target}})
+		case *ast.Image:
+			target := []interface{}{string(unescape(v.Destination)), string(unescape(v.Title))}
+			*l = append(*l, &Element{T: "Image", C: []interface{}{newAttr(c), exportInlines(c, source), target}})
+		case *ast.AutoLink:
+			label := string(v.Label(source))
+			url := string(v.URL(source))
+			attr := emptyAttr()
+			if v.AutoLinkType == ast.AutoLinkEmail {
+				attr[1] = []string{"email"}
+				if !strings.HasPrefix(strings.ToLower(url), "mailto:") {
+					url = "mailto:" + url
+				}
+			} else {
+				attr[1] = []string{"uri"}
+			}
+			target := []interface{}{url, ""}
+			*l = append(*l, &Element{T: "Link", C: []interface{}{attr, []*Element{{T: "Str", C: label}}, target}})
+		case *east.TaskCheckBox:
+			if v.IsChecked {
+				l.appendStr("☒")
+			} else {
+				l.appendStr("☐")
+			}
+			l.appendSpace()
+		default:
+			writeInlines(l, c, source)
+		}
+	}
+}
+
+func unescape(v []byte) []byte {
+	return util.ResolveEntityNames(util.ResolveNumericReferences(util.UnescapePunctuations(v)))
+}
@@ -0,0 +1,114 @@
+package pandoc_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/renderer/pandoc"
+	"github.com/yuin/goldmark/text"
+)
+
+func TestRender(t *testing.T) {
+	markdown := goldmark.New(goldmark.WithRenderer(pandoc.NewRenderer()))
+	var b bytes.Buffer
+	if err := markdown.Convert([]byte("## Hi *there*\n\n1) [a](/b \"T\")\n   `c`\n"), &b); err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"pandoc-api-version":[1,23,1],"meta":{},"blocks":[` +
+		`{"t":"Header","c":[2,["",[],[]],[{"t":"Str","c":"Hi"},{"t":"Space"},{"t":"Emph","c":[{"t":"Str","c":"there"}]}]]},` +
+		`{"t":"OrderedList","c":[[1,{"t":"Decimal"},{"t":"OneParen"}],[[{"t":"Plain","c":[` +
+		`{"t":"Link","c":[["",[],[]],[{"t":"Str","c":"a"}],["/b","T"]]},{"t":"SoftBreak"},` +
+		`{"t":"Code","c":[["",[],[]],"c"]}]}]]]}]}` + "\n"
+	if b.String() != expected {
+		t.Errorf("unexpected output:\n%s", b.String())
+	}
+}
+
+func TestParse(t *testing.T) {
+	data := []byte(`{"pandoc-api-version":[1,23,1],"meta":{},"blocks":[` +
+		`{"t":"Header","c":[1,["top",[],[]],[{"t":"Str","c":"*Hi*"},{"t":"Space"},{"t":"Quoted","c":[{"t":"DoubleQuote"},[{"t":"Str","c":"there"}]]}]]},` +
+		`{"t":"Para","c":[{"t":"Strong","c":[{"t":"Str","c":"a&amp;b"}]},{"t":"LineBreak"},` +
+		`{"t":"Span","c":[["",[],[]],[{"t":"Link","c":[["",[],[]],[{"t":"Str","c":"l"}],["/u","t"]]}]]},{"t":"Space"},` +
+		`{"t":"RawInline","c":["latex","\\LaTeX"]},{"t":"Code","c":[["",[],[]],"<c>"]}]},` +
+		`{"t":"BulletList","c":[[{"t":"Plain","c":[{"t":"Str","c":"x"}]}],[{"t":"Plain","c":[{"t":"Str","c":"y"}]}]]},` +
+		`{"t":"CodeBlock","c":[["",["go"],[]],"a\nb"]},` +
+		`{"t":"Table","c":[["",[],[]],[null,[]],[[{"t":"AlignRight"},{"t":"ColWidthDefault"}]],` +
+		`[["",[],[]],[[["",[],[]],[[["",[],[]],{"t":"AlignDefault"},1,1,[{"t":"Plain","c":[{"t":"Str","c":"h"}]}]]]]]],` +
+		`[[["",[],[]],0,[],[[["",[],[]],[[["",[],[]],{"t":"AlignDefault"},1,1,[{"t":"Plain","c":[{"t":"Str","c":"d"}]}]]]]]]],` +
+		`[["",[],[]],[]]]}]}`)
+	doc, source, err := pandoc.Parse(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	markdown := goldmark.New(goldmark.WithExtensions(extension.Table))
+	var b bytes.Buffer
+	if err := markdown.Renderer().Render(&b, source, doc); err != nil {
+		t.Fatal(err)
+	}
+	expected := `<h1 id="top">*Hi* “there”</h1>
+<p><strong>a&amp;amp;b</strong><br>
+<a href="/u" title="t">l</a> <code>&lt;c&gt;</code></p>
+<ul>
+<li>x</li>
+<li>y</li>
+</ul>
+<pre><code class="language-go">a
+b
+</code></pre>
+<table>
+<thead>
+<tr>
+<th align="right">h</th>
+</tr>
+</thead>
+<tbody>
+<tr>
+<td align="right">d</td>
+</tr>
+</tbody>
+</table>
+`
+	if b.String() != expected {
+		t.Errorf("unexpected output:\n%s", b.String())
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, data := range []string{
+		`{"pandoc-api-version":[1,20],"meta":{},"blocks":[]}`,
+		`{"pandoc-api-version":[1,23,1],"meta":{},"blocks":[{"t":"Para","c":[{"t":"Note","c":[]}]}]}`,
+		`{"pandoc-api-version":[1,23,1],"meta":{},"blocks":[{"t":"Header","c":[1]}]}`,
+	} {
+		if _, _, err := pandoc.Parse([]byte(data)); err == nil {
+			t.Errorf("%s: expected an error", data)
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	source := []byte("# Title\n\n> quoted *text* with [a link](/url)\n\n3. one\n4. two\n\n<div>\nraw\n</div>\n\n~~~\ncode\n~~~\n")
+	markdown := goldmark.New(goldmark.WithRendererOptions(html.WithUnsafe()))
+	var expected bytes.Buffer
+	if err := markdown.Convert(source, &expected); err != nil {
+		t.Fatal(err)
+	}
+	var data bytes.Buffer
+	doc := markdown.Parser().Parse(text.NewReader(source))
+	if err := pandoc.NewRenderer().Render(&data, source, doc); err != nil {
+		t.Fatal(err)
+	}
+	imported, importedSource, err := pandoc.Parse(data.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b bytes.Buffer
+	if err := markdown.Renderer().Render(&b, importedSource, imported); err != nil {
+		t.Fatal(err)
+	}
+	if b.String() != expected.String() {
+		t.Errorf("unexpected output:\n%s\nexpected:\n%s", b.String(), expected.String())
+	}
+}
@@ -0,0 +1,566 @@
+package pandoc
+
+import (
+	"bytes"
+	ejson "encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/yuin/goldmark/ast"
+	east "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// ErrUnsupportedVersion is returned by Parse if the given document is
+// written in a Pandoc JSON AST version older than 1.21.
+var ErrUnsupportedVersion = errors.New("pandoc: unsupported pandoc-api-version")
+
+type rawElement struct {
+	T string           `json:"t"`
+	C ejson.RawMessage `json:"c"`
+}
+
+type rawDocument struct {
+	APIVersion []int        `json:"pandoc-api-version"`
+	Blocks     []rawElement `json:"blocks"`
+}
+
+// Parse converts the given Pandoc JSON AST into a goldmark AST.
+// Texts of the AST refer to a source generated from the document, so Parse
+// also returns it. They can be rendered like
+//
+//	doc, source, err := pandoc.Parse(data)
+//	err = markdown.Renderer().Render(w, source, doc)
+//
+// Spans, divs and figures are converted into their contents, and quotes
+// and maths are converted into texts. Raw blocks and inlines of formats
+// other than HTML are removed, and captions of tables are ignored.
+// Parse returns an error if the document has elements that can not be
+// converted like footnotes and definition lists.
+func Parse(data []byte) (ast.Node, []byte, error) {
+	var d rawDocument
+	if err := ejson.Unmarshal(data, &d); err != nil {
+		return nil, nil, err
+	}
+	if len(d.APIVersion) < 2 || d.APIVersion[0] != 1 || d.APIVersion[1] < 21 {
+		return nil, nil, ErrUnsupportedVersion
+	}
+	p := &importer{}
+	doc := ast.NewDocument()
+	if err := p.blocks(doc, d.Blocks); err != nil {
+		return nil, nil, err
+	}
+	return doc, p.source, nil
+}
+
+// unmarshalTuple unmarshals a JSON array into the given values.
+// nil values are skipped.
+func unmarshalTuple(data ejson.RawMessage, vs ...interface{}) error {
+	var parts []ejson.RawMessage
+	if err := ejson.Unmarshal(data, &parts); err != nil {
+		return err
+	}
+	if len(parts) != len(vs) {
+		return fmt.Errorf("pandoc: expected %d values but got %d", len(vs), len(parts))
+	}
+	for i, v := range vs {
+		if v == nil {
+			continue
+		}
+		if err := ejson.Unmarshal(parts[i], v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func unsupported(t string) error {
+	return fmt.Errorf("pandoc: unsupported element %q", t)
+}
+
+type importer struct {
+	source []byte
+}
+
+// segment appends the given value to the source and returns a segment of it.
+func (p *importer) segment(value string) text.Segment {
+	start := len(p.source)
+	p.source = append(p.source, value...)
+	return text.NewSegment(start, len(p.source))
+}
+
+// escape escapes punctuations in the given text, so the text is rendered
+// as it is.
+func escape(s string) string {
+	var buf bytes.Buffer
+	for i := 0; i < len(s); i++ {
+		if util.IsPunct(s[i]) {
+			_ = buf.WriteByte('\\')
+		}
+		_ = buf.WriteByte(s[i])
+	}
+	return buf.String()
+}
+
+func setAttributes(n ast.Node, data ejson.RawMessage) error {
+	var id string
+	var classes []string
+	var values [][]string
+	if err := unmarshalTuple(data, &id, &classes, &values); err != nil {
+		return err
+	}
+	if len(id) != 0 {
+		n.SetAttribute([]byte("id"), []byte(id))
+	}
+	if len(classes) != 0 {
+		n.SetAttribute([]byte("class"), []byte(strings.Join(classes, " ")))
+	}
+	for _, v := range values {
+		if len(v) == 2 {
+			n.SetAttribute([]byte(v[0]), []byte(v[1]))
+		}
+	}
+	return nil
+}
+
+func (p *importer) blocks(parent ast.Node, elements []rawElement) error {
+	for _, e := range elements {
+		if err := p.block(parent, e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *importer) block(parent ast.Node, e rawElement) error {
+	switch e.T {
+	case "Plain":
+		n := ast.NewTextBlock()
+		parent.AppendChild(parent, n)
+		return p.inlines(n, e.C)
+	case "Para":
+		n := ast.NewParagraph()
+		parent.AppendChild(parent, n)
+		return p.inlines(n, e.C)
+	case "LineBlock":
+		var lines []ejson.RawMessage
+		if err := ejson.Unmarshal(e.C, &lines); err != nil {
+			return err
+		}
+		n := ast.NewParagraph()
+		parent.AppendChild(parent, n)
+		for i, line := range lines {
+			if err := p.inlines(n, line); err != nil {
+				return err
+			}
+			if i != len(lines)-1 {
+				t := ast.NewTextSegment(p.segment(""))
+				t.SetSoftLineBreak(true)
+				t.SetHardLineBreak(true)
+				n.AppendChild(n, t)
+			}
+		}
+	case "Header":
+		var level int
+		var attr, inlines ejson.RawMessage
+		if err := unmarshalTuple(e.C, &level, &attr, &inlines); err != nil {
+			return err
+		}
+		n := ast.NewHeading(level)
+		if err := setAttributes(n, attr); err != nil {
+			return err
+		}
+		parent.AppendChild(parent, n)
+		return p.inlines(n, inlines)
+	case "HorizontalRule":
+		parent.AppendChild(parent, ast.NewThemanticBreak())
+	case "CodeBlock":
+		var classes []string
+		var code string
+		var attr ejson.RawMessage
+		if err := unmarshalTuple(e.C, &attr, &code); err != nil {
+			return err
+		}
+		if err := unmarshalTuple(attr, nil, &classes, nil); err != nil {
+			return err
+		}
+		var n ast.Node
+		if len(classes) != 0 {
+			n = ast.NewFencedCodeBlock(ast.NewTextSegment(p.segment(classes[0])))
+		} else {
+			n = ast.NewCodeBlock()
+		}
+		for _, line := range strings.SplitAfter(code, "\n") {
+			if len(line) != 0 {
+				n.Lines().Append(p.segment(strings.TrimSuffix(line, "\n") + "\n"))
+			}
+		}
+		parent.AppendChild(parent, n)
+	case "RawBlock":
+		var format, value string
+		if err := unmarshalTuple(e.C, &format, &value); err != nil {
+			return err
+		}
+		if !isHTML(format) {
+			return nil
+		}
+		n := ast.NewHTMLBlock(ast.HTMLBlockType7)
+		for _, line := range strings.SplitAfter(value, "\n") {
+			if len(line) != 0 {
+				n.Lines().Append(p.segment(strings.TrimSuffix(line, "\n") + "\n"))
+			}
+		}
+		parent.AppendChild(parent, n)
+	case "BlockQuote":
+		var blocks []rawElement
+		if err := ejson.Unmarshal(e.C, &blocks); err != nil {
+			return err
+		}
+		n := ast.NewBlockquote()
+		parent.AppendChild(parent, n)
+		return p.blocks(n, blocks)
+	case "BulletList":
+		var items [][]rawElement
+		if err := ejson.Unmarshal(e.C, &items); err != nil {
+			return err
+		}
+		return p.list(parent, ast.NewList('-'), items)
+	case "OrderedList":
+		var attrs []ejson.RawMessage
+		var items [][]rawElement
+		if err := unmarshalTuple(e.C, &attrs, &items); err != nil {
+			return err
+		}
+		var start int
+		var delim rawElement
+		if len(attrs) != 3 {
+			return fmt.Errorf("pandoc: invalid list attributes")
+		}
+		if err := ejson.Unmarshal(attrs[0], &start); err != nil {
+			return err
+		}
+		if err := ejson.Unmarshal(attrs[2], &delim); err != nil {
+			return err
+		}
+		marker := byte('.')
+		if delim.T == "OneParen" || delim.T == "TwoParens" {
+			marker = ')'
+		}
+		n := ast.NewList(marker)
+		n.Start = start
+		return p.list(parent, n, items)
+	case "Div":
+		var blocks []rawElement
+		if err := unmarshalTuple(e.C, nil, &blocks); err != nil {
+			return err
+		}
+		return p.blocks(parent, blocks)
+	case "Figure":
+		var blocks []rawElement
+		if err := unmarshalTuple(e.C, nil, nil, &blocks); err != nil {
+			return err
+		}
+		return p.blocks(parent, blocks)
+	case "Table":
+		return p.table(parent, e.C)
+	default:
+		return unsupported(e.T)
+	}
+	return nil
+}
+
+func isHTML(format string) bool {
+	return format == "html" || format == "html4" || format == "html5"
+}
+
+func (p *importer) list(parent ast.Node, n *ast.List, items [][]rawElement) error {
+	n.IsTight = true
+	for _, item := range items {
+		for _, b := range item {
+			if b.T == "Para" {
+				n.IsTight = false
+			}
+		}
+	}
+	parent.AppendChild(parent, n)
+	for _, item := range items {
+		li := ast.NewListItem(0)
+		n.AppendChild(n, li)
+		if err := p.blocks(li, item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var importAlignments = map[string]east.Alignment{
+	"AlignLeft":    east.AlignLeft,
+	"AlignRight":   east.AlignRight,
+	"AlignCenter":  east.AlignCenter,
+	"AlignDefault": east.AlignNone,
+}
+
+func (p *importer) table(parent ast.Node, data ejson.RawMessage) error {
+	var attr, head, foot ejson.RawMessage
+	var colSpecs [][]rawElement
+	var bodies []ejson.RawMessage
+	if err := unmarshalTuple(data, &attr, nil, &colSpecs, &head, &bodies, &foot); err != nil {
+		return err
+	}
+	n := east.NewTable()
+	if err := setAttributes(n, attr); err != nil {
+		return err
+	}
+	for _, spec := range colSpecs {
+		alignment := east.AlignNone
+		if len(spec) != 0 {
+			alignment = importAlignments[spec[0].T]
+		}
+		n.Alignments = append(n.Alignments, alignment)
+	}
+	var headRows, rows []ejson.RawMessage
+	if err := unmarshalTuple(head, nil, &headRows); err != nil {
+		return err
+	}
+	// goldmark tables have exactly one header row, so other rows of the
+	// head are rendered as body rows
+	if len(headRows) != 0 {
+		rows = append(rows, headRows[1:]...)
+	}
+	for _, body := range bodies {
+		var intermediateHead, bodyRows []ejson.RawMessage
+		if err := unmarshalTuple(body, nil, nil, &intermediateHead, &bodyRows); err != nil {
+			return err
+		}
+		rows = append(rows, intermediateHead...)
+		rows = append(rows, bodyRows...)
+	}
+	var footRows []ejson.RawMessage
+	if err := unmarshalTuple(foot, nil, &footRows); err != nil {
+		return err
+	}
+
+	header := east.NewTableRow(n.Alignments)
+	if len(headRows) != 0 {
+		if err := p.tableRow(header, headRows[0], n.Alignments); err != nil {
+			return err
+		}
+	} else {
+		for _, alignment := range n.Alignments {
+			cell := east.NewTableCell()
+			cell.Alignment = alignment
+			header.AppendChild(header, cell)
+		}
+	}
+	n.AppendChild(n, east.NewTableHeader(header))
+	for _, data := range rows {
+		row := east.NewTableRow(n.Alignments)
+		if err := p.tableRow(row, data, n.Alignments); err != nil {
+			return err
+		}
+		n.AppendChild(n, row)
+	}
+	if len(footRows) != 0 {
+		footer := east.NewTableFooter()
+		for _, data := range footRows {
+			row := east.NewTableRow(n.Alignments)
+			if err := p.tableRow(row, data, n.Alignments); err != nil {
+				return err
+			}
+			footer.AppendChild(footer, row)
+		}
+		n.AppendChild(n, footer)
+	}
+	parent.AppendChild(parent, n)
+	return nil
+}
+
+func (p *importer) tableRow(row *east.TableRow, data ejson.RawMessage, alignments []east.Alignment) error {
+	var cells []ejson.RawMessage
+	if err := unmarshalTuple(data, nil, &cells); err != nil {
+		return err
+	}
+	for i, data := range cells {
+		var align rawElement
+		var blocks []rawElement
+		if err := unmarshalTuple(data, nil, &align, nil, nil, &blocks); err != nil {
+			return err
+		}
+		cell := east.NewTableCell()
+		cell.Alignment = importAlignments[align.T]
+		if cell.Alignment == east.AlignNone && i < len(alignments) {
+			cell.Alignment = alignments[i]
+		}
+		for _, b := range blocks {
+			if b.T != "Plain" && b.T != "Para" {
+				return unsupported(b.T)
+			}
+			if err := p.inlines(cell, b.C); err != nil {
+				return err
+			}
+		}
+		row.AppendChild(row, cell)
+	}
+	return nil
+}
+
+// An inlineImporter converts inline elements into children of the parent.
+// Successive texts are converted into one text node.
+type inlineImporter struct {
+	*importer
+	parent ast.Node
+	text   bytes.Buffer
+}
+
+func (p *inlineImporter) flush(softLineBreak, hardLineBreak bool) {
+	if p.text.Len() == 0 && !softLineBreak {
+		return
+	}
+	t := ast.NewTextSegment(p.segment(p.text.String()))
+	t.SetSoftLineBreak(softLineBreak)
+	t.SetHardLineBreak(hardLineBreak)
+	p.parent.AppendChild(p.parent, t)
+	p.text.Reset()
+}
+
+func (p *importer) inlines(parent ast.Node, data ejson.RawMessage) error {
+	var elements []rawElement
+	if err := ejson.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+	ip := &inlineImporter{importer: p, parent: parent}
+	for _, e := range elements {
+		if err := ip.inline(e); err != nil {
+			return err
+		}
+	}
+	ip.flush(false, false)
+	return nil
+}
+
+func (p *inlineImporter) inline(e rawElement) error {
+	switch e.T {
+	case "Str":
+		var s string
+		if err := ejson.Unmarshal(e.C, &s); err != nil {
+			return err
+		}
+		_, _ = p.text.WriteString(escape(s))
+		return nil
+	case "Space":
+		_ = p.text.WriteByte(' ')
+		return nil
+	case "SoftBreak":
+		p.flush(true, false)
+		return nil
+	case "LineBreak":
+		p.flush(true, true)
+		return nil
+	case "Math":
+		var typ rawElement
+		var s string
+		if err := unmarshalTuple(e.C, &typ, &s); err != nil {
+			return err
+		}
+		delim := "$"
+		if typ.T == "DisplayMath" {
+			delim = "$$"
+		}
+		_, _ = p.text.WriteString(escape(delim + s + delim))
+		return nil
+	case "Quoted":
+		var typ rawElement
+		var inlines ejson.RawMessage
+		if err := unmarshalTuple(e.C, &typ, &inlines); err != nil {
+			return err
+		}
+		open, close := "“", "”"
+		if typ.T == "SingleQuote" {
+			open, close = "‘", "’"
+		}
+		_, _ = p.text.WriteString(open)
+		p.flush(false, false)
+		if err := p.inlines(p.parent, inlines); err != nil {
+			return err
+		}
+		_, _ = p.text.WriteString(close)
+		return nil
+	}
+	p.flush(false, false)
+	switch e.T {
+	case "Emph", "Strong", "Strikeout", "Underline":
+		var n ast.Node
+		switch e.T {
+		case "Emph":
+			n = ast.NewEmphasis(1)
+		case "Strong":
+			n = ast.NewEmphasis(2)
+		case "Strikeout":
+			n = east.NewStrikethrough()
+		default:
+			n = east.NewUnderline()
+		}
+		p.parent.AppendChild(p.parent, n)
+		return p.inlines(n, e.C)
+	case "Superscript", "Subscript", "SmallCaps":
+		return p.inlines(p.parent, e.C)
+	case "Span", "Cite":
+		var inlines ejson.RawMessage
+		if err := unmarshalTuple(e.C, nil, &inlines); err != nil {
+			return err
+		}
+		return p.inlines(p.parent, inlines)
+	case "Code":
+		var attr ejson.RawMessage
+		var s string
+		if err := unmarshalTuple(e.C, &attr, &s); err != nil {
+			return err
+		}
+		n := ast.NewCodeSpan()
+		if err := setAttributes(n, attr); err != nil {
+			return err
+		}
+		n.AppendChild(n, ast.NewRawTextSegment(p.segment(s)))
+		p.parent.AppendChild(p.parent, n)
+	case "RawInline":
+		var format, s string
+		if err := unmarshalTuple(e.C, &format, &s); err != nil {
+			return err
+		}
+		if isHTML(format) {
+			n := ast.NewRawHTML()
+			n.Segments.Append(p.segment(s))
+			p.parent.AppendChild(p.parent, n)
+		}
+	case "Link", "Image":
+		var attr, inlines ejson.RawMessage
+		var target []string
+		if err := unmarshalTuple(e.C, &attr, &inlines, &target); err != nil {
+			return err
+		}
+		if len(target) != 2 {
+			return fmt.Errorf("pandoc: invalid link target")
+		}
+		link := ast.NewLink()
+		link.Destination = []byte(target[0])
+		if len(target[1]) != 0 {
+			link.Title = []byte(escape(target[1]))
+		}
+		if err := p.inlines(link, inlines); err != nil {
+			return err
+		}
+		var n ast.Node = link
+		if e.T == "Image" {
+			n = ast.NewImage(link)
+		}
+		if err := setAttributes(n, attr); err != nil {
+			return err
+		}
+		p.parent.AppendChild(p.parent, n)
+	default:
+		return unsupported(e.T)
+	}
+	return nil
+}
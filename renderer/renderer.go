@@ -3,9 +3,12 @@ package renderer
 
 import (
 	"bufio"
+	"bytes"
 	"io"
+	"sort"
 
 	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
 	"github.com/yuin/goldmark/util"
 
 	"sync"
@@ -15,6 +18,9 @@ import (
 type Config struct {
 	Options       map[OptionName]interface{}
 	NodeRenderers util.PrioritizedSlice
+	Deterministic bool
+	BeforeHooks   []NodeHookFunc
+	AfterHooks    []NodeHookFunc
 }
 
 // NewConfig returns a new Config
@@ -62,6 +68,129 @@ func WithOption(name OptionName, value interface{}) Option {
 	return &withOption{name, value}
 }
 
+// OptDeterministic is an option name used in WithDeterministicOutput.
+// NodeRenderers that write attributes should sort them with
+// SortedAttributes when this option is set to true.
+const OptDeterministic OptionName = "Deterministic"
+
+type withDeterministicOutput struct {
+}
+
+func (o *withDeterministicOutput) SetConfig(c *Config) {
+	c.Deterministic = true
+	c.Options[OptDeterministic] = true
+}
+
+// WithDeterministicOutput is a functional option that sorts attributes of
+// nodes by their names when rendering them. Nodes are not modified.
+// Without this option, attributes are rendered in the order they are set,
+// so output depends on the order of parsers and extensions.
+// With this option, identical input rendered with identical options
+// always produces byte-identical output, which is useful for generated
+// documents kept under version control.
+func WithDeterministicOutput() Option {
+	return &withDeterministicOutput{}
+}
+
+type withNodeHooks struct {
+	before NodeHookFunc
+	after  NodeHookFunc
+}
+
+func (o *withNodeHooks) SetConfig(c *Config) {
+	if o.before != nil {
+		c.BeforeHooks = append(c.BeforeHooks, o.before)
+	}
+	if o.after != nil {
+		c.AfterHooks = append([]NodeHookFunc{o.after}, c.AfterHooks...)
+	}
+}
+
+// WithNodeHooks is a functional option that adds hooks that are called
+// before and after each node is rendered. Either of hooks can be nil.
+// Before hooks are called in the order they are added, and after hooks
+// are called in the reverse order, so hooks added later are nested in
+// hooks added earlier like middlewares.
+//
+// If a before hook returns ast.WalkSkipChildren, the node and its
+// descendants are not rendered and after hooks are not called for the
+// node. This is useful for instrumentation, wrapping nodes in extra
+// markups and skipping nodes conditionally without re-implementing
+// NodeRenderers.
+func WithNodeHooks(before, after NodeHookFunc) Option {
+	return &withNodeHooks{before, after}
+}
+
+// nodeRendererFuncsOption is a document option key used in
+// WithDocumentNodeRenderers.
+var nodeRendererFuncsOption = ast.NewDocumentOptionKey()
+
+type funcRegisterer map[ast.NodeKind]NodeRendererFunc
+
+func (r funcRegisterer) Register(kind ast.NodeKind, f NodeRendererFunc) {
+	r[kind] = f
+}
+
+// WithDocumentNodeRenderers is a functional option for Parse and Convert
+// that overrides NodeRendererFuncs of the given NodeRenderers while the
+// parsed document is rendered(i.e. md.Convert(source, &buf,
+// renderer.WithDocumentNodeRenderers(imageRenderer))).
+// This allows you to swap renderers per call without creating new
+// Markdown objects.
+//
+// Renderer options are not set to the given NodeRenderers, and writers are
+// not wrapped even if they implement WriterWrapper, so the given
+// NodeRenderers must be configured by themselves.
+func WithDocumentNodeRenderers(nrs ...NodeRenderer) parser.ParseOption {
+	funcs := funcRegisterer{}
+	for _, nr := range nrs {
+		nr.RegisterFuncs(funcs)
+	}
+	return func(c *parser.ParseConfig) {
+		if c.Options == nil {
+			c.Options = map[ast.DocumentOptionKey]interface{}{}
+		}
+		merged := funcRegisterer{}
+		if prev, ok := c.Options[nodeRendererFuncsOption].(funcRegisterer); ok {
+			for kind, f := range prev {
+				merged[kind] = f
+			}
+		}
+		for kind, f := range funcs {
+			merged[kind] = f
+		}
+		c.Options[nodeRendererFuncsOption] = merged
+	}
+}
+
+// WithDocumentNodeRendererFunc is a functional option for Parse and Convert
+// that overrides a NodeRendererFunc for the given kind while the parsed
+// document is rendered. See WithDocumentNodeRenderers.
+func WithDocumentNodeRendererFunc(kind ast.NodeKind, f NodeRendererFunc) parser.ParseOption {
+	return WithDocumentNodeRenderers(nodeRendererFunc{kind, f})
+}
+
+type nodeRendererFunc struct {
+	kind ast.NodeKind
+	f    NodeRendererFunc
+}
+
+func (n nodeRendererFunc) RegisterFuncs(reg NodeRendererFuncRegisterer) {
+	reg.Register(n.kind, n.f)
+}
+
+// documentNodeRendererFuncs returns NodeRendererFuncs set by
+// WithDocumentNodeRenderers to the document that contains the given node.
+func documentNodeRendererFuncs(n ast.Node) funcRegisterer {
+	for ; n.Parent() != nil; n = n.Parent() {
+	}
+	if doc, ok := n.(*ast.Document); ok {
+		funcs, _ := doc.Option(nodeRendererFuncsOption).(funcRegisterer)
+		return funcs
+	}
+	return nil
+}
+
 // A SetOptioner interface sets given option to the object.
 type SetOptioner interface {
 	// SetOption sets given option to the object.
@@ -73,12 +202,25 @@ type SetOptioner interface {
 // NodeRendererFunc is a function that renders a given node.
 type NodeRendererFunc func(writer util.BufWriter, source []byte, n ast.Node, entering bool) (ast.WalkStatus, error)
 
+// NodeHookFunc is a function that is called before or after a node is
+// rendered. See WithNodeHooks.
+type NodeHookFunc func(writer util.BufWriter, source []byte, n ast.Node) (ast.WalkStatus, error)
+
 // A NodeRenderer interface offers NodeRendererFuncs.
 type NodeRenderer interface {
 	// RendererFuncs registers NodeRendererFuncs to given NodeRendererFuncRegisterer.
 	RegisterFuncs(NodeRendererFuncRegisterer)
 }
 
+// A WriterWrapper interface wraps writers that will be passed to
+// NodeRendererFuncs. If a NodeRenderer implements this interface,
+// WrapWriter will be called once per Render call.
+// This is useful for renderers that need per-render states like indentations.
+type WriterWrapper interface {
+	// WrapWriter returns a new writer that writes contents to the given writer.
+	WrapWriter(util.BufWriter) util.BufWriter
+}
+
 // A NodeRendererFuncRegisterer registers
 type NodeRendererFuncRegisterer interface {
 	// Register registers given NodeRendererFunc to this object.
@@ -100,6 +242,9 @@ type renderer struct {
 	nodeRendererFuncsTmp map[ast.NodeKind]NodeRendererFunc
 	maxKind              int
 	nodeRendererFuncs    []NodeRendererFunc
+	writerWrappers       []WriterWrapper
+	beforeHooks          []NodeHookFunc
+	afterHooks           []NodeHookFunc
 	initSync             sync.Once
 }
 
@@ -136,6 +281,8 @@ func (r *renderer) Register(kind ast.NodeKind, v NodeRendererFunc) {
 func (r *renderer) Render(w io.Writer, source []byte, n ast.Node) error {
 	r.initSync.Do(func() {
 		r.options = r.config.Options
+		r.beforeHooks = r.config.BeforeHooks
+		r.afterHooks = r.config.AfterHooks
 		r.config.NodeRenderers.Sort()
 		l := len(r.config.NodeRenderers)
 		for i := l - 1; i >= 0; i-- {
@@ -147,6 +294,9 @@ func (r *renderer) Render(w io.Writer, source []byte, n ast.Node) error {
 				}
 			}
 			nr.RegisterFuncs(r)
+			if ww, ok := v.Value.(WriterWrapper); ok {
+				r.writerWrappers = append(r.writerWrappers, ww)
+			}
 		}
 		r.nodeRendererFuncs = make([]NodeRendererFunc, r.maxKind+1)
 		for kind, nr := range r.nodeRendererFuncsTmp {
@@ -159,13 +309,47 @@ func (r *renderer) Render(w io.Writer, source []byte, n ast.Node) error {
 	if !ok {
 		writer = bufio.NewWriter(w)
 	}
+	for _, ww := range r.writerWrappers {
+		writer = ww.WrapWriter(writer)
+	}
+	overrides := documentNodeRendererFuncs(n)
+	var skipped ast.Node
 	err := ast.Walk(n, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
 		s := ast.WalkStatus(ast.WalkContinue)
 		var err error
-		f := r.nodeRendererFuncs[n.Kind()]
+		if !entering && n == skipped {
+			skipped = nil
+			return ast.WalkContinue, nil
+		}
+		if entering {
+			for _, hook := range r.beforeHooks {
+				s, err = hook(writer, source, n)
+				if err != nil || s == ast.WalkStop {
+					return s, err
+				}
+				if s == ast.WalkSkipChildren {
+					skipped = n
+					return s, nil
+				}
+			}
+		}
+		var f NodeRendererFunc
+		if override, ok := overrides[n.Kind()]; ok {
+			f = override
+		} else if int(n.Kind()) < len(r.nodeRendererFuncs) {
+			f = r.nodeRendererFuncs[n.Kind()]
+		}
 		if f != nil {
 			s, err = f(writer, source, n, entering)
 		}
+		if !entering && err == nil && s != ast.WalkStop {
+			for _, hook := range r.afterHooks {
+				s, err = hook(writer, source, n)
+				if err != nil || s == ast.WalkStop {
+					return s, err
+				}
+			}
+		}
 		return s, err
 	})
 	if err != nil {
@@ -173,3 +357,14 @@ func (r *renderer) Render(w io.Writer, source []byte, n ast.Node) error {
 	}
 	return writer.Flush()
 }
+
+// SortedAttributes returns a copy of the given attributes sorted by their
+// names.
+func SortedAttributes(attrs []ast.Attribute) []ast.Attribute {
+	sorted := make([]ast.Attribute, len(attrs))
+	copy(sorted, attrs)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i].Name, sorted[j].Name) < 0
+	})
+	return sorted
+}
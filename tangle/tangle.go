@@ -0,0 +1,247 @@
+// Package tangle extracts fenced code blocks from Markdown documents for
+// literate programming workflows.
+package tangle
+
+import (
+	"bytes"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// A Block struct represents an extracted fenced code block.
+type Block struct {
+	// Language is a language of this block.
+	// Language is empty if the block does not have an info string.
+	Language string
+
+	// Meta is a set of key=value pairs declared in the info string
+	// after the language.
+	Meta map[string]string
+
+	// Target is a file name that this block should be written to.
+	// Target is empty if the block does not declare a target.
+	Target string
+
+	// Code is a content of this block.
+	Code []byte
+
+	// Segment is a position of the code in the source.
+	Segment text.Segment
+
+	// Line is a 1-based line number where the code starts.
+	// Line is 0 if the block is empty and does not have an info string.
+	Line int
+}
+
+// A File struct represents blocks concatenated by a declared target.
+type File struct {
+	// Name is a name of the target.
+	Name string
+
+	// Code is a concatenated content of the blocks.
+	Code []byte
+
+	// Blocks is a list of blocks that are concatenated into this file.
+	Blocks []*Block
+}
+
+// A Config struct holds configurations for extraction.
+type Config struct {
+	// Languages is a list of languages that should be extracted.
+	// If Languages is empty, all blocks are extracted.
+	Languages []string
+
+	// TargetKey is a key of the meta data that declares file targets.
+	TargetKey string
+
+	// Filter is a function that returns true if the given block should be
+	// extracted.
+	Filter func(*Block) bool
+}
+
+// NewConfig returns a new Config with defaults.
+func NewConfig() *Config {
+	return &Config{
+		Languages: nil,
+		TargetKey: "file",
+		Filter:    nil,
+	}
+}
+
+// An Option is a functional option type for the extraction.
+type Option func(*Config)
+
+// WithLanguages is a functional option that extracts only blocks written
+// in the given languages.
+func WithLanguages(languages ...string) Option {
+	return func(c *Config) {
+		c.Languages = append(c.Languages, languages...)
+	}
+}
+
+// WithTargetKey is a functional option that specifies a key of the meta data
+// that declares file targets. The default is "file".
+func WithTargetKey(key string) Option {
+	return func(c *Config) {
+		c.TargetKey = key
+	}
+}
+
+// WithFilter is a functional option that extracts only blocks the given
+// function returns true.
+func WithFilter(f func(*Block) bool) Option {
+	return func(c *Config) {
+		c.Filter = f
+	}
+}
+
+// Extract extracts fenced code blocks from the given AST in document order.
+//
+// Info strings like the following are understood:
+//
+//     ```go file=main.go
+//     ```python {file="script.py" tangle=true}
+//
+// Attributes of blocks parsed with the parser.WithFencedCodeBlockAttribute
+// option are also included in Meta.
+func Extract(doc ast.Node, source []byte, opts ...Option) []*Block {
+	c := NewConfig()
+	for _, opt := range opts {
+		opt(c)
+	}
+	blocks := []*Block{}
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		fcb, ok := n.(*ast.FencedCodeBlock)
+		if !ok {
+			return ast.WalkContinue, nil
+		}
+		block := newBlock(fcb, source, c)
+		if !c.accepts(block) {
+			return ast.WalkSkipChildren, nil
+		}
+		blocks = append(blocks, block)
+		return ast.WalkSkipChildren, nil
+	})
+	return blocks
+}
+
+// Tangle extracts fenced code blocks that declare file targets and
+// concatenates them by targets.
+// Files are returned in order of their first appearance.
+func Tangle(doc ast.Node, source []byte, opts ...Option) []*File {
+	files := []*File{}
+	indices := map[string]int{}
+	for _, block := range Extract(doc, source, opts...) {
+		if len(block.Target) == 0 {
+			continue
+		}
+		i, ok := indices[block.Target]
+		if !ok {
+			i = len(files)
+			indices[block.Target] = i
+			files = append(files, &File{Name: block.Target})
+		}
+		f := files[i]
+		f.Code = append(f.Code, block.Code...)
+		f.Blocks = append(f.Blocks, block)
+	}
+	return files
+}
+
+func (c *Config) accepts(block *Block) bool {
+	if len(c.Languages) != 0 {
+		found := false
+		for _, l := range c.Languages {
+			if l == block.Language {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if c.Filter != nil {
+		return c.Filter(block)
+	}
+	return true
+}
+
+func newBlock(n *ast.FencedCodeBlock, source []byte, c *Config) *Block {
+	block := &Block{
+		Meta: map[string]string{},
+	}
+	if n.Info != nil {
+		info := n.Info.Text(source)
+		block.Language = string(n.Language(source))
+		parseMeta(info[len(n.Language(source)):], block.Meta)
+	}
+	// attributes like '{file="main.go"}' are removed from info strings
+	// if the parser.WithFencedCodeBlockAttribute option is enabled
+	for _, attr := range n.Attributes() {
+		block.Meta[string(attr.Name)] = string(attr.Value)
+	}
+	block.Target = block.Meta[c.TargetKey]
+	lines := n.Lines()
+	var buf bytes.Buffer
+	for i := 0; i < lines.Len(); i++ {
+		line := lines.At(i)
+		buf.Write(line.Value(source))
+	}
+	block.Code = buf.Bytes()
+	if lines.Len() != 0 {
+		block.Segment = text.NewSegment(lines.At(0).Start, lines.At(lines.Len()-1).Stop)
+		block.Line = bytes.Count(source[:block.Segment.Start], []byte{'\n'}) + 1
+	} else if n.Info != nil {
+		block.Segment = text.NewSegment(n.Info.Segment.Stop, n.Info.Segment.Stop)
+		block.Line = bytes.Count(source[:block.Segment.Start], []byte{'\n'}) + 2
+	}
+	return block
+}
+
+func parseMeta(info []byte, meta map[string]string) {
+	info = util.TrimLeftSpace(util.TrimRightSpace(info))
+	if len(info) > 1 && info[0] == '{' && info[len(info)-1] == '}' {
+		info = info[1 : len(info)-1]
+	}
+	for len(info) != 0 {
+		info = util.TrimLeftSpace(info)
+		i := 0
+		for ; i < len(info) && info[i] != '=' && !util.IsSpace(info[i]); i++ {
+		}
+		key := string(info[:i])
+		info = info[i:]
+		if len(info) == 0 || info[0] != '=' {
+			if len(key) != 0 {
+				meta[key] = ""
+			}
+			continue
+		}
+		info = info[1:]
+		var value []byte
+		if len(info) != 0 && info[0] == '"' {
+			j := bytes.IndexByte(info[1:], '"')
+			if j < 0 {
+				value = info[1:]
+				info = nil
+			} else {
+				value = info[1 : j+1]
+				info = info[j+2:]
+			}
+		} else {
+			j := 0
+			for ; j < len(info) && !util.IsSpace(info[j]); j++ {
+			}
+			value = info[:j]
+			info = info[j:]
+		}
+		if len(key) != 0 {
+			meta[key] = string(value)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package tangle
+
+import (
+	"testing"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+)
+
+func TestTangle(t *testing.T) {
+	source := []byte("# Example\n" +
+		"\n" +
+		"```go file=main.go\n" +
+		"package main\n" +
+		"```\n" +
+		"\n" +
+		"```sh\n" +
+		"go run .\n" +
+		"```\n" +
+		"\n" +
+		"```go {file=\"main.go\" note=\"body\"}\n" +
+		"func main() {}\n" +
+		"```\n")
+	doc := goldmark.DefaultParser().Parse(text.NewReader(source))
+
+	blocks := Extract(doc, source, WithLanguages("go"))
+	if len(blocks) != 2 {
+		t.Fatalf("expected 2 blocks, but got %d", len(blocks))
+	}
+	if blocks[0].Line != 4 || blocks[1].Line != 12 {
+		t.Errorf("unexpected lines: %d, %d", blocks[0].Line, blocks[1].Line)
+	}
+	if blocks[1].Meta["note"] != "body" {
+		t.Errorf("unexpected meta: %v", blocks[1].Meta)
+	}
+
+	files := Tangle(doc, source)
+	if len(files) != 1 || files[0].Name != "main.go" {
+		t.Fatalf("unexpected files: %v", files)
+	}
+	if string(files[0].Code) != "package main\nfunc main() {}\n" {
+		t.Errorf("unexpected code: %q", files[0].Code)
+	}
+}
+
+func TestExtractEmptyBlock(t *testing.T) {
+	source := []byte("```\n```\n\n```go\n```\n")
+	doc := goldmark.DefaultParser().Parse(text.NewReader(source))
+	blocks := Extract(doc, source)
+	if len(blocks) != 2 {
+		t.Fatalf("expected 2 blocks, but got %d", len(blocks))
+	}
+	if len(blocks[0].Code) != 0 || blocks[0].Line != 0 {
+		t.Errorf("unexpected block: %+v", blocks[0])
+	}
+	if blocks[1].Language != "go" || blocks[1].Line != 5 {
+		t.Errorf("unexpected block: %+v", blocks[1])
+	}
+}
+
+func TestTangleWithAttribute(t *testing.T) {
+	source := []byte("```go {file=\"main.go\" note=body}\n" +
+		"package main\n" +
+		"```\n")
+	p := goldmark.New(goldmark.WithParserOptions(parser.WithFencedCodeBlockAttribute())).Parser()
+	doc := p.Parse(text.NewReader(source))
+	blocks := Extract(doc, source)
+	if len(blocks) != 1 {
+		t.Fatalf("expected 1 block, but got %d", len(blocks))
+	}
+	if b := blocks[0]; b.Language != "go" || b.Target != "main.go" || b.Meta["note"] != "body" {
+		t.Errorf("unexpected block: %+v", b)
+	}
+}
@@ -0,0 +1,32 @@
+package text
+
+import "sort"
+
+// A LineIndex struct converts offsets in a source into line and column
+// numbers.
+type LineIndex struct {
+	starts []int
+}
+
+// NewLineIndex returns a new LineIndex for the given source.
+func NewLineIndex(source []byte) *LineIndex {
+	starts := []int{0}
+	for i, c := range source {
+		if c == '\n' {
+			starts = append(starts, i+1)
+		}
+	}
+	return &LineIndex{starts: starts}
+}
+
+// Position returns a 1-based line number and a 1-based column number in
+// bytes of the given offset.
+func (l *LineIndex) Position(offset int) (line, column int) {
+	i := sort.Search(len(l.starts), func(i int) bool {
+		return l.starts[i] > offset
+	}) - 1
+	if i < 0 {
+		i = 0
+	}
+	return i + 1, offset - l.starts[i] + 1
+}
@@ -1,6 +1,8 @@
 package text
 
 import (
+	"bytes"
+
 	"github.com/yuin/goldmark/util"
 	"io"
 	"regexp"
@@ -206,8 +208,13 @@ func (r *reader) Position() (int, Segment) {
 }
 
 func (r *reader) SetPosition(line int, pos Segment) {
+	if line != r.line && pos.Start >= 0 && pos.Start <= r.sourceLength {
+		// positions of other lines are set by parsers that look ahead.
+		r.head = bytes.LastIndexByte(r.source[:pos.Start], '\n') + 1
+	}
 	r.line = line
 	r.pos = pos
+	r.peekedLine = nil
 }
 
 func (r *reader) SetPadding(v int) {